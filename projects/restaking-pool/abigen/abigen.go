@@ -9,6 +9,8 @@ import (
 	"go/token"
 	"io/ioutil"
 	"os/exec"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -85,15 +87,18 @@ func ImproveAbigenOutput(path string, abiPath string) {
 
 	fset, fileNode := parseFile(bs)
 	logNames := getLogNames(fileNode)
-	if len(logNames) > 0 {
+	if len(logNames) > 0 || hasErrorArgs(abi) {
 		astutil.AddImport(fset, fileNode, "fmt")
-		astutil.AddImport(fset, fileNode, "github.com/TagusLabs/genesis-smart-contracts/abigen/generated")
 	}
 	contractName := getContractName(fileNode)
+	methods := getTransactMethods(contractName, fset, fileNode)
+	if len(logNames) > 0 || len(abi.Errors) > 0 || len(methods) > 0 {
+		astutil.AddImport(fset, fileNode, "github.com/TagusLabs/genesis-smart-contracts/abigen/generated")
+	}
 	fileNode = addContractStructFields(contractName, fileNode)
 	fileNode = replaceAnonymousStructs(contractName, fileNode)
 	bs = generateCode(fset, fileNode)
-	bs = writeAdditionalMethods(contractName, logNames, abi, bs)
+	bs = writeAdditionalMethods(contractName, logNames, methods, abi, bs)
 	err = ioutil.WriteFile(path, bs, 0600)
 	if err != nil {
 		Exit("Error while writing improved abigen source", err)
@@ -276,6 +281,56 @@ func getLogNames(fileNode *ast.File) []string {
 	return logNames
 }
 
+// transactMethod is a method of the Transactor, with its parameters after
+// the TransactOpts. abiName is the name of the ABI method it transacts, empty
+// for the fallback and receive functions.
+type transactMethod struct {
+	name    string
+	abiName string
+	params  []string
+	args    []string
+}
+
+func getTransactMethods(contractName string, fset *token.FileSet, fileNode *ast.File) []transactMethod {
+	var methods []transactMethod
+	for _, decl := range fileNode.Decls {
+		x, is := decl.(*ast.FuncDecl)
+		if !is || x.Recv == nil {
+			continue
+		}
+		star, is := x.Recv.List[0].Type.(*ast.StarExpr)
+		if !is || star.X.(*ast.Ident).Name != contractName+"Transactor" {
+			continue
+		}
+		method := transactMethod{name: x.Name.Name}
+		ast.Inspect(x.Body, func(n ast.Node) bool {
+			call, is := n.(*ast.CallExpr)
+			if !is || len(call.Args) < 2 {
+				return true
+			}
+			if sel, is := call.Fun.(*ast.SelectorExpr); !is || sel.Sel.Name != "Transact" {
+				return true
+			}
+			if lit, is := call.Args[1].(*ast.BasicLit); is && lit.Kind == token.STRING {
+				method.abiName, _ = strconv.Unquote(lit.Value)
+			}
+			return false
+		})
+		for _, field := range x.Type.Params.List[1:] {
+			var typ bytes.Buffer
+			if err := format.Node(&typ, fset, field.Type); err != nil {
+				Exit("Error while improving abigen output", err)
+			}
+			for _, name := range field.Names {
+				method.params = append(method.params, name.Name+" "+typ.String())
+				method.args = append(method.args, name.Name)
+			}
+		}
+		methods = append(methods, method)
+	}
+	return methods
+}
+
 func replaceAnonymousStructs(contractName string, fileNode *ast.File) *ast.File {
 	done := map[string]bool{}
 	return astutil.Apply(fileNode, func(cursor *astutil.Cursor) bool {
@@ -329,7 +384,7 @@ func replaceAnonymousStructs(contractName string, fileNode *ast.File) *ast.File
 	}, nil).(*ast.File)
 }
 
-func writeAdditionalMethods(contractName string, logNames []string, abi abi.ABI, bs []byte) []byte {
+func writeAdditionalMethods(contractName string, logNames []string, methods []transactMethod, abi abi.ABI, bs []byte) []byte {
 	// Write the ParseLog method
 	if len(logNames) > 0 {
 		var logSwitchBody string
@@ -366,9 +421,186 @@ func (_%v *%v) Address() common.Address {
 }
 `, contractName, contractName, contractName))...)
 
+	// Write a type for every custom error, and the UnpackError method
+	// decoding reverts into them
+	var errorCases string
+	for _, name := range errorNames(abi) {
+		e := abi.Errors[name]
+		var fields, values, formats, args []string
+		for i, input := range e.Inputs {
+			field := fieldName(input.Name, i)
+			typ := goType(input.Type)
+			fields = append(fields, field+" "+typ)
+			values = append(values, fmt.Sprintf("%v: *abi.ConvertType(e.Args[%v], new(%v)).(*%v)", field, i, typ, typ))
+			formats = append(formats, "%v")
+			args = append(args, "e."+field)
+		}
+		errorType := contractName + name + "Error"
+		message := fmt.Sprintf("%q", name+"()")
+		if len(args) > 0 {
+			message = fmt.Sprintf("fmt.Sprintf(%q, %v)", name+"("+strings.Join(formats, ", ")+")", strings.Join(args, ", "))
+		}
+		structType := "struct{}"
+		if len(fields) > 0 {
+			structType = "struct {\n" + strings.Join(fields, "\n") + "\n}"
+		}
+		bs = append(bs, []byte(fmt.Sprintf(`
+type %v %v
+
+func (e *%v) Error() string {
+    return %v
+}
+`, errorType, structType, errorType, message))...)
+		errorCases += fmt.Sprintf(`case %q:
+        return &%v{%v}, true
+`, name, errorType, strings.Join(values, ", "))
+	}
+	if errorCases == "" {
+		bs = append(bs, []byte(fmt.Sprintf(`
+func (_%v *%v) UnpackError(data []byte) (error, bool) {
+    return nil, false
+}
+`, contractName, contractName))...)
+	} else {
+		bs = append(bs, []byte(fmt.Sprintf(`
+func (_%v *%v) UnpackError(data []byte) (error, bool) {
+    e, ok := generated.UnpackError(&_%v.abi, data)
+    if !ok {
+        return nil, false
+    }
+    switch e.Name {
+    %v    default:
+        return e, true
+    }
+}
+`, contractName, contractName, contractName, errorCases))...)
+	}
+
+	// Write the AndWait variant of every Transactor method
+	for _, m := range methods {
+		bs = append(bs, []byte(fmt.Sprintf(`
+func (_%v *%v) %vAndWait(waiter *generated.Waiter, opts *bind.TransactOpts%v) (*generated.Result, error) {
+    return waiter.Send(opts, _%v, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+        return _%v.%v(opts%v)
+    })
+}
+`, contractName, contractName, m.name, joinParams(m.params), contractName, contractName, m.name, joinParams(m.args)))...)
+	}
+
+	// Write the Simulate variant of every Transactor method of an ABI method
+	for _, m := range methods {
+		if m.abiName == "" {
+			continue
+		}
+		outputs := abi.Methods[m.abiName].Outputs
+		if len(outputs) == 0 {
+			bs = append(bs, []byte(fmt.Sprintf(`
+func (_%v *%v) Simulate%v(sim *generated.Simulator, opts *generated.SimulateOpts%v) (uint64, error) {
+    _, gas, err := sim.Call(opts, _%v, &_%v.abi, %q%v)
+    return gas, err
+}
+`, contractName, contractName, m.name, joinParams(m.params), contractName, contractName, m.abiName, joinParams(m.args)))...)
+			continue
+		}
+		var types, zeros, converts, results []string
+		for i, output := range outputs {
+			typ := goType(output.Type)
+			types = append(types, typ)
+			zeros = append(zeros, fmt.Sprintf("*new(%v)", typ))
+			converts = append(converts, fmt.Sprintf("out%v := *abi.ConvertType(out[%v], new(%v)).(*%v)", i, i, typ, typ))
+			results = append(results, fmt.Sprintf("out%v", i))
+		}
+		bs = append(bs, []byte(fmt.Sprintf(`
+func (_%v *%v) Simulate%v(sim *generated.Simulator, opts *generated.SimulateOpts%v) (%v, uint64, error) {
+    out, gas, err := sim.Call(opts, _%v, &_%v.abi, %q%v)
+    if err != nil {
+        return %v, 0, err
+    }
+    %v
+    return %v, gas, nil
+}
+`, contractName, contractName, m.name, joinParams(m.params), strings.Join(types, ", "), contractName, contractName, m.abiName, joinParams(m.args),
+			strings.Join(zeros, ", "), strings.Join(converts, "\n"), strings.Join(results, ", ")))...)
+	}
+
 	return bs
 }
 
+// errorNames returns the names of the custom errors of the ABI, sorted.
+func errorNames(abi abi.ABI) []string {
+	names := make([]string, 0, len(abi.Errors))
+	for name := range abi.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// hasErrorArgs reports whether a custom error of the ABI has arguments,
+// which its Error method formats.
+func hasErrorArgs(abi abi.ABI) bool {
+	for _, e := range abi.Errors {
+		if len(e.Inputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldName is the struct field of an argument, Arg<i> when it is unnamed.
+func fieldName(name string, i int) string {
+	if name == "" {
+		return fmt.Sprintf("Arg%v", i)
+	}
+	return abi.ToCamelCase(name)
+}
+
+// goType is the Go type abigen binds an ABI type to.
+func goType(t abi.Type) string {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		prefix := ""
+		if t.T == abi.UintTy {
+			prefix = "u"
+		}
+		switch t.Size {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%vint%v", prefix, t.Size)
+		}
+		return "*big.Int"
+	case abi.BoolTy:
+		return "bool"
+	case abi.StringTy:
+		return "string"
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%v]byte", t.Size)
+	case abi.HashTy:
+		return "common.Hash"
+	case abi.FunctionTy:
+		return "[24]byte"
+	case abi.SliceTy:
+		return "[]" + goType(*t.Elem)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%v]%v", t.Size, goType(*t.Elem))
+	case abi.TupleTy:
+		return abi.ToCamelCase(t.TupleRawName)
+	}
+	return "interface{}"
+}
+
+// joinParams formats params following the TransactOpts in a parameter or
+// argument list.
+func joinParams(params []string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(params, ", ")
+}
+
 func writeInterface(contractName string, fileNode *ast.File) *ast.File {
 	// Generate an interface for the contract
 	var methods []*ast.Field
@@ -0,0 +1,255 @@
+package generated
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// Wrapper is implemented by every generated contract wrapper.
+type Wrapper interface {
+	Address() common.Address
+	// UnpackError decodes the revert data of a custom error declared by
+	// the contract.
+	UnpackError(data []byte) (error, bool)
+}
+
+// LogParser is implemented by the wrappers of contracts with events.
+type LogParser interface {
+	ParseLog(log types.Log) (AbigenLog, error)
+}
+
+// Backend sends transactions and fetches their receipts.
+type Backend interface {
+	bind.ContractBackend
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// Result is the outcome of a mined transaction.
+type Result struct {
+	Transaction *types.Transaction
+	Receipt     *types.Receipt
+	// Events are the logs of the known contracts, decoded in log order.
+	Events []AbigenLog
+	// Unknown are the logs of other contracts and of events the ABIs do
+	// not declare.
+	Unknown []types.Log
+}
+
+// Events returns the events of r of type T, such as
+// *RestakingPool.ContractStaked.
+func Events[T AbigenLog](r *Result) []T {
+	var events []T
+	for _, ev := range r.Events {
+		if e, ok := ev.(T); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Event returns the first event of r of type T.
+func Event[T AbigenLog](r *Result) (T, bool) {
+	events := Events[T](r)
+	if len(events) == 0 {
+		var zero T
+		return zero, false
+	}
+	return events[0], true
+}
+
+// ErrReverted is the reason of a revert no known contract declares.
+var ErrReverted = errors.New("execution reverted")
+
+// RevertError is returned for a transaction that reverted, when it was
+// simulated, when its gas was estimated or once mined.
+type RevertError struct {
+	// Tx is the hash of the mined transaction, zero if the transaction
+	// reverted before it was sent.
+	Tx common.Hash
+	// Data is the revert data, empty if a mined transaction could not be
+	// replayed.
+	Data []byte
+	// Reason is the decoded revert: the custom error of a known contract,
+	// a Revert with the require message, or ErrReverted.
+	Reason error
+}
+
+func (e *RevertError) Error() string {
+	if e.Tx == (common.Hash{}) {
+		return fmt.Sprintf("reverted: %v", e.Reason)
+	}
+	return fmt.Sprintf("transaction %s reverted: %v", e.Tx.Hex(), e.Reason)
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.Reason
+}
+
+// Revert is the message of a require or revert with a string.
+type Revert string
+
+func (r Revert) Error() string {
+	return string(r)
+}
+
+// CustomError is a decoded custom error.
+type CustomError struct {
+	Name string
+	Args []interface{}
+}
+
+func (e *CustomError) Error() string {
+	args := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		args[i] = fmt.Sprint(a)
+	}
+	return e.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// UnpackError decodes data as one of the custom errors of parsed. The
+// generated wrappers turn it into the typed error of the contract.
+func UnpackError(parsed *abi.ABI, data []byte) (*CustomError, bool) {
+	if len(data) < 4 {
+		return nil, false
+	}
+	for _, e := range parsed.Errors {
+		if !bytes.Equal(e.ID[:4], data[:4]) {
+			continue
+		}
+		args, err := e.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, false
+		}
+		return &CustomError{Name: e.Name, Args: args}, true
+	}
+	return nil, false
+}
+
+// Waiter sends the transactions of the AndWait variants of the generated
+// Transactor methods, waits for their receipts and decodes them with the
+// wrappers of the known contracts.
+type Waiter struct {
+	backend Backend
+	known   []Wrapper
+}
+
+// NewWaiter returns a Waiter decoding the logs and errors of known.
+func NewWaiter(backend Backend, known ...Wrapper) *Waiter {
+	return &Waiter{backend: backend, known: known}
+}
+
+// Send sends the transaction of transact, a Transactor method of to, and
+// waits for it to be mined. A revert is returned as a *RevertError, with the
+// result of the mined transaction.
+func (w *Waiter) Send(opts *bind.TransactOpts, to Wrapper, transact func(*bind.TransactOpts) (*types.Transaction, error)) (*Result, error) {
+	tx, err := transact(opts)
+	if err != nil {
+		if data, ok := revertData(err); ok {
+			return nil, &RevertError{Data: data, Reason: unpack(w.known, to, data)}
+		}
+		return nil, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	receipt, err := bind.WaitMined(ctx, w.backend, tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get receipt of %s", tx.Hash().Hex())
+	}
+	result := w.Decode(tx, receipt)
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return result, nil
+	}
+	return result, w.replay(ctx, opts.From, to, tx, receipt)
+}
+
+// Decode decodes the logs of receipt.
+func (w *Waiter) Decode(tx *types.Transaction, receipt *types.Receipt) *Result {
+	result := &Result{Transaction: tx, Receipt: receipt}
+	for _, log := range receipt.Logs {
+		if ev, ok := w.decodeLog(*log); ok {
+			result.Events = append(result.Events, ev)
+		} else {
+			result.Unknown = append(result.Unknown, *log)
+		}
+	}
+	return result
+}
+
+func (w *Waiter) decodeLog(log types.Log) (AbigenLog, bool) {
+	if len(log.Topics) == 0 {
+		return nil, false
+	}
+	for _, c := range w.known {
+		parser, ok := c.(LogParser)
+		if !ok || c.Address() != log.Address {
+			continue
+		}
+		if ev, err := parser.ParseLog(log); err == nil {
+			return ev, true
+		}
+	}
+	return nil, false
+}
+
+// replay calls the reverted tx again on the state of its block, which it
+// left unchanged but for the sender's nonce and balance, to recover the
+// revert data the receipt does not hold.
+func (w *Waiter) replay(ctx context.Context, from common.Address, to Wrapper, tx *types.Transaction, receipt *types.Receipt) error {
+	revert := &RevertError{Tx: tx.Hash(), Reason: ErrReverted}
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	_, err := w.backend.CallContract(ctx, msg, receipt.BlockNumber)
+	if data, ok := revertData(err); ok {
+		revert.Data, revert.Reason = data, unpack(w.known, to, data)
+	}
+	return revert
+}
+
+// unpack decodes revert data with the errors of to first, then with those of
+// the other known contracts, whose errors bubble up from inner calls.
+func unpack(known []Wrapper, to Wrapper, data []byte) error {
+	for _, c := range append([]Wrapper{to}, known...) {
+		if c == nil {
+			continue
+		}
+		if err, ok := c.UnpackError(data); ok {
+			return err
+		}
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return Revert(reason)
+	}
+	return ErrReverted
+}
+
+// revertData extracts the revert data of a failed call or gas estimation.
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if err == nil || !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	hex, _ := dataErr.ErrorData().(string)
+	data, decodeErr := hexutil.Decode(hex)
+	if decodeErr != nil {
+		return nil, false
+	}
+	return data, true
+}
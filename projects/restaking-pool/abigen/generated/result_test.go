@@ -0,0 +1,80 @@
+package generated_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// miner is the simulated backend mining every transaction when it is sent.
+type miner struct {
+	*backends.SimulatedBackend
+}
+
+func (m miner) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := m.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	m.Commit()
+	return nil
+}
+
+func TestWaiter(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	backend := miner{f.Backend}
+	c, err := client.New(context.Background(), f.ProtocolConfig.Address(), backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waiter := generated.NewWaiter(backend, c.Wrappers()...)
+	pool, user := c.RestakingPool(), f.Users[0]
+
+	result, err := pool.StakeAndWait(waiter, user.WithValue(fixture.Ether))
+	if err != nil {
+		t.Fatal(err)
+	}
+	staked, ok := generated.Event[*restakingpool.ContractStaked](result)
+	if !ok || staked.Staker != user.Address || staked.Amount.Cmp(fixture.Ether) != 0 {
+		t.Fatalf("staked %+v in %+v", staked, result.Events)
+	}
+	minted := generated.Events[*ctoken.ContractTransfer](result)
+	if len(minted) != 1 || minted[0].To != user.Address || minted[0].Value.Cmp(staked.Shares) != 0 {
+		t.Fatalf("minted %+v for %s shares", minted, staked.Shares)
+	}
+	if len(result.Unknown) != 0 || result.Receipt.TxHash != result.Transaction.Hash() {
+		t.Fatalf("unknown logs %+v", result.Unknown)
+	}
+
+	// a revert at the estimation
+	_, err = pool.StakeAndWait(waiter, user.WithValue(nil))
+	var revert *generated.RevertError
+	var stakeErr *restakingpool.ContractPoolStakeAmLessThanMinError
+	if !errors.As(err, &revert) || revert.Tx != (common.Hash{}) || !errors.As(err, &stakeErr) {
+		t.Fatalf("stake of nothing: %v", err)
+	}
+
+	// a mined revert is replayed for its reason
+	opts := user.WithValue(nil)
+	opts.GasLimit = 300_000
+	result, err = pool.StakeAndWait(waiter, opts)
+	if !errors.As(err, &revert) || revert.Tx != result.Transaction.Hash() || result.Receipt.Status != types.ReceiptStatusFailed {
+		t.Fatalf("mined stake of nothing %+v: %v", result, err)
+	}
+	if !errors.As(err, &stakeErr) {
+		t.Fatalf("replayed reason: %v", err)
+	}
+}
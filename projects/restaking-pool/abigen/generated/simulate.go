@@ -0,0 +1,107 @@
+package generated
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// SimulateOpts is the transaction a Simulate variant runs.
+type SimulateOpts struct {
+	From  common.Address
+	Value *big.Int
+	// BlockNumber is the block whose state the call runs on, nil for the
+	// latest block. The gas is estimated on the same block when the backend
+	// exposes its rpc client, as an ethclient does, and not at all otherwise.
+	BlockNumber *big.Int
+	Context     context.Context
+}
+
+// Simulator runs the Simulate variants of the generated Transactor methods as
+// eth_call, decoding reverts with the errors of the known contracts.
+type Simulator struct {
+	backend bind.ContractBackend
+	known   []Wrapper
+}
+
+// NewSimulator returns a Simulator decoding the errors of known.
+func NewSimulator(backend bind.ContractBackend, known ...Wrapper) *Simulator {
+	return &Simulator{backend: backend, known: known}
+}
+
+// Call calls method of to, described by parsed, and returns its outputs and
+// the estimated gas of the transaction. Without a block number the gas is
+// estimated on the pending state. With one, it is estimated on that block
+// through the rpc client of the backend, and is zero when the backend has
+// none: an estimate on other state than the call would not match its
+// outputs. A revert of the call or of the estimation is returned as a
+// *RevertError.
+func (s *Simulator) Call(opts *SimulateOpts, to Wrapper, parsed *abi.ABI, method string, params ...interface{}) ([]interface{}, uint64, error) {
+	if opts == nil {
+		opts = new(SimulateOpts)
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	input, err := parsed.Pack(method, params...)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not pack %s", method)
+	}
+	address := to.Address()
+	msg := ethereum.CallMsg{From: opts.From, To: &address, Value: opts.Value, Data: input}
+	output, err := s.backend.CallContract(ctx, msg, opts.BlockNumber)
+	if err != nil {
+		return nil, 0, s.revert(to, err)
+	}
+	out, err := parsed.Unpack(method, output)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not unpack %s", method)
+	}
+	gas, err := s.estimateGas(ctx, msg, opts.BlockNumber)
+	if err != nil {
+		return nil, 0, s.revert(to, err)
+	}
+	return out, gas, nil
+}
+
+// rpcBackend is a backend exposing its rpc client, as ethclient.Client does.
+type rpcBackend interface {
+	Client() *rpc.Client
+}
+
+func (s *Simulator) estimateGas(ctx context.Context, msg ethereum.CallMsg, block *big.Int) (uint64, error) {
+	if block == nil {
+		return s.backend.EstimateGas(ctx, msg)
+	}
+	backend, ok := s.backend.(rpcBackend)
+	if !ok {
+		return 0, nil
+	}
+	arg := map[string]interface{}{"from": msg.From, "to": msg.To}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	var gas hexutil.Uint64
+	if err := backend.Client().CallContext(ctx, &gas, "eth_estimateGas", arg, hexutil.EncodeBig(block)); err != nil {
+		return 0, err
+	}
+	return uint64(gas), nil
+}
+
+func (s *Simulator) revert(to Wrapper, err error) error {
+	if data, ok := revertData(err); ok {
+		return &RevertError{Data: data, Reason: unpack(s.known, to, data)}
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+package generated_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+func TestSimulator(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim := generated.NewSimulator(f.Backend, c.Wrappers()...)
+	pool, token := f.RestakingPool, f.CToken
+	u0, u1 := f.Users[0], f.Users[1]
+	if _, err := f.Send(pool.Stake(u0.WithValue(fixture.Ether))); err != nil {
+		t.Fatal(err)
+	}
+	head, err := f.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assets, err := pool.TotalAssets(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the simulated backend has no rpc client to estimate at a block
+	gas, err := pool.SimulateStake(sim, &generated.SimulateOpts{From: u1.Address, Value: fixture.Ether, BlockNumber: head.Number})
+	if err != nil || gas != 0 {
+		t.Fatalf("stake at block %s: gas %d: %v", head.Number, gas, err)
+	}
+	gas, err = pool.SimulateStake(sim, &generated.SimulateOpts{From: u1.Address, Value: fixture.Ether})
+	if err != nil || gas < 21_000 {
+		t.Fatalf("stake: gas %d: %v", gas, err)
+	}
+	// with one, the gas is estimated on the block of the call
+	estimator := &estimator{gas: 90_000}
+	server := rpc.NewServer()
+	defer server.Stop()
+	if err := server.RegisterName("eth", estimator); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+	atBlock := generated.NewSimulator(rpcBackend{f.Backend, client}, c.Wrappers()...)
+	gas, err = pool.SimulateStake(atBlock, &generated.SimulateOpts{From: u1.Address, Value: fixture.Ether, BlockNumber: head.Number})
+	if err != nil || gas != estimator.gas || estimator.block != hexutil.EncodeBig(head.Number) {
+		t.Fatalf("stake at block %s: gas %d estimated at %s: %v", head.Number, gas, estimator.block, err)
+	}
+	if after, err := pool.TotalAssets(nil); err != nil || after.Cmp(assets) != 0 {
+		t.Fatalf("simulation changed the assets from %s to %s: %v", assets, after, err)
+	}
+	shares, err := token.BalanceOf(nil, u0.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, gas, err := token.SimulateTransfer(sim, &generated.SimulateOpts{From: u0.Address}, u1.Address, shares)
+	if err != nil || !ok || gas == 0 {
+		t.Fatalf("transfer: %v, gas %d: %v", ok, gas, err)
+	}
+	if _, err := pool.SimulateDistributeUnstakes(sim, &generated.SimulateOpts{From: f.Operator.Address}); err != nil {
+		t.Fatalf("distributeUnstakes: %v", err)
+	}
+
+	// reverts decode to the typed errors of the pool and of inner calls
+	var stakeErr *restakingpool.ContractPoolStakeAmLessThanMinError
+	if _, err := pool.SimulateStake(sim, &generated.SimulateOpts{From: u1.Address}); !errors.As(err, &stakeErr) {
+		t.Errorf("stake of nothing: %v", err)
+	}
+	var operatorErr *restakingpool.ContractOnlyOperatorAllowedError
+	if _, err := pool.SimulateDistributeUnstakes(sim, &generated.SimulateOpts{From: u1.Address}); !errors.As(err, &operatorErr) {
+		t.Errorf("distributeUnstakes by a user: %v", err)
+	}
+	var balanceErr *ctoken.ContractERC20InsufficientBalanceError
+	_, err = pool.SimulateFlashUnstake(sim, &generated.SimulateOpts{From: u1.Address}, shares, u1.Address)
+	if !errors.As(err, &balanceErr) || balanceErr.Sender != u1.Address || balanceErr.Needed.Cmp(shares) != 0 {
+		t.Errorf("flashUnstake without shares: %v", err)
+	}
+	var revert *generated.RevertError
+	if _, err := pool.SimulateClaimUnstake(sim, &generated.SimulateOpts{From: u1.Address}, u1.Address); !errors.As(err, &revert) {
+		t.Errorf("claimUnstake without unstakes: %v", err)
+	}
+}
+
+// rpcBackend is a backend with an rpc client, as an ethclient is.
+type rpcBackend struct {
+	bind.ContractBackend
+	client *rpc.Client
+}
+
+func (b rpcBackend) Client() *rpc.Client { return b.client }
+
+// estimator serves eth_estimateGas, recording the block it is asked for.
+type estimator struct {
+	gas   uint64
+	block string
+}
+
+func (e *estimator) EstimateGas(args map[string]interface{}, block string) hexutil.Uint64 {
+	e.block = block
+	return hexutil.Uint64(e.gas)
+}
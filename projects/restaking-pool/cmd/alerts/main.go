@@ -0,0 +1,106 @@
+// Command alerts evaluates a rule file over the protocol events and
+// periodic view snapshots and sends the alerts to the sinks of the file.
+//
+//	go run ./cmd/alerts -rpc wss://... -config 0x... -rules rules.yaml
+//
+// See pkg/alerts for the rule file. Events are read by an indexer that
+// stores its progress in -db; without -start it follows from the head. The
+// events already evaluated are kept in -marks, so that a restart does not
+// send their alerts again.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/alerts"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "ws://localhost:8546", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	rules := flag.String("rules", "", "rule file")
+	db := flag.String("db", "alerts-index", "event indexer database directory")
+	marks := flag.String("marks", "alerts-marks", "database directory of the evaluated events")
+	start := flag.Int64("start", -1, "first block to evaluate events from, the head when negative")
+	confirmations := flag.Uint64("confirmations", 3, "blocks an event waits for before it is evaluated")
+	flag.Parse()
+	if !common.IsHexAddress(*config) || *rules == "" {
+		fmt.Fprintln(os.Stderr, "usage: alerts -config address -rules file [-rpc url] [-db dir] [-marks dir] [-start block] [-confirmations n]")
+		os.Exit(2)
+	}
+
+	if err := run(*rpcURL, common.HexToAddress(*config), *rules, *db, *marks, *start, *confirmations); err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(rpcURL string, config common.Address, rules, db, marks string, start int64, confirmations uint64) error {
+	cfg, err := alerts.Load(rules)
+	if err != nil {
+		return err
+	}
+	sinks, err := cfg.OpenSinks()
+	if err != nil {
+		return err
+	}
+	engine, err := alerts.New(cfg.Rules, sinks)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+	if start < 0 {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head")
+		}
+		start = int64(head)
+	}
+	store, err := indexer.Open(db)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	markStore, err := alerts.OpenMarks(marks)
+	if err != nil {
+		return err
+	}
+	defer markStore.Close()
+	engine.Persist(markStore)
+
+	ix := indexer.New(backend, store, indexer.Contracts(c), indexer.Config{Start: uint64(start), Confirmations: confirmations}, engine.Handle)
+	indexed := make(chan struct{})
+	defer func() { <-indexed }() // before the store closes
+	go func() {
+		defer close(indexed)
+		_ = ix.Run(ctx, func(_ indexer.Progress, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "indexer:", err)
+			}
+		})
+	}()
+	return engine.Run(ctx, c, backend, cfg.PollInterval(), func(err error) {
+		fmt.Fprintln(os.Stderr, "views:", err)
+	})
+}
@@ -0,0 +1,113 @@
+// Command apr prints the cToken APR and APY over time from the RatioFeed
+// history and cross-checks the stored ring with averagePercentageRate.
+//
+//	go run ./cmd/apr -rpc $RPC_URL -config 0x... -from 19000000 -window 7d > apr.csv
+//	go run ./cmd/apr -rpc $RPC_URL -config 0x... -source storage -format json
+//
+// -source events replays every RatioUpdated since -from, -source storage
+// reads the eight daily ratios RatioFeed keeps. It exits with status 1 when
+// averagePercentageRate disagrees with the rate computed from storage.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/apr"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	from := flag.Uint64("from", 0, "block to replay events from, the RatioFeed deployment")
+	source := flag.String("source", "events", "history source: events or storage")
+	window := flag.String("window", "1d", "period each rate is measured over, 0 for consecutive updates")
+	format := flag.String("format", "csv", "output format: csv or json")
+	flag.Parse()
+	if !common.IsHexAddress(*config) {
+		fmt.Fprintln(os.Stderr, "usage: apr -config address [-rpc url] [-from block] [-source events|storage] [-window 7d] [-format csv|json]")
+		os.Exit(2)
+	}
+
+	ok, err := run(*rpcURL, common.HexToAddress(*config), *from, *source, *window, *format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+}
+
+// run reports whether averagePercentageRate matched the stored ring.
+func run(rpcURL string, config common.Address, from uint64, source, window, format string) (bool, error) {
+	period, err := parse.Duration(window)
+	if err != nil {
+		return false, err
+	}
+	ctx := context.Background()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return false, errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return false, err
+	}
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get head")
+	}
+	feed, token := c.RatioFeed(), c.Addresses().CToken
+
+	ring, err := apr.ReadRing(ctx, backend, feed.Address(), token, head.Number)
+	if err != nil {
+		return false, err
+	}
+	checks, err := apr.CrossCheck(ctx, feed, token, ring, head.Number)
+	if err != nil {
+		return false, err
+	}
+	ok := true
+	for _, check := range checks {
+		if !check.Match() {
+			ok = false
+			fmt.Fprintf(os.Stderr, "averagePercentageRate(%d) is %s, computed %s\n", check.Day, check.Contract, check.Computed)
+		}
+	}
+
+	var points []apr.Point
+	switch source {
+	case "events":
+		h, err := apr.Replay(ctx, backend, feed, token, from, head.Number.Uint64())
+		if err != nil {
+			return false, err
+		}
+		if h.Ring != *ring && from == 0 {
+			fmt.Fprintf(os.Stderr, "replayed ring %+v differs from storage %+v\n", h.Ring, *ring)
+		}
+		points = h.Points
+	case "storage":
+		points = ring.Points()
+	default:
+		return false, errors.Errorf("unknown source %q", source)
+	}
+
+	samples := apr.Series(points, period)
+	switch format {
+	case "csv":
+		return ok, apr.WriteCSV(os.Stdout, samples)
+	case "json":
+		return ok, apr.WriteJSON(os.Stdout, samples)
+	}
+	return false, errors.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,112 @@
+// Command backfill prints the Staked, Unstaked and Transfer history of the
+// protocol as JSON lines, in chain order.
+//
+//	go run ./cmd/backfill -rpc $RPC_URL -config 0x... -from 19000000 > history.jsonl
+//	go run ./cmd/backfill -rpc $RPC_URL -config 0x... -from 19000000 -workers 8 -rate 25
+//
+// Ranges the provider rejects for returning too many logs are halved until
+// they fit.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/backfill"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	from := flag.Uint64("from", 0, "first block, the protocol deployment")
+	to := flag.Int64("to", -1, "last block, the head when negative")
+	chunk := flag.Uint64("chunk", 2000, "blocks per log query before splitting")
+	workers := flag.Int("workers", 4, "concurrent log queries")
+	rate := flag.Float64("rate", 0, "maximum log queries per second, 0 for no limit")
+	flag.Parse()
+	if !common.IsHexAddress(*config) {
+		fmt.Fprintln(os.Stderr, "usage: backfill -config address [-rpc url] [-from block] [-to block] [-chunk n] [-workers n] [-rate n]")
+		os.Exit(2)
+	}
+
+	cfg := backfill.Config{From: *from, ChunkSize: *chunk, Workers: *workers, RateLimit: *rate}
+	if err := run(*rpcURL, common.HexToAddress(*config), *to, cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// line is an output event.
+type line struct {
+	Block    uint64                 `json:"block"`
+	Index    uint                   `json:"index"`
+	Tx       common.Hash            `json:"tx"`
+	Contract string                 `json:"contract"`
+	Event    string                 `json:"event"`
+	Args     map[string]interface{} `json:"args"`
+}
+
+func run(rpcURL string, config common.Address, to int64, cfg backfill.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+	if to < 0 {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head")
+		}
+		to = int64(head)
+	}
+	cfg.To = uint64(to)
+
+	pool, err := restakingpool.ContractMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	token, err := ctoken.ContractMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	addrs := c.Addresses()
+	names := map[common.Address]string{addrs.RestakingPool: "RestakingPool", addrs.CToken: "cToken"}
+	decoders := map[common.Address]backfill.Decoder{addrs.RestakingPool: backfill.ABI(pool), addrs.CToken: backfill.ABI(token)}
+	cfg.Addresses = []common.Address{addrs.RestakingPool, addrs.CToken}
+	cfg.Topics = [][]common.Hash{{pool.Events["Staked"].ID, pool.Events["Unstaked"].ID, token.Events["Transfer"].ID}}
+	decoder := backfill.DecoderFunc(func(l types.Log) (interface{}, error) {
+		return decoders[l.Address].Decode(l)
+	})
+
+	enc := json.NewEncoder(os.Stdout)
+	stats, err := backfill.Run(ctx, backend, cfg, decoder, func(e backfill.Event) error {
+		ev, ok := e.Decoded.(*backfill.ABIEvent)
+		if !ok {
+			return nil
+		}
+		return enc.Encode(line{
+			Block: e.Log.BlockNumber, Index: e.Log.Index, Tx: e.Log.TxHash,
+			Contract: names[e.Log.Address], Event: ev.Name, Args: ev.Args,
+		})
+	})
+	fmt.Fprintf(os.Stderr, "%d events, %d requests, %d splits, %d retries\n", stats.Logs, stats.Requests, stats.Splits, stats.Retries)
+	return err
+}
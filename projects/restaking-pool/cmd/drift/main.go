@@ -0,0 +1,83 @@
+// Command drift compares the live governance parameters of a network with
+// its config file and prints the setter calls that reconcile them.
+//
+//	go run ./cmd/drift -rpc https://... -file drift/mainnet.yaml
+//	go run ./cmd/drift -rpc https://... -file drift/mainnet.yaml -json > proposal.json
+//
+// See pkg/drift for the config file. With -json the report carries the calls
+// both one by one and as the targets, values and payloads of a timelock
+// batch. The command exits with status 3 when a parameter drifted.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/drift"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	file := flag.String("file", "", "config file of the network")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	flag.Parse()
+	if *file == "" {
+		fmt.Fprintln(os.Stderr, "usage: drift -file config.yaml [-rpc url] [-json]")
+		os.Exit(2)
+	}
+
+	drifted, err := run(*rpcURL, *file, *asJSON)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if drifted {
+		os.Exit(3)
+	}
+}
+
+func run(rpcURL, file string, asJSON bool) (bool, error) {
+	cfg, err := drift.Load(file)
+	if err != nil {
+		return false, err
+	}
+	ctx := context.Background()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return false, errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	if cfg.ChainID != 0 {
+		id, err := backend.ChainID(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "could not get chain id")
+		}
+		if !id.IsUint64() || id.Uint64() != cfg.ChainID {
+			return false, errors.Errorf("%s is chain %s, want %d", rpcURL, id, cfg.ChainID)
+		}
+	}
+	c, err := client.New(ctx, common.HexToAddress(cfg.ProtocolConfig), backend)
+	if err != nil {
+		return false, err
+	}
+	report, err := drift.Check(ctx, c, backend, cfg)
+	if err != nil {
+		return false, err
+	}
+	if asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		err = report.Print(os.Stdout)
+	}
+	return report.Drifted(), err
+}
@@ -0,0 +1,105 @@
+// Command exporter serves the protocol state and event counts in the
+// Prometheus text format.
+//
+//	go run ./cmd/exporter -rpc wss://... -config 0x... -listen :9464 -start 19000000
+//
+// Every scrape reads the state at the head block. Events are counted by an
+// indexer that stores its progress in -db; without -start it counts from
+// the head.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/metrics"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "ws://localhost:8546", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	listen := flag.String("listen", ":9464", "address to serve /metrics on")
+	db := flag.String("db", "exporter-index", "event indexer database directory")
+	start := flag.Int64("start", -1, "first block to count events from, the head when negative")
+	confirmations := flag.Uint64("confirmations", 12, "blocks an event waits for before it is counted")
+	timeout := flag.Duration("timeout", 10*time.Second, "scrape timeout")
+	flag.Parse()
+	if !common.IsHexAddress(*config) {
+		fmt.Fprintln(os.Stderr, "usage: exporter -config address [-rpc url] [-listen addr] [-db dir] [-start block] [-confirmations n] [-timeout d]")
+		os.Exit(2)
+	}
+
+	if err := run(*rpcURL, common.HexToAddress(*config), *listen, *db, *start, *confirmations, *timeout); err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(rpcURL string, config common.Address, listen, db string, start int64, confirmations uint64, timeout time.Duration) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+	if start < 0 {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head")
+		}
+		start = int64(head)
+	}
+
+	e := metrics.New(c, backend, timeout)
+	e.OnError = func(err error) { fmt.Fprintln(os.Stderr, "scrape:", err) }
+	handler, err := e.Handler()
+	if err != nil {
+		return err
+	}
+	store, err := indexer.Open(db)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	ix := indexer.New(backend, store, indexer.Contracts(c), indexer.Config{Start: uint64(start), Confirmations: confirmations}, e.Handle)
+	indexed := make(chan struct{})
+	defer func() { <-indexed }() // before the store closes
+	go func() {
+		defer close(indexed)
+		_ = ix.Run(ctx, func(_ indexer.Progress, err error) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "indexer:", err)
+			}
+		})
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+	server := &http.Server{Addr: listen, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	fmt.Fprintf(os.Stderr, "serving /metrics on %s\n", listen)
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		stop()
+		return err
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,80 @@
+// Command gasreport measures RestakingPool gas usage on the simulated
+// backend and prints the changes against a baseline file.
+//
+//	go run ./cmd/gasreport            # compare against gas-baseline.json
+//	go run ./cmd/gasreport -update    # accept the current numbers
+//
+// It exits with status 1 when a method got more expensive than the
+// threshold allows, unless -update is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/gasreport"
+)
+
+func main() {
+	baselinePath := flag.String("baseline", "gas-baseline.json", "baseline file")
+	update := flag.Bool("update", false, "write the current numbers to the baseline file")
+	threshold := flag.Float64("threshold", 0.5, "percent change reported as a regression or improvement")
+	all := flag.Bool("all", false, "print every measured method, not only changes")
+	flag.Parse()
+
+	regressed, err := run(*baselinePath, *update, *threshold, *all)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if regressed {
+		os.Exit(1)
+	}
+}
+
+// run reports whether there are regressions that were not accepted with
+// -update.
+func run(baselinePath string, update bool, threshold float64, all bool) (bool, error) {
+	baseline, err := gasreport.LoadBaseline(baselinePath)
+	if err != nil {
+		return false, err
+	}
+	f, err := fixture.New(gasreport.Options())
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	entries, err := gasreport.Measure(f)
+	if err != nil {
+		return false, err
+	}
+
+	rows := gasreport.Compare(baseline, entries, threshold)
+	shown := rows
+	if !all {
+		shown = nil
+		for _, r := range rows {
+			if r.Status != gasreport.StatusSame {
+				shown = append(shown, r)
+			}
+		}
+	}
+	if len(shown) > 0 {
+		if err := gasreport.WriteTable(os.Stdout, shown); err != nil {
+			return false, err
+		}
+	}
+	regressions := gasreport.Regressions(rows)
+	fmt.Printf("\n%d methods measured, %d regressions over %.2f%%\n", len(entries), len(regressions), threshold)
+
+	if update || len(baseline.Entries) == 0 {
+		if err := gasreport.SaveBaseline(baselinePath, entries); err != nil {
+			return false, err
+		}
+		fmt.Println("baseline written to", baselinePath)
+		return false, nil
+	}
+	return len(regressions) > 0, nil
+}
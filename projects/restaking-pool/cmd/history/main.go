@@ -0,0 +1,92 @@
+// Command history prints the timeline of the governance parameter changes
+// of the protocol, as CSV or JSON.
+//
+//	go run ./cmd/history -rpc $RPC_URL -config 0x... -from 19000000 > changes.csv
+//	go run ./cmd/history -rpc $RPC_URL -config 0x... -fee-collectors 0x...,0x... -format json
+//
+// The changes of the contracts ProtocolConfig resolves to today are
+// replayed; FeeCollectors are not listed by ProtocolConfig and are passed
+// with -fee-collectors.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/backfill"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/history"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	collectors := flag.String("fee-collectors", "", "comma-separated FeeCollector addresses")
+	from := flag.Uint64("from", 0, "first block, the protocol deployment")
+	to := flag.Int64("to", -1, "last block, the head when negative")
+	format := flag.String("format", "csv", "output format, csv or json")
+	workers := flag.Int("workers", 4, "concurrent log queries")
+	rate := flag.Float64("rate", 0, "maximum log queries per second, 0 for no limit")
+	flag.Parse()
+	var feeCollectors []common.Address
+	valid := common.IsHexAddress(*config) && (*format == "csv" || *format == "json")
+	for _, s := range strings.Split(*collectors, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			valid = valid && common.IsHexAddress(s)
+			feeCollectors = append(feeCollectors, common.HexToAddress(s))
+		}
+	}
+	if !valid {
+		fmt.Fprintln(os.Stderr, "usage: history -config address [-rpc url] [-fee-collectors addresses] [-from block] [-to block] [-format csv|json] [-workers n] [-rate n]")
+		os.Exit(2)
+	}
+
+	cfg := backfill.Config{From: *from, Workers: *workers, RateLimit: *rate}
+	if err := run(*rpcURL, common.HexToAddress(*config), feeCollectors, *to, *format, cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(rpcURL string, config common.Address, feeCollectors []common.Address, to int64, format string, cfg backfill.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+	if to < 0 {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get head")
+		}
+		to = int64(head)
+	}
+	cfg.To = uint64(to)
+
+	sources, err := history.Sources(c, feeCollectors)
+	if err != nil {
+		return err
+	}
+	entries, _, err := history.Replay(ctx, backend, sources, cfg)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		return history.WriteJSON(os.Stdout, entries)
+	}
+	return history.WriteCSV(os.Stdout, entries)
+}
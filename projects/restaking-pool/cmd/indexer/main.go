@@ -0,0 +1,129 @@
+// Command indexer follows the protocol contracts and stores their logs in a
+// LevelDB database, printing every event it indexes or rolls back.
+//
+//	go run ./cmd/indexer -rpc wss://... -config 0x... -start 19000000 -db ./index
+//
+// A websocket endpoint pushes new heads; over HTTP the indexer polls. A
+// restart resumes at the last indexed block.
+//
+// -webhooks posts the events to the endpoints of a JSON file, a list of
+// webhook.Endpoint, before they are committed:
+//
+//	go run ./cmd/indexer -rpc wss://... -config 0x... -webhooks hooks.json -dead-letter dead.jsonl
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/webhook"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "ws://localhost:8546", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	db := flag.String("db", "index", "database directory")
+	start := flag.Uint64("start", 0, "first block to index, the protocol deployment")
+	confirmations := flag.Uint64("confirmations", 12, "blocks a block waits for, including its own")
+	batch := flag.Uint64("batch", 1000, "blocks per log query")
+	hooks := flag.String("webhooks", "", "JSON file of webhook endpoints")
+	deadLetter := flag.String("dead-letter", "webhooks-dead.jsonl", "file of failed webhook deliveries")
+	flag.Parse()
+	if !common.IsHexAddress(*config) {
+		fmt.Fprintln(os.Stderr, "usage: indexer -config address [-rpc url] [-db dir] [-start block] [-confirmations n] [-batch n] [-webhooks file] [-dead-letter file]")
+		os.Exit(2)
+	}
+
+	cfg := indexer.Config{Start: *start, Confirmations: *confirmations, BatchSize: *batch}
+	if err := run(*rpcURL, common.HexToAddress(*config), *db, cfg, *hooks, *deadLetter); err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(rpcURL string, config common.Address, db string, cfg indexer.Config, hooks, deadLetter string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+	store, err := indexer.Open(db)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	printEvents := func(_ context.Context, events []indexer.Event) error {
+		for _, ev := range events {
+			name := "anonymous"
+			if ev.Decoded != nil {
+				name = fmt.Sprintf("%T", ev.Decoded)
+			} else if len(ev.Log.Topics) > 0 {
+				name = "topic " + ev.Log.Topics[0].Hex()
+			}
+			state := "+"
+			if ev.Log.Removed {
+				state = "-"
+			}
+			fmt.Printf("%s %d/%d %s %s tx=%s\n", state, ev.Log.BlockNumber, ev.Log.Index, ev.Contract, name, ev.Log.TxHash.Hex())
+		}
+		return nil
+	}
+	handler := printEvents
+	if hooks != "" {
+		d, err := dispatcher(hooks, deadLetter)
+		if err != nil {
+			return err
+		}
+		// send the deliveries that failed before the restart first
+		delivered, left, err := d.Redrive(ctx)
+		if err != nil {
+			return err
+		}
+		if delivered+left > 0 {
+			fmt.Fprintf(os.Stderr, "redelivered %d dead letters, %d left\n", delivered, left)
+		}
+		handler = func(ctx context.Context, events []indexer.Event) error {
+			if err := d.Handle(ctx, events); err != nil {
+				return err
+			}
+			return printEvents(ctx, events)
+		}
+	}
+	ix := indexer.New(backend, store, indexer.Contracts(c), cfg, handler)
+	return ix.Run(ctx, func(p indexer.Progress, err error) {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "indexed to %d of %d: %d logs, %d removed\n", p.Checkpoint.Number, p.Head, p.Indexed, p.Removed)
+	})
+}
+
+func dispatcher(path, deadLetter string) (*webhook.Dispatcher, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read webhooks")
+	}
+	var endpoints []webhook.Endpoint
+	if err := json.Unmarshal(data, &endpoints); err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s", path)
+	}
+	return webhook.New(webhook.Config{Endpoints: endpoints, DeadLetter: deadLetter})
+}
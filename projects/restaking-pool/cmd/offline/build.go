@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/offline"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakerdeployer "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerDeployer"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// call is an entry of the calls file.
+type call struct {
+	Contract string        `yaml:"contract"`
+	Address  string        `yaml:"address"`
+	Method   string        `yaml:"method"`
+	Args     []interface{} `yaml:"args"`
+	Value    interface{}   `yaml:"value"`
+}
+
+// transactor is the raw Transactor of a generated wrapper.
+type transactor interface {
+	Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error)
+}
+
+// bindRaw returns the raw Transactor of the wrapper of contract at address.
+func bindRaw(contract string, address common.Address, backend bind.ContractBackend) (transactor, error) {
+	switch contract {
+	case "ProtocolConfig":
+		c, err := protocolconfig.NewContract(address, backend)
+		return &protocolconfig.ContractRaw{Contract: c}, err
+	case "cToken":
+		c, err := ctoken.NewContract(address, backend)
+		return &ctoken.ContractRaw{Contract: c}, err
+	case "RatioFeed":
+		c, err := ratiofeed.NewContract(address, backend)
+		return &ratiofeed.ContractRaw{Contract: c}, err
+	case "RestakingPool":
+		c, err := restakingpool.NewContract(address, backend)
+		return &restakingpool.ContractRaw{Contract: c}, err
+	case "RestakerDeployer":
+		c, err := restakerdeployer.NewContract(address, backend)
+		return &restakerdeployer.ContractRaw{Contract: c}, err
+	case "FeeCollector":
+		c, err := feecollector.NewContract(address, backend)
+		return &feecollector.ContractRaw{Contract: c}, err
+	}
+	return nil, errors.Errorf("unknown contract %s", contract)
+}
+
+func build(args []string) error {
+	flags := flag.NewFlagSet("build", flag.ContinueOnError)
+	rpcURL := flags.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flags.String("config", "", "ProtocolConfig address")
+	from := flags.String("from", "", "sender of the transactions")
+	callsPath := flags.String("calls", "", "YAML file of the calls")
+	out := flags.String("out", "", "file the unsigned transactions are written to")
+	gasMargin := flags.Uint64("gas-margin", 20, "percent added to the gas estimates")
+	if err := flags.Parse(args); err != nil || !common.IsHexAddress(*config) || !common.IsHexAddress(*from) || *callsPath == "" || *out == "" {
+		return flag.ErrHelp
+	}
+	data, err := os.ReadFile(*callsPath)
+	if err != nil {
+		return errors.Wrap(err, "could not read calls")
+	}
+	var calls []call
+	if err := yaml.Unmarshal(data, &calls); err != nil {
+		return errors.Wrapf(err, "could not parse %s", *callsPath)
+	}
+
+	ctx := context.Background()
+	backend, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, common.HexToAddress(*config), backend)
+	if err != nil {
+		return err
+	}
+	addrs := c.Addresses()
+	resolved := map[string]common.Address{
+		"ProtocolConfig":   addrs.ProtocolConfig,
+		"cToken":           addrs.CToken,
+		"RatioFeed":        addrs.RatioFeed,
+		"RestakingPool":    addrs.RestakingPool,
+		"RestakerDeployer": addrs.RestakerDeployer,
+	}
+	b, err := offline.NewBuilder(ctx, backend, common.HexToAddress(*from), *gasMargin)
+	if err != nil {
+		return err
+	}
+	for i, cl := range calls {
+		address, ok := resolved[cl.Contract]
+		if cl.Address != "" {
+			address, ok = common.HexToAddress(cl.Address), common.IsHexAddress(cl.Address)
+		}
+		if !ok {
+			return errors.Errorf("call %d: no address for %s", i+1, cl.Contract)
+		}
+		raw, err := bindRaw(cl.Contract, address, backend)
+		if err != nil {
+			return errors.Wrapf(err, "call %d", i+1)
+		}
+		method, params, err := pack(cl)
+		if err != nil {
+			return errors.Wrapf(err, "call %d", i+1)
+		}
+		err = b.Add(cl.Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			if cl.Value != nil {
+				if opts.Value, err = parse.Amount(cl.Value); err != nil {
+					return nil, err
+				}
+			}
+			return raw.Transact(opts, method, params...)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "call %d", i+1)
+		}
+	}
+	f := b.File()
+	if err := f.Print(os.Stdout); err != nil {
+		return err
+	}
+	return f.Save(*out)
+}
+
+// pack resolves the method of a call with parse.Method and converts its
+// arguments.
+func pack(cl call) (string, []interface{}, error) {
+	meta, ok := offline.ABIs[cl.Contract]
+	if !ok {
+		return "", nil, errors.Errorf("unknown contract %s", cl.Contract)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return "", nil, err
+	}
+	method, err := parse.Method(parsed, cl.Method, len(cl.Args))
+	if err != nil {
+		return "", nil, errors.Wrap(err, cl.Contract)
+	}
+	params := make([]interface{}, len(cl.Args))
+	for i, input := range method.Inputs {
+		if params[i], err = parse.Arg(cl.Args[i], input.Type, nil); err != nil {
+			return "", nil, errors.Wrapf(err, "%s.%s argument %s", cl.Contract, strings.TrimSpace(method.RawName), input.Name)
+		}
+	}
+	return method.Name, params, nil
+}
@@ -0,0 +1,159 @@
+// Command offline builds, signs and broadcasts transactions of a key kept on
+// an air-gapped machine, in three steps exchanging a JSON file:
+//
+//	go run ./cmd/offline build -rpc $RPC_URL -config 0x... -from 0x... -calls calls.yaml -out unsigned.json
+//	go run ./cmd/offline sign -in unsigned.json -out signed.json -keystore governance.json -password password.txt
+//	go run ./cmd/offline broadcast -rpc $RPC_URL -in signed.json
+//
+// build runs online without a key, sign on the offline machine and
+// broadcast online again. sign and broadcast print the calls decoded from
+// the call data and ask for confirmation unless -yes is given. The calls
+// file lists the calls to make; the protocol contracts are resolved from
+// ProtocolConfig and a FeeCollector takes its address. A method overloaded
+// with as many arguments is given by its signature, e.g.
+// "transfer(address,uint256)":
+//
+//	# calls.yaml
+//	- contract: RestakingPool
+//	  method: setMaxTVL
+//	  args: [10000 ether]
+//	- contract: FeeCollector
+//	  address: 0x...
+//	  method: withdraw
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/offline"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/signer"
+)
+
+const usage = `usage:
+  offline build -config address -from address -calls file -out file [-rpc url] [-gas-margin percent]
+  offline sign -in file -out file (-key file | -keystore file [-password file]) [-allow addresses] [-yes]
+  offline broadcast -in file [-rpc url] [-yes]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "build":
+		err = build(os.Args[2:])
+	case "sign":
+		err = sign(os.Args[2:])
+	case "broadcast":
+		err = broadcast(os.Args[2:])
+	default:
+		err = flag.ErrHelp
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func sign(args []string) error {
+	flags := flag.NewFlagSet("sign", flag.ContinueOnError)
+	in := flags.String("in", "", "unsigned transactions")
+	out := flags.String("out", "", "file the signed transactions are written to")
+	key := flags.String("key", "", "file holding the hex private key")
+	keystore := flags.String("keystore", "", "encrypted geth keystore file")
+	password := flags.String("password", "", "file holding the keystore password")
+	allowed := flags.String("allow", "", "comma-separated destinations allowed, all when empty")
+	yes := flags.Bool("yes", false, "sign without asking")
+	if err := flags.Parse(args); err != nil || *in == "" || *out == "" {
+		return flag.ErrHelp
+	}
+	var allow signer.Allowlist
+	for _, a := range strings.Split(*allowed, ",") {
+		if a = strings.TrimSpace(a); a == "" {
+			continue
+		}
+		if !common.IsHexAddress(a) {
+			return errors.Errorf("invalid address %q", a)
+		}
+		allow = append(allow, common.HexToAddress(a))
+	}
+
+	ctx := context.Background()
+	s, err := signer.Open(ctx, signer.Config{Key: *key, Keystore: *keystore, PasswordFile: *password})
+	if err != nil {
+		return err
+	}
+	f, err := review(*in, false)
+	if err != nil {
+		return err
+	}
+	if !*yes && !confirm(fmt.Sprintf("sign %d transactions with %s?", len(f.Transactions), s.Address().Hex())) {
+		return errors.New("not signed")
+	}
+	if err := offline.Sign(ctx, f, s, allow); err != nil {
+		return err
+	}
+	return f.Save(*out)
+}
+
+func broadcast(args []string) error {
+	flags := flag.NewFlagSet("broadcast", flag.ContinueOnError)
+	rpcURL := flags.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	in := flags.String("in", "", "signed transactions")
+	yes := flags.Bool("yes", false, "broadcast without asking")
+	if err := flags.Parse(args); err != nil || *in == "" {
+		return flag.ErrHelp
+	}
+	f, err := review(*in, true)
+	if err != nil {
+		return err
+	}
+	if !*yes && !confirm(fmt.Sprintf("broadcast %d transactions?", len(f.Transactions))) {
+		return errors.New("not broadcast")
+	}
+	ctx := context.Background()
+	backend, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	sent, err := offline.Broadcast(ctx, backend, f)
+	for i, s := range sent {
+		if s.Status != "" {
+			fmt.Printf("transaction %d: %s %s\n", i+1, s.Hash.Hex(), s.Status)
+		}
+	}
+	return err
+}
+
+// review loads and verifies a file and prints its calls.
+func review(path string, signed bool) (*offline.File, error) {
+	f, err := offline.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Verify(signed); err != nil {
+		return nil, err
+	}
+	return f, f.Print(os.Stdout)
+}
+
+func confirm(question string) bool {
+	fmt.Printf("\n%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.EqualFold(strings.TrimSpace(answer), "y")
+}
@@ -0,0 +1,134 @@
+// Command oracle computes the cToken ratio and submits it to RatioFeed
+// every time the 12 hour cadence allows it.
+//
+//	go run ./cmd/oracle -rpc $RPC_URL -config 0x... -keyfile operator.key
+//	go run ./cmd/oracle -rpc $RPC_URL -config 0x... -dry-run -once
+//	go run ./cmd/oracle -simulated -ticks 72
+//
+// The state in -state lets a restarted oracle wait for the update it sent
+// before stopping instead of sending another one. -simulated runs the
+// oracle against a fresh protocol on the simulated backend, with rewards
+// vesting and the chain moving -step forward per tick.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/oracle"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	keyfile := flag.String("keyfile", "", "file holding the hex private key of the operator")
+	statePath := flag.String("state", "oracle-state.json", "file the oracle state persists to")
+	dryRun := flag.Bool("dry-run", false, "compute and check the ratio without submitting it")
+	confirmations := flag.Uint64("confirmations", 3, "blocks an update waits for, including its own")
+	interval := flag.Duration("interval", time.Minute, "delay between two ticks")
+	once := flag.Bool("once", false, "run a single tick and exit")
+	simulated := flag.Bool("simulated", false, "run against a fresh protocol on the simulated backend")
+	ticks := flag.Int("ticks", 72, "ticks to run with -simulated")
+	step := flag.Duration("step", time.Hour, "chain time between two ticks with -simulated")
+	flag.Parse()
+
+	cfg := oracle.Config{
+		StatePath:     *statePath,
+		DryRun:        *dryRun,
+		Confirmations: *confirmations,
+		PollInterval:  *interval,
+	}
+	var err error
+	if *simulated {
+		cfg.StatePath = ""
+		err = runSimulated(cfg, *ticks, *step)
+	} else {
+		if !common.IsHexAddress(*config) || (*keyfile == "" && !*dryRun) {
+			fmt.Fprintln(os.Stderr, "usage: oracle -config address (-keyfile file | -dry-run) [-rpc url] [-state file] [-confirmations n] [-interval d] [-once]")
+			os.Exit(2)
+		}
+		err = run(*rpcURL, common.HexToAddress(*config), *keyfile, cfg, *once)
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(rpcURL string, config common.Address, keyfile string, cfg oracle.Config, once bool) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+
+	var opts *bind.TransactOpts
+	if keyfile != "" {
+		data, err := os.ReadFile(keyfile)
+		if err != nil {
+			return errors.Wrap(err, "could not read key")
+		}
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+		if err != nil {
+			return errors.Wrap(err, "could not parse key")
+		}
+		chainID, err := backend.ChainID(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get chain id")
+		}
+		if opts, err = bind.NewKeyedTransactorWithChainID(key, chainID); err != nil {
+			return err
+		}
+	}
+
+	o, err := oracle.New(c, backend, opts, cfg)
+	if err != nil {
+		return err
+	}
+	if once {
+		r, err := o.Tick(ctx)
+		report(time.Now(), r, err)
+		return err
+	}
+	return o.Run(ctx, func(r *oracle.Result, err error) { report(time.Now(), r, err) })
+}
+
+func report(now time.Time, r *oracle.Result, err error) {
+	stamp := now.UTC().Format(time.RFC3339)
+	if err != nil {
+		fmt.Printf("%s error %v\n", stamp, err)
+		return
+	}
+	line := fmt.Sprintf("%s %s", stamp, r.Action)
+	if r.Ratio != nil {
+		line += " ratio=" + r.Ratio.String()
+	}
+	if r.Tx != (common.Hash{}) {
+		line += " tx=" + r.Tx.Hex()
+	}
+	if r.Reason != "" {
+		line += " (" + r.Reason + ")"
+	}
+	if !r.Next.IsZero() {
+		line += " next=" + r.Next.Format(time.RFC3339)
+	}
+	fmt.Println(line)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/oracle"
+)
+
+// autoCommit mines every transaction as soon as it is sent, like a node
+// would a few seconds later.
+type autoCommit struct {
+	*backends.SimulatedBackend
+}
+
+func (b autoCommit) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.Commit()
+	return nil
+}
+
+// runSimulated stakes from every fixture user, starts a rewards timeline
+// and ticks the oracle while the chain moves step forward per tick.
+func runSimulated(cfg oracle.Config, ticks int, step time.Duration) error {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for _, u := range f.Users {
+		if _, err := f.Send(f.RestakingPool.Stake(u.WithValue(new(big.Int).Mul(big.NewInt(5), fixture.Ether)))); err != nil {
+			return err
+		}
+	}
+	if err := f.AddRewards(fixture.Ether); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	backend := autoCommit{f.Backend}
+	c, err := client.New(ctx, f.ProtocolConfig.Address(), backend)
+	if err != nil {
+		return err
+	}
+	o, err := oracle.New(c, backend, f.Operator.TransactOpts(), cfg)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < ticks; i++ {
+		now, err := f.Now()
+		if err != nil {
+			return err
+		}
+		r, err := o.Tick(ctx)
+		report(now, r, err)
+		if err := f.AdvanceTime(step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"math/big"
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/offline"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+// call is an entry of the calls file.
+type call struct {
+	Contract string        `yaml:"contract"`
+	Address  string        `yaml:"address"`
+	Method   string        `yaml:"method"`
+	Args     []interface{} `yaml:"args"`
+	Value    interface{}   `yaml:"value"`
+}
+
+func readCalls(path string) ([]call, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read calls")
+	}
+	var calls []call
+	if err := yaml.Unmarshal(data, &calls); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s", path)
+	}
+	return calls, nil
+}
+
+// pack encodes a call with the generated ABI of its contract, resolving its
+// method with parse.Method.
+func pack(cl call) (*big.Int, []byte, error) {
+	meta, ok := offline.ABIs[cl.Contract]
+	if !ok {
+		return nil, nil, errors.Errorf("unknown contract %s", cl.Contract)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	method, err := parse.Method(parsed, cl.Method, len(cl.Args))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, cl.Contract)
+	}
+	params := make([]interface{}, len(cl.Args))
+	for i, input := range method.Inputs {
+		if params[i], err = parse.Arg(cl.Args[i], input.Type, nil); err != nil {
+			return nil, nil, errors.Wrapf(err, "%s.%s argument %s", cl.Contract, method.RawName, input.Name)
+		}
+	}
+	data, err := parsed.Pack(method.Name, params...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not encode %s.%s", cl.Contract, method.RawName)
+	}
+	value := new(big.Int)
+	if cl.Value != nil {
+		if value, err = parse.Amount(cl.Value); err != nil {
+			return nil, nil, errors.Wrap(err, "value")
+		}
+	}
+	return value, data, nil
+}
@@ -0,0 +1,234 @@
+// Command safe builds Safe Transaction Builder batches of protocol admin
+// calls and decodes them for review:
+//
+//	go run ./cmd/safe build -rpc $RPC_URL -config 0x... -calls calls.yaml -out batch.json -nonce 42
+//	go run ./cmd/safe decode -rpc $RPC_URL -config 0x... -in batch.json -nonce 42
+//
+// The batch is for the governance Safe ProtocolConfig names unless -safe is
+// given, and is imported in the Transaction Builder of the Safe web app.
+// decode reads batches built here or exported by the Transaction Builder.
+// With -nonce, both print the hash of the Safe transaction executing the
+// batch at that Safe nonce, for the signers to compare with their wallet.
+// The calls file has the format of cmd/offline, overloads given by their
+// signature; the protocol contracts are resolved from ProtocolConfig and a
+// FeeCollector takes its address:
+//
+//	# calls.yaml
+//	- contract: RestakingPool
+//	  method: addRestaker
+//	  args: [p2p]
+//	- contract: RestakingPool
+//	  method: setFlashUnstakeFeeParams
+//	  args: [800000000, 20000000, 1000000000]
+//	- contract: RatioFeed
+//	  method: setRatioThreshold
+//	  args: [5000000]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/safe"
+)
+
+const usage = `usage:
+  safe build -config address -calls file -out file [-rpc url] [-safe address] [-name name] [-description text] [-nonce n] [-multisend address]
+  safe decode -config address -in file [-rpc url] [-fee-collectors addresses] [-nonce n] [-multisend address]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "build":
+		err = build(os.Args[2:])
+	case "decode":
+		err = decode(os.Args[2:])
+	default:
+		err = flag.ErrHelp
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// network is the chain and the protocol contracts of a node.
+type network struct {
+	backend   *ethclient.Client
+	chainID   *big.Int
+	addrs     client.Addresses
+	contracts map[common.Address]string
+}
+
+func connect(ctx context.Context, rpcURL string, config common.Address) (*network, error) {
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect")
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		backend.Close()
+		return nil, errors.Wrap(err, "could not get chain id")
+	}
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+	addrs := c.Addresses()
+	return &network{
+		backend: backend,
+		chainID: chainID,
+		addrs:   addrs,
+		contracts: map[common.Address]string{
+			addrs.ProtocolConfig:   "ProtocolConfig",
+			addrs.CToken:           "cToken",
+			addrs.RatioFeed:        "RatioFeed",
+			addrs.RestakingPool:    "RestakingPool",
+			addrs.RestakerDeployer: "RestakerDeployer",
+		},
+	}, nil
+}
+
+func build(args []string) error {
+	flags := flag.NewFlagSet("build", flag.ContinueOnError)
+	rpcURL := flags.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flags.String("config", "", "ProtocolConfig address")
+	safeAddr := flags.String("safe", "", "Safe executing the batch, the governance when empty")
+	callsPath := flags.String("calls", "", "YAML file of the calls")
+	out := flags.String("out", "", "file the batch is written to")
+	name := flags.String("name", "protocol operations", "batch name")
+	description := flags.String("description", "", "batch description")
+	nonce := flags.Int64("nonce", -1, "Safe nonce to print the Safe transaction hash for")
+	multiSend := flags.String("multisend", safe.MultiSendCallOnly.Hex(), "MultiSendCallOnly executing batches of several calls")
+	if err := flags.Parse(args); err != nil || !common.IsHexAddress(*config) || *callsPath == "" || *out == "" ||
+		*safeAddr != "" && !common.IsHexAddress(*safeAddr) || !common.IsHexAddress(*multiSend) {
+		return flag.ErrHelp
+	}
+	calls, err := readCalls(*callsPath)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	n, err := connect(ctx, *rpcURL, common.HexToAddress(*config))
+	if err != nil {
+		return err
+	}
+	defer n.backend.Close()
+	owner := n.addrs.Governance
+	if *safeAddr != "" {
+		owner = common.HexToAddress(*safeAddr)
+	}
+	b := safe.New(n.chainID, owner, *name)
+	b.Meta.Description = *description
+	resolved := make(map[string]common.Address, len(n.contracts))
+	for a, name := range n.contracts {
+		resolved[name] = a
+	}
+	for i, cl := range calls {
+		to, ok := resolved[cl.Contract]
+		if cl.Address != "" {
+			to, ok = common.HexToAddress(cl.Address), common.IsHexAddress(cl.Address)
+		}
+		if !ok {
+			return errors.Errorf("call %d: no address for %s", i+1, cl.Contract)
+		}
+		value, data, err := pack(cl)
+		if err != nil {
+			return errors.Wrapf(err, "call %d", i+1)
+		}
+		if err := b.AddData(cl.Contract, to, value, data); err != nil {
+			return errors.Wrapf(err, "call %d", i+1)
+		}
+		n.contracts[to] = cl.Contract
+	}
+	if err := b.Save(*out); err != nil {
+		return err
+	}
+	return review(b, n, *nonce, common.HexToAddress(*multiSend))
+}
+
+func decode(args []string) error {
+	flags := flag.NewFlagSet("decode", flag.ContinueOnError)
+	rpcURL := flags.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flags.String("config", "", "ProtocolConfig address")
+	in := flags.String("in", "", "batch file")
+	collectors := flags.String("fee-collectors", "", "comma-separated FeeCollector addresses")
+	nonce := flags.Int64("nonce", -1, "Safe nonce to print the Safe transaction hash for")
+	multiSend := flags.String("multisend", safe.MultiSendCallOnly.Hex(), "MultiSendCallOnly executing batches of several calls")
+	if err := flags.Parse(args); err != nil || !common.IsHexAddress(*config) || *in == "" || !common.IsHexAddress(*multiSend) {
+		return flag.ErrHelp
+	}
+	var feeCollectors []common.Address
+	for _, s := range strings.Split(*collectors, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			if !common.IsHexAddress(s) {
+				return flag.ErrHelp
+			}
+			feeCollectors = append(feeCollectors, common.HexToAddress(s))
+		}
+	}
+	b, err := safe.Load(*in)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	n, err := connect(ctx, *rpcURL, common.HexToAddress(*config))
+	if err != nil {
+		return err
+	}
+	defer n.backend.Close()
+	if b.ChainID != n.chainID.String() {
+		return errors.Errorf("node is on chain %s, batch is for chain %s", n.chainID, b.ChainID)
+	}
+	for _, a := range feeCollectors {
+		n.contracts[a] = "FeeCollector"
+	}
+	return review(b, n, *nonce, common.HexToAddress(*multiSend))
+}
+
+// review prints the calls of b and, for a nonce of zero or more, the hashes
+// of the Safe transaction executing them.
+func review(b *safe.Batch, n *network, nonce int64, multiSend common.Address) error {
+	owner, err := b.Safe()
+	if err != nil {
+		return err
+	}
+	calls, err := b.Decode(n.contracts)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Safe %s on chain %s, %d calls\n", owner.Hex(), b.ChainID, len(calls))
+	for i, c := range calls {
+		fmt.Printf("\ncall %d: %s\n  to:    %s\n  value: %s\n", i+1, c, b.Transactions[i].To.Hex(), b.Transactions[i].Value)
+	}
+	if nonce < 0 {
+		return nil
+	}
+	tx, err := b.Tx(n.contracts, uint64(nonce), multiSend)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\nSafe transaction at nonce %d\n  to:               %s\n  operation:        %d\n  domain separator: %s\n  message hash:     %s\n  safe tx hash:     %s\n",
+		nonce, tx.To.Hex(), tx.Operation, safe.DomainSeparator(n.chainID, owner).Hex(), tx.StructHash().Hex(), tx.Hash(n.chainID, owner).Hex())
+	return nil
+}
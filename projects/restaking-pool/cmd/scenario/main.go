@@ -0,0 +1,59 @@
+// Command scenario runs scenario files against a freshly deployed protocol
+// on the simulated backend and prints a per-step report.
+//
+//	go run ./cmd/scenario pkg/scenario/testdata/*.yaml
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/scenario"
+)
+
+func main() {
+	users := flag.Int("users", 4, "number of funded user accounts")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: scenario [-users n] file.yaml...")
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, path := range flag.Args() {
+		ok, err := run(path, *users)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			os.Exit(1)
+		}
+		failed = failed || !ok
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// run executes one scenario on its own fixture so scenarios cannot affect
+// each other.
+func run(path string, users int) (bool, error) {
+	s, err := scenario.Load(path)
+	if err != nil {
+		return false, err
+	}
+	opts := fixture.DefaultOptions()
+	opts.Users = users
+	f, err := fixture.New(opts)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	runner, err := scenario.NewRunner(f)
+	if err != nil {
+		return false, err
+	}
+	report := runner.Run(s)
+	fmt.Print(report)
+	return !report.Failed(), nil
+}
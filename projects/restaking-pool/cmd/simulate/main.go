@@ -0,0 +1,92 @@
+// Command simulate seeds the RestakingPool simulator from a live node and
+// runs a what-if timeline against it, printing the pool views over time.
+//
+//	go run ./cmd/simulate -rpc $RPC_URL -config 0x... timeline.yaml
+//	go run ./cmd/simulate -rpc $RPC_URL -config 0x... -format csv timeline.yaml > out.csv
+//
+// See pkg/sim for the timeline format.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/sim"
+)
+
+func main() {
+	rpcURL := flag.String("rpc", "http://localhost:8545", "JSON-RPC endpoint")
+	config := flag.String("config", "", "ProtocolConfig address")
+	block := flag.Int64("block", -1, "block to seed from, latest by default")
+	accounts := flag.String("accounts", "", "comma separated accounts whose claimables to seed")
+	format := flag.String("format", "table", "output format: table, csv or json")
+	flag.Parse()
+	if flag.NArg() != 1 || !common.IsHexAddress(*config) {
+		fmt.Fprintln(os.Stderr, "usage: simulate -config address [-rpc url] [-block n] [-accounts a,b] [-format table|csv|json] timeline.yaml")
+		os.Exit(2)
+	}
+
+	if err := run(*rpcURL, common.HexToAddress(*config), *block, *accounts, *format, flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(rpcURL string, config common.Address, block int64, accounts, format, path string) error {
+	timeline, err := sim.LoadTimeline(path)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return errors.Wrap(err, "could not connect")
+	}
+	defer backend.Close()
+	c, err := client.New(ctx, config, backend)
+	if err != nil {
+		return err
+	}
+
+	var number *big.Int
+	if block >= 0 {
+		number = big.NewInt(block)
+	}
+	var addrs []common.Address
+	for _, a := range strings.Split(accounts, ",") {
+		if a = strings.TrimSpace(a); a == "" {
+			continue
+		}
+		if !common.IsHexAddress(a) {
+			return errors.Errorf("invalid account %q", a)
+		}
+		addrs = append(addrs, common.HexToAddress(a))
+	}
+	state, err := sim.Seed(ctx, c, number, addrs...)
+	if err != nil {
+		return err
+	}
+	report, err := sim.Run(state, timeline)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "table":
+		return report.WriteTable(os.Stdout)
+	case "csv":
+		return report.WriteCSV(os.Stdout)
+	case "json":
+		return report.WriteJSON(os.Stdout)
+	}
+	return errors.Errorf("unknown format %q", format)
+}
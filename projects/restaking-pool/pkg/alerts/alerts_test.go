@@ -0,0 +1,294 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/metrics"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+// memory is a sink that keeps the alerts, or fails.
+type memory struct {
+	alerts []Alert
+	fail   bool
+}
+
+func (m *memory) Send(_ context.Context, a Alert) error {
+	if m.fail {
+		return errors.New("sink down")
+	}
+	m.alerts = append(m.alerts, a)
+	return nil
+}
+
+func (m *memory) take() []Alert {
+	out := m.alerts
+	m.alerts = nil
+	return out
+}
+
+// clock is a settable time.
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func engine(t *testing.T) (*Engine, *memory, *clock) {
+	t.Helper()
+	cfg, err := Load("testdata/rules.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.PollInterval() != 30*time.Second {
+		t.Errorf("interval %s", cfg.PollInterval())
+	}
+	if sinks, err := cfg.OpenSinks(); err != nil || len(sinks) != 2 {
+		t.Fatalf("%d sinks: %v", len(sinks), err)
+	}
+	sink := new(memory)
+	e, err := New(cfg.Rules, []Sink{sink})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &clock{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	e.now = c.now
+	return e, sink, c
+}
+
+func event(contract string, decoded interface{ Topic() common.Hash }, block uint64, index uint) indexer.Event {
+	l := types.Log{BlockNumber: block, BlockHash: common.BigToHash(new(big.Int).SetUint64(block)), Index: index, TxHash: common.Hash{byte(block), byte(index)}}
+	switch d := decoded.(type) {
+	case *restakingpool.ContractFlashUnstaked:
+		d.Raw = l
+	case *restakingpool.ContractRestakerAdded:
+		d.Raw = l
+	case *protocolconfig.ContractGovernanceChanged:
+		d.Raw = l
+	}
+	return indexer.Event{Contract: contract, Log: l, Decoded: decoded}
+}
+
+func flash(fee float64, block uint64) indexer.Event {
+	f, _ := new(big.Float).Mul(big.NewFloat(fee), big.NewFloat(1e18)).Int(nil)
+	return event("RestakingPool", &restakingpool.ContractFlashUnstaked{Amount: big.NewInt(1), Shares: big.NewInt(1), Fee: f}, block, 0)
+}
+
+func TestEvents(t *testing.T) {
+	e, sink, clock := engine(t)
+	ctx := context.Background()
+	events := []indexer.Event{
+		flash(0.1, 1),
+		flash(0.6, 2),
+		event("ProtocolConfig", &protocolconfig.ContractGovernanceChanged{}, 2, 1),
+		event("RestakingPool", &restakingpool.ContractRestakerAdded{}, 3, 0),
+		// the contract filter skips a RestakerAdded of another contract
+		event("RestakerDeployer", &restakingpool.ContractRestakerAdded{}, 3, 1),
+		{Contract: "RestakingPool", Log: types.Log{BlockNumber: 3, Index: 2}},
+	}
+	if err := e.Handle(ctx, events); err != nil {
+		t.Fatal(err)
+	}
+	alerts := sink.take()
+	if len(alerts) != 3 {
+		t.Fatalf("alerts %+v", alerts)
+	}
+	if a := alerts[0]; a.Rule != "expensive flash unstake" || a.Event != "FlashUnstaked" || a.Block != 2 || a.Message != "FlashUnstaked fee 0.6 ETH above 0.5 ETH in tx "+a.Tx.Hex() {
+		t.Errorf("flash alert %+v", a)
+	}
+	if a := alerts[1]; a.Rule != "admin changed" || a.Severity != "critical" || a.Contract != "ProtocolConfig" {
+		t.Errorf("admin alert %+v", a)
+	}
+	if a := alerts[2]; a.Rule != "restaker added" || a.Severity != "info" {
+		t.Errorf("restaker alert %+v", a)
+	}
+
+	// events delivered again do not fire twice, removed ones never fire
+	removed := flash(0.7, 4)
+	removed.Log.Removed = true
+	if err := e.Handle(ctx, append(events, removed)); err != nil {
+		t.Fatal(err)
+	}
+	if alerts := sink.take(); len(alerts) != 0 {
+		t.Fatalf("alerts again %+v", alerts)
+	}
+
+	// the cooldown holds back alerts and counts them
+	clock.t = clock.t.Add(5 * time.Minute)
+	if err := e.Handle(ctx, []indexer.Event{flash(0.8, 5), flash(0.9, 6)}); err != nil {
+		t.Fatal(err)
+	}
+	if alerts := sink.take(); len(alerts) != 0 {
+		t.Fatalf("alerts during cooldown %+v", alerts)
+	}
+	clock.t = clock.t.Add(5 * time.Minute)
+
+	// a failing sink fails the step, which the indexer retries
+	sink.fail = true
+	if err := e.Handle(ctx, []indexer.Event{flash(1, 7)}); err == nil {
+		t.Fatal("no error")
+	}
+	sink.fail = false
+	if err := e.Handle(ctx, []indexer.Event{flash(1, 7)}); err != nil {
+		t.Fatal(err)
+	}
+	if alerts := sink.take(); len(alerts) != 1 || alerts[0].Suppressed != 2 || alerts[0].Block != 7 {
+		t.Fatalf("alerts after cooldown %+v", alerts)
+	}
+}
+
+func TestRedelivery(t *testing.T) {
+	cfg, err := Load("testdata/rules.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	marks, err := OpenMarks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer marks.Close()
+	up, down := new(memory), &memory{fail: true}
+	e, err := New(cfg.Rules, []Sink{up, down})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Persist(marks)
+	ctx := context.Background()
+	events := []indexer.Event{flash(0.6, 2)}
+
+	// the retry of a failed step sends to the sinks that did not take the
+	// alert only
+	if err := e.Handle(ctx, events); err == nil {
+		t.Fatal("no error")
+	}
+	if len(up.take()) != 1 || len(down.take()) != 0 {
+		t.Fatal("alert not sent to the working sink")
+	}
+	down.fail = false
+	if err := e.Handle(ctx, events); err != nil {
+		t.Fatal(err)
+	}
+	if a, b := up.take(), down.take(); len(a) != 0 || len(b) != 1 {
+		t.Fatalf("retry sent %+v and %+v", a, b)
+	}
+
+	// the step delivered again after a restart does not fire
+	restarted, err := New(cfg.Rules, []Sink{up, down})
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted.Persist(marks)
+	if err := restarted.Handle(ctx, append(events, flash(0.7, 3))); err != nil {
+		t.Fatal(err)
+	}
+	if a := up.take(); len(a) != 1 || a[0].Block != 3 {
+		t.Fatalf("alerts after restart %+v", a)
+	}
+}
+
+func snapshot(age uint64, assets, max int64) *metrics.Snapshot {
+	eth := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18)) }
+	return &metrics.Snapshot{
+		Block: 100, Time: 1_000_000, LastUpdate: 1_000_000 - age,
+		TotalAssets: eth(assets), MaxTVL: eth(max), TotalPendingUnstakes: eth(1),
+	}
+}
+
+func TestViews(t *testing.T) {
+	e, sink, clock := engine(t)
+	ctx := context.Background()
+	evaluate := func(s *metrics.Snapshot) []Alert {
+		t.Helper()
+		if err := e.Evaluate(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+		return sink.take()
+	}
+
+	if alerts := evaluate(snapshot(3600, 10, 100)); len(alerts) != 0 {
+		t.Fatalf("alerts %+v", alerts)
+	}
+	alerts := evaluate(snapshot(14*3600, 96, 100))
+	if len(alerts) != 2 {
+		t.Fatalf("alerts %+v", alerts)
+	}
+	if a := alerts[0]; a.Rule != "ratio not updated" || a.Message != "ratio_age 14h0m0s above 13h0m0s" {
+		t.Errorf("ratio alert %+v", a)
+	}
+	if a := alerts[1]; a.Rule != "close to max TVL" || a.Message != "max_tvl_headroom 4.00% below 5.00%" {
+		t.Errorf("TVL alert %+v", a)
+	}
+
+	// a firing rule repeats after its cooldown only
+	clock.t = clock.t.Add(30 * time.Minute)
+	if alerts := evaluate(snapshot(14*3600+1800, 96, 100)); len(alerts) != 0 {
+		t.Fatalf("alerts %+v", alerts)
+	}
+	clock.t = clock.t.Add(30 * time.Minute)
+	if alerts := evaluate(snapshot(15*3600, 96, 100)); len(alerts) != 1 || alerts[0].Rule != "ratio not updated" {
+		t.Fatalf("alerts %+v", alerts)
+	}
+
+	// and resolves
+	alerts = evaluate(snapshot(60, 90, 100))
+	if len(alerts) != 2 || !alerts[0].Resolved || !alerts[1].Resolved || alerts[1].Message != "max_tvl_headroom back to 10.00%" {
+		t.Fatalf("alerts %+v", alerts)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for rules, want := range map[string]string{
+		"rules: [{name: a, view: ratio_age}]":                                 "a view without a threshold",
+		"rules: [{name: a, view: ratio_age, above: 5}]":                       "5 is not a duration",
+		"rules: [{name: a, view: nope, above: 5}]":                            "unknown view",
+		"rules: [{name: a, events: [Staked], above: 1}]":                      "a threshold without a field",
+		"rules: [{name: a, events: [Staked], view: ratio, above: 1}]":         "both events and a view",
+		"rules: [{name: a, events: [Staked]}, {name: a, events: [Unstaked]}]": "duplicate name",
+		"rules: [{name: a}]": "neither events nor a view",
+	} {
+		if _, err := Parse([]byte(rules)); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: got %v, want %s", rules, err, want)
+		}
+	}
+}
+
+func TestRatioAge(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, sink, _ := engine(t)
+	if err := f.UpdateRatio(big.NewInt(0.99e18)); err != nil {
+		t.Fatal(err)
+	}
+	for _, advance := range []time.Duration{12 * time.Hour, 2 * time.Hour} {
+		if err := f.AdvanceTime(advance); err != nil {
+			t.Fatal(err)
+		}
+		snap, err := metrics.Read(ctx, c, f.Backend)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Evaluate(ctx, snap); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if alerts := sink.take(); len(alerts) != 1 || alerts[0].Rule != "ratio not updated" {
+		t.Fatalf("alerts %+v", alerts)
+	}
+}
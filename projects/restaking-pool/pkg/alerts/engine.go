@@ -0,0 +1,296 @@
+package alerts
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/metrics"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/webhook"
+)
+
+// Alert is a fired or resolved rule.
+type Alert struct {
+	Rule     string    `json:"rule"`
+	Severity string    `json:"severity"`
+	Message  string    `json:"message"`
+	Time     time.Time `json:"time"`
+	// Resolved is set when the condition of a view rule cleared.
+	Resolved bool `json:"resolved,omitempty"`
+	// Suppressed counts the events the cooldown held back since the
+	// previous alert of the rule.
+	Suppressed int `json:"suppressed,omitempty"`
+
+	// Block is the event or snapshot block.
+	Block    uint64       `json:"block,omitempty"`
+	Contract string       `json:"contract,omitempty"`
+	Event    string       `json:"event,omitempty"`
+	Tx       *common.Hash `json:"tx,omitempty"`
+
+	// key is the event and rule of an event alert.
+	key string
+}
+
+// seenSize bounds the event keys kept for deduplication.
+const seenSize = 10000
+
+// Marks persists the keys of the evaluated events across restarts;
+// *MarkStore implements it.
+type Marks interface {
+	Marked(key string) (bool, error)
+	Mark(keys ...string) error
+}
+
+// Engine evaluates rules and sends the alerts to sinks.
+type Engine struct {
+	rules []*rule
+	sinks []Sink
+	now   func() time.Time
+
+	mu     sync.Mutex
+	states map[string]state
+	seen   map[string]bool
+	order  []string
+	marks  Marks
+	// delivered holds the sinks that took an event alert of a step that
+	// failed, so that the retry skips them.
+	delivered map[string]bool
+}
+
+// state is the alerting state of a rule.
+type state struct {
+	firing     bool
+	last       time.Time
+	suppressed int
+}
+
+// New returns an engine of rules.
+func New(rules []Rule, sinks []Sink) (*Engine, error) {
+	compiled, err := compile(rules)
+	if err != nil {
+		return nil, err
+	}
+	return &Engine{
+		rules:     compiled,
+		sinks:     sinks,
+		now:       time.Now,
+		states:    make(map[string]state),
+		seen:      make(map[string]bool),
+		delivered: make(map[string]bool),
+	}, nil
+}
+
+// Persist keeps the keys of the evaluated events in m as well, so that the
+// events of a step delivered again after a restart do not fire twice.
+func (e *Engine) Persist(m Marks) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.marks = m
+}
+
+// evaluated reports whether the event of key was evaluated.
+func (e *Engine) evaluated(key string) (bool, error) {
+	if e.seen[key] || e.marks == nil {
+		return e.seen[key], nil
+	}
+	return e.marks.Marked(key)
+}
+
+// pending is the outcome of an evaluation, applied once the alerts are
+// sent.
+type pending struct {
+	alerts []Alert
+	states map[string]state
+	seen   []string
+}
+
+func (e *Engine) state(p *pending, name string) state {
+	if s, ok := p.states[name]; ok {
+		return s
+	}
+	return e.states[name]
+}
+
+// fire adds an alert of r unless its cooldown holds it back.
+func (e *Engine) fire(p *pending, r *rule, a Alert) {
+	s := e.state(p, r.Name)
+	now := e.now()
+	if r.cooldown > 0 && !s.last.IsZero() && now.Sub(s.last) < r.cooldown {
+		s.suppressed++
+		p.states[r.Name] = s
+		return
+	}
+	a.Rule, a.Severity, a.Time, a.Suppressed = r.Name, r.Severity, now.UTC(), s.suppressed
+	s.last, s.suppressed = now, 0
+	p.states[r.Name] = s
+	p.alerts = append(p.alerts, a)
+}
+
+// Handle evaluates the event rules over the decoded events of an indexer
+// step; it is an indexer.Handler. Removed events do not fire. An error of a
+// sink fails the step, and the events are evaluated again with the retry,
+// which sends their alerts only to the sinks that did not take them.
+func (e *Engine) Handle(ctx context.Context, events []indexer.Event) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	p := &pending{states: make(map[string]state)}
+	for _, ev := range events {
+		if ev.Decoded == nil || ev.Log.Removed {
+			continue
+		}
+		name := webhook.EventName(ev.Decoded)
+		for _, r := range e.rules {
+			if !r.events[name] || (r.Contract != "" && r.Contract != ev.Contract) {
+				continue
+			}
+			key := r.Name + "/" + webhook.ID(ev.Log)
+			evaluated, err := e.evaluated(key)
+			if err != nil {
+				return err
+			}
+			if evaluated {
+				continue
+			}
+			message := name + " in tx " + ev.Log.TxHash.Hex()
+			if r.Field != "" {
+				value, ok := field(ev.Decoded, r.Field)
+				if !ok || !r.match(value) {
+					continue
+				}
+				message = r.describe(name+" "+r.Field, value) + " in tx " + ev.Log.TxHash.Hex()
+			}
+			p.seen = append(p.seen, key)
+			tx := ev.Log.TxHash
+			e.fire(p, r, Alert{Message: message, Block: ev.Log.BlockNumber, Contract: ev.Contract, Event: name, Tx: &tx, key: key})
+		}
+	}
+	return e.commit(ctx, p)
+}
+
+// field returns the numeric argument of a generated event struct, matched
+// case-insensitively.
+func field(event interface{}, name string) (*big.Rat, bool) {
+	v := reflect.Indirect(reflect.ValueOf(event))
+	f := v.FieldByNameFunc(func(n string) bool { return strings.EqualFold(n, name) })
+	if !f.IsValid() {
+		return nil, false
+	}
+	if x, ok := f.Interface().(*big.Int); ok {
+		return new(big.Rat).SetInt(x), x != nil
+	}
+	if f.CanUint() {
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(f.Uint())), true
+	}
+	if f.CanInt() {
+		return new(big.Rat).SetInt64(f.Int()), true
+	}
+	return nil, false
+}
+
+// Evaluate evaluates the view rules on a snapshot.
+func (e *Engine) Evaluate(ctx context.Context, snap *metrics.Snapshot) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	p := &pending{states: make(map[string]state)}
+	for _, r := range e.rules {
+		if r.view == nil {
+			continue
+		}
+		value := r.view.value(snap)
+		s := e.state(p, r.Name)
+		switch {
+		case r.match(value) && !s.firing:
+			s.firing, s.last = true, time.Time{}
+			p.states[r.Name] = s
+			e.fire(p, r, Alert{Message: r.describe(r.View, value), Block: snap.Block})
+		case r.match(value) && r.cooldown > 0 && e.now().Sub(s.last) >= r.cooldown:
+			// repeat while the condition holds
+			e.fire(p, r, Alert{Message: r.describe(r.View, value), Block: snap.Block})
+		case !r.match(value) && s.firing:
+			s.firing = false
+			p.states[r.Name] = s
+			p.alerts = append(p.alerts, Alert{
+				Rule: r.Name, Severity: r.Severity, Time: e.now().UTC(), Resolved: true, Block: snap.Block,
+				Message: r.View + " back to " + format(value, r.kind),
+			})
+		}
+	}
+	return e.commit(ctx, p)
+}
+
+// commit sends the alerts to every sink and, when all succeed, applies the
+// new states. A sink that took an event alert is skipped when the step is
+// retried; view alerts are evaluated again with the next snapshot, and may
+// reach a sink twice.
+func (e *Engine) commit(ctx context.Context, p *pending) error {
+	var err error
+	for _, a := range p.alerts {
+		for i, sink := range e.sinks {
+			id := a.key + "/" + strconv.Itoa(i)
+			if a.key != "" && e.delivered[id] {
+				continue
+			}
+			if sendErr := sink.Send(ctx, a); sendErr != nil {
+				err = multierr.Append(err, sendErr)
+			} else if a.key != "" {
+				e.delivered[id] = true
+			}
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not send alerts")
+	}
+	if e.marks != nil && len(p.seen) > 0 {
+		if err := e.marks.Mark(p.seen...); err != nil {
+			return err
+		}
+	}
+	for _, a := range p.alerts {
+		for i := range e.sinks {
+			delete(e.delivered, a.key+"/"+strconv.Itoa(i))
+		}
+	}
+	for name, s := range p.states {
+		e.states[name] = s
+	}
+	for _, key := range p.seen {
+		e.seen[key] = true
+		e.order = append(e.order, key)
+	}
+	if n := len(e.order) - seenSize; n > 0 {
+		for _, key := range e.order[:n] {
+			delete(e.seen, key)
+		}
+		e.order = append([]string(nil), e.order[n:]...)
+	}
+	return nil
+}
+
+// Run evaluates the view rules on a snapshot of the protocol resolved by c
+// every interval until ctx is done. Errors are passed to report.
+func (e *Engine) Run(ctx context.Context, c *client.Client, backend metrics.Backend, interval time.Duration, report func(error)) error {
+	for {
+		snap, err := metrics.Read(ctx, c, backend)
+		if err == nil {
+			err = e.Evaluate(ctx, snap)
+		}
+		if err != nil && ctx.Err() == nil {
+			report(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
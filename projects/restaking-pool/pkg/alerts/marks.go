@@ -0,0 +1,47 @@
+package alerts
+
+import (
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// markPrefix prefixes the keys of the evaluated events: m/<key> -> empty.
+var markPrefix = []byte("m/")
+
+// MarkStore implements Marks with a LevelDB database.
+type MarkStore struct {
+	db *leveldb.DB
+}
+
+// OpenMarks opens or creates the mark store at path.
+func OpenMarks(path string) (*MarkStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open marks %s", path)
+	}
+	return &MarkStore{db: db}, nil
+}
+
+// Close closes the database.
+func (s *MarkStore) Close() error {
+	return s.db.Close()
+}
+
+// Marked reports whether key was marked.
+func (s *MarkStore) Marked(key string) (bool, error) {
+	ok, err := s.db.Has(markKey(key), nil)
+	return ok, errors.Wrap(err, "could not read mark")
+}
+
+// Mark records keys in one batch.
+func (s *MarkStore) Mark(keys ...string) error {
+	batch := new(leveldb.Batch)
+	for _, key := range keys {
+		batch.Put(markKey(key), nil)
+	}
+	return errors.Wrap(s.db.Write(batch, nil), "could not write marks")
+}
+
+func markKey(key string) []byte {
+	return append(append([]byte(nil), markPrefix...), key...)
+}
@@ -0,0 +1,302 @@
+// Package alerts evaluates declarative alert rules over the decoded event
+// stream of an indexer and periodic view snapshots, and sends the alerts
+// to pluggable sinks. Rules are written in YAML or JSON:
+//
+//	interval: 1m
+//	rules:
+//	  - name: ratio not updated
+//	    view: ratio_age
+//	    above: 13h
+//	    cooldown: 1h
+//	  - name: expensive flash unstake
+//	    events: [FlashUnstaked]
+//	    field: fee
+//	    above: 0.5 ether
+//	  - name: admin changed
+//	    severity: critical
+//	    events: [GovernanceChanged, OperatorChanged, TreasuryChanged]
+//	  - name: close to max TVL
+//	    view: max_tvl_headroom
+//	    below: 5
+//	sinks:
+//	  - type: stdout
+//	  - type: file
+//	    path: alerts.jsonl
+//	  - type: webhook
+//	    url: https://ops.example.com/alerts
+//	    secret: ...
+//
+// A view rule fires when its condition becomes true, repeats every cooldown
+// while it holds, and sends a resolved alert when it clears. An event rule
+// fires for every matching event, at most once per cooldown. An event seen
+// again does not fire twice: the engine remembers the recent events, and
+// with Persist the events it evaluated before a restart.
+package alerts
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/metrics"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+// Config is a rule file.
+type Config struct {
+	// Interval is the delay between view snapshots, 1 minute by default.
+	Interval string       `yaml:"interval"`
+	Rules    []Rule       `yaml:"rules"`
+	Sinks    []SinkConfig `yaml:"sinks"`
+}
+
+// Rule is an alert rule on either events or a view.
+type Rule struct {
+	Name     string `yaml:"name"`
+	Severity string `yaml:"severity"`
+
+	// Events lists the event names the rule matches.
+	Events []string `yaml:"events"`
+	// Contract restricts the events to a contract, by indexer name such as
+	// RestakingPool.
+	Contract string `yaml:"contract"`
+	// Field is the numeric event argument Above and Below compare, such as
+	// fee. Without it every matching event fires.
+	Field string `yaml:"field"`
+
+	// View is the watched value: total_assets, flash_capacity,
+	// available_to_stake, max_tvl, pending_unstakes, claimable,
+	// free_balance, ctoken_supply, ratio, ratio_age (time since the last
+	// updateRatio) or max_tvl_headroom (room below maxTVL in percent).
+	View string `yaml:"view"`
+
+	// Above and Below are the thresholds: amounts ("0.5 ether") for event
+	// fields and amount views, durations ("13h") for ratio_age, numbers for
+	// percentages.
+	Above interface{} `yaml:"above"`
+	Below interface{} `yaml:"below"`
+
+	// Cooldown is the minimum delay between two alerts of the rule.
+	Cooldown string `yaml:"cooldown"`
+}
+
+// kind is how a view or field value is parsed and printed.
+type kind int
+
+const (
+	amount kind = iota
+	duration
+	percent
+)
+
+// view is a value of a snapshot.
+type view struct {
+	kind  kind
+	value func(s *metrics.Snapshot) *big.Rat
+}
+
+func wei(v func(s *metrics.Snapshot) *big.Int) view {
+	return view{amount, func(s *metrics.Snapshot) *big.Rat { return new(big.Rat).SetInt(v(s)) }}
+}
+
+var views = map[string]view{
+	"total_assets":       wei(func(s *metrics.Snapshot) *big.Int { return s.TotalAssets }),
+	"flash_capacity":     wei(func(s *metrics.Snapshot) *big.Int { return s.FlashCapacity }),
+	"available_to_stake": wei(func(s *metrics.Snapshot) *big.Int { return s.AvailableToStake }),
+	"max_tvl":            wei(func(s *metrics.Snapshot) *big.Int { return s.MaxTVL }),
+	"pending_unstakes":   wei(func(s *metrics.Snapshot) *big.Int { return s.TotalPendingUnstakes }),
+	"claimable":          wei(func(s *metrics.Snapshot) *big.Int { return s.TotalClaimable }),
+	"free_balance":       wei(func(s *metrics.Snapshot) *big.Int { return s.FreeBalance }),
+	"ctoken_supply":      wei(func(s *metrics.Snapshot) *big.Int { return s.TotalSupply }),
+	"ratio":              wei(func(s *metrics.Snapshot) *big.Int { return s.Ratio }),
+	// ratio_age is the time since the last updateRatio, at the snapshot
+	// block.
+	"ratio_age": {duration, func(s *metrics.Snapshot) *big.Rat {
+		return new(big.Rat).SetInt64(int64(s.Time) - int64(s.LastUpdate))
+	}},
+	// max_tvl_headroom is the room left below maxTVL in percent of maxTVL.
+	"max_tvl_headroom": {percent, func(s *metrics.Snapshot) *big.Rat {
+		if s.MaxTVL.Sign() == 0 {
+			return new(big.Rat)
+		}
+		room := new(big.Int).Sub(s.MaxTVL, s.TotalAssets)
+		return new(big.Rat).SetFrac(room.Mul(room, big.NewInt(100)), s.MaxTVL)
+	}},
+}
+
+// rule is a compiled Rule.
+type rule struct {
+	Rule
+	events       map[string]bool
+	view         *view
+	kind         kind
+	above, below *big.Rat
+	cooldown     time.Duration
+}
+
+// Parse decodes a rule file.
+func Parse(data []byte) (*Config, error) {
+	var f Config
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrap(err, "could not parse rules")
+	}
+	if f.Interval != "" {
+		if _, err := parse.Duration(f.Interval); err != nil {
+			return nil, errors.Wrap(err, "interval")
+		}
+	}
+	if _, err := compile(f.Rules); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Load reads and parses a rule file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := Parse(data)
+	return f, errors.Wrap(err, path)
+}
+
+// PollInterval returns the snapshot interval, 1 minute by default.
+func (c *Config) PollInterval() time.Duration {
+	d, err := parse.Duration(c.Interval)
+	if err != nil || d == 0 {
+		return time.Minute
+	}
+	return d
+}
+
+func compile(rules []Rule) ([]*rule, error) {
+	names := make(map[string]bool)
+	out := make([]*rule, 0, len(rules))
+	for i, r := range rules {
+		c, err := compileRule(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule %d (%s)", i, r.Name)
+		}
+		if names[r.Name] {
+			return nil, errors.Errorf("rule %d: duplicate name %q", i, r.Name)
+		}
+		names[r.Name] = true
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func compileRule(r Rule) (*rule, error) {
+	c := &rule{Rule: r}
+	if r.Name == "" {
+		return nil, errors.New("no name")
+	}
+	if c.Severity == "" {
+		c.Severity = "warning"
+	}
+	switch {
+	case len(r.Events) > 0 && r.View != "":
+		return nil, errors.New("both events and a view")
+	case len(r.Events) > 0:
+		c.events = make(map[string]bool)
+		for _, e := range r.Events {
+			c.events[e] = true
+		}
+		if r.Field == "" && (r.Above != nil || r.Below != nil) {
+			return nil, errors.New("a threshold without a field")
+		}
+		if r.Field != "" && r.Above == nil && r.Below == nil {
+			return nil, errors.New("a field without a threshold")
+		}
+	case r.View != "":
+		v, ok := views[r.View]
+		if !ok {
+			return nil, errors.Errorf("unknown view %q", r.View)
+		}
+		c.view, c.kind = &v, v.kind
+		if r.Above == nil && r.Below == nil {
+			return nil, errors.New("a view without a threshold")
+		}
+	default:
+		return nil, errors.New("neither events nor a view")
+	}
+
+	var err error
+	if c.above, err = parseThreshold(r.Above, c.kind); err != nil {
+		return nil, errors.Wrap(err, "above")
+	}
+	if c.below, err = parseThreshold(r.Below, c.kind); err != nil {
+		return nil, errors.Wrap(err, "below")
+	}
+	if r.Cooldown != "" {
+		if c.cooldown, err = parse.Duration(r.Cooldown); err != nil {
+			return nil, errors.Wrap(err, "cooldown")
+		}
+	}
+	return c, nil
+}
+
+func parseThreshold(v interface{}, k kind) (*big.Rat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch k {
+	case duration:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("%v is not a duration", v)
+		}
+		d, err := parse.Duration(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Rat).SetInt64(int64(d / time.Second)), nil
+	case percent:
+		r, ok := new(big.Rat).SetString(strings.TrimSuffix(fmt.Sprint(v), "%"))
+		if !ok {
+			return nil, errors.Errorf("%v is not a percentage", v)
+		}
+		return r, nil
+	}
+	n, err := parse.Amount(v)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).SetInt(n), nil
+}
+
+// match reports whether value crosses the thresholds.
+func (r *rule) match(value *big.Rat) bool {
+	return (r.above != nil && value.Cmp(r.above) > 0) || (r.below != nil && value.Cmp(r.below) < 0)
+}
+
+// describe explains why value fires the rule.
+func (r *rule) describe(name string, value *big.Rat) string {
+	s := name + " " + format(value, r.kind)
+	if r.above != nil && value.Cmp(r.above) > 0 {
+		return s + " above " + format(r.above, r.kind)
+	}
+	if r.below != nil && value.Cmp(r.below) < 0 {
+		return s + " below " + format(r.below, r.kind)
+	}
+	return s
+}
+
+var ether = new(big.Rat).SetInt64(1e18)
+
+func format(v *big.Rat, k kind) string {
+	switch k {
+	case duration:
+		f, _ := v.Float64()
+		return (time.Duration(f) * time.Second).String()
+	case percent:
+		return v.FloatString(2) + "%"
+	}
+	return strings.TrimRight(strings.TrimRight(new(big.Rat).Quo(v, ether).FloatString(6), "0"), ".") + " ETH"
+}
@@ -0,0 +1,159 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/webhook"
+)
+
+// Sink receives alerts.
+type Sink interface {
+	Send(ctx context.Context, a Alert) error
+}
+
+// SinkConfig is a sink of a rule file.
+type SinkConfig struct {
+	// Type is stdout, file or webhook.
+	Type string `yaml:"type"`
+	// Path is the file of a file sink.
+	Path string `yaml:"path"`
+	// URL and Secret configure a webhook sink.
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"`
+}
+
+// NewSink returns the sink of c.
+func NewSink(c SinkConfig) (Sink, error) {
+	switch c.Type {
+	case "stdout":
+		return WriterSink(os.Stdout), nil
+	case "file":
+		if c.Path == "" {
+			return nil, errors.New("file sink without a path")
+		}
+		return FileSink(c.Path), nil
+	case "webhook":
+		if c.URL == "" || c.Secret == "" {
+			return nil, errors.New("webhook sink without a URL or secret")
+		}
+		return WebhookSink(c.URL, c.Secret), nil
+	}
+	return nil, errors.Errorf("unknown sink type %q", c.Type)
+}
+
+// OpenSinks returns the sinks of the configuration.
+func (c *Config) OpenSinks() ([]Sink, error) {
+	sinks := make([]Sink, 0, len(c.Sinks))
+	for i, sc := range c.Sinks {
+		s, err := NewSink(sc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "sink %d", i)
+		}
+		sinks = append(sinks, s)
+	}
+	return sinks, nil
+}
+
+// writerSink prints alerts as text lines.
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WriterSink returns a sink printing one line per alert to w.
+func WriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Send(_ context.Context, a Alert) error {
+	status := a.Severity
+	if a.Resolved {
+		status = "resolved"
+	}
+	line := fmt.Sprintf("%s [%s] %s: %s", a.Time.Format(time.RFC3339), status, a.Rule, a.Message)
+	if a.Suppressed > 0 {
+		line += fmt.Sprintf(" (%d more suppressed)", a.Suppressed)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := fmt.Fprintln(s.w, line)
+	return err
+}
+
+// fileSink appends alerts to a file as JSON lines.
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+// FileSink returns a sink appending alerts to path as JSON lines.
+func FileSink(path string) Sink {
+	return &fileSink{path: path}
+}
+
+func (s *fileSink) Send(_ context.Context, a Alert) error {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "could not open alert file")
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return errors.Wrap(err, "could not write alert")
+	}
+	return errors.Wrap(f.Close(), "could not write alert")
+}
+
+// webhookSink POSTs alerts as JSON, signed like pkg/webhook deliveries.
+type webhookSink struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// WebhookSink returns a sink POSTing every alert as JSON to url, signed with
+// secret in the headers pkg/webhook uses.
+func WebhookSink(url, secret string) Sink {
+	return &webhookSink{url: url, secret: []byte(secret), client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *webhookSink) Send(ctx context.Context, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderTimestamp, timestamp)
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(s.secret, timestamp, body))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not send alert")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("alert webhook answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package apr
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// TestRingDecodingAndReplay updates the ratio for more days than the ring
+// holds, with intraday updates and a repair in between, and checks the
+// stored ring, the replayed one and averagePercentageRate agree.
+func TestRingDecodingAndReplay(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	token := f.CToken.Address()
+
+	// the ratio drops by 0.01% a day
+	ratio := new(big.Int).Set(fixture.Ether)
+	for day := 0; day < 11; day++ {
+		if err := f.AdvanceTime(12 * time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		ratio.Sub(ratio, new(big.Int).Div(ratio, big.NewInt(10000)))
+		if err := f.UpdateRatio(ratio); err != nil {
+			t.Fatal(err)
+		}
+		switch day {
+		case 3:
+			// a second update the same day does not enter the ring
+			if err := f.UpdateRatio(ratio); err != nil {
+				t.Fatal(err)
+			}
+		case 6:
+			if _, err := f.Send(f.RatioFeed.RepairRatio(f.Governance.TransactOpts(), token, ratio)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	header, err := f.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := ReadRing(ctx, f.Backend, f.RatioFeed.Address(), token, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := Replay(ctx, f.Backend, f.RatioFeed, token, 0, header.Number.Uint64())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Ring != *stored {
+		t.Fatalf("replayed ring %+v, stored %+v", h.Ring, *stored)
+	}
+	// the fixture repair, 11 daily updates, an intraday one and a repair
+	if len(h.Points) != 14 || !h.Points[0].Repair || !h.Points[9].Repair {
+		t.Fatalf("%d points", len(h.Points))
+	}
+	if stored.Offset != 11 || len(stored.Ratios()) != 8 || stored.Ratios()[7] != ratio.Uint64() {
+		t.Fatalf("ring %+v", stored)
+	}
+
+	checks, err := CrossCheck(ctx, f.RatioFeed, token, stored, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checks) != 7 {
+		t.Fatalf("%d checks", len(checks))
+	}
+	for _, c := range checks {
+		if !c.Match() {
+			t.Errorf("day %d: contract %s, computed %s", c.Day, c.Contract, c.Computed)
+		}
+	}
+	// 0.01% a day is 3.65% a year
+	if got := new(big.Rat).SetFrac(checks[6].Computed, big.NewInt(1e18)); math.Abs(ratFloat(got)-3.65) > 0.01 {
+		t.Fatalf("7 day rate %s%%", got.FloatString(4))
+	}
+
+	daily := Series(h.Points, 24*time.Hour)
+	if len(daily) == 0 {
+		t.Fatal("no daily samples")
+	}
+	for _, s := range daily {
+		// the intraday update stretches one day to a day and a half
+		if s.Time.Sub(s.From) > 25*time.Hour {
+			continue
+		}
+		if math.Abs(s.APR-3.65) > 0.05 || s.APY < s.APR || math.Abs(s.APY-3.72) > 0.05 {
+			t.Errorf("%s: APR %f, APY %f", s.Time, s.APR, s.APY)
+		}
+	}
+	if points := Series(h.Points, 0); len(points) != len(h.Points)-1 {
+		t.Fatalf("%d point samples for %d points", len(points), len(h.Points))
+	}
+}
+
+func ratFloat(r *big.Rat) float64 {
+	f, _ := r.Float64()
+	return f
+}
+
+func TestRingAveragePercentageRateErrors(t *testing.T) {
+	r := &Ring{Offset: 2}
+	if _, err := r.AveragePercentageRate(0); err == nil {
+		t.Error("day 0 accepted")
+	}
+	if _, err := r.AveragePercentageRate(3); err == nil {
+		t.Error("more days than pushed ratios accepted")
+	}
+}
@@ -0,0 +1,93 @@
+package apr
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+)
+
+// Backend reads the blocks and transactions of the replayed events.
+type Backend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// Point is a ratio at a time.
+type Point struct {
+	Time  time.Time `json:"time"`
+	Block uint64    `json:"block,omitempty"`
+	Ratio *big.Int  `json:"ratio"`
+	// Repair marks a repairRatio, which does not enter the ring.
+	Repair bool `json:"repair,omitempty"`
+}
+
+// History is the replayed ratio history of a token.
+type History struct {
+	// Points lists every RatioUpdated, oldest first.
+	Points []Point
+	// Ring is the HistoricalRatios the updates build.
+	Ring Ring
+}
+
+// Replay reads the RatioUpdated events of token in [start, end] and rebuilds
+// the ring from them. start should be the RatioFeed deployment block for
+// the ring to be complete.
+//
+// repairRatio emits RatioUpdated too but does not push to the ring. It is
+// told apart by the selector of the transaction, which only works when
+// governance calls RatioFeed directly; repairs sent through another
+// contract are taken for updates.
+func Replay(ctx context.Context, backend Backend, feed *ratiofeed.Contract, token common.Address, start, end uint64) (*History, error) {
+	parsed, err := ratiofeed.ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	repair := parsed.Methods["repairRatio"].ID
+
+	it, err := feed.FilterRatioUpdated(&bind.FilterOpts{Start: start, End: &end, Context: ctx}, []common.Address{token})
+	if err != nil {
+		return nil, errors.Wrap(err, "RatioUpdated")
+	}
+	defer it.Close()
+	h := new(History)
+	times := make(map[uint64]time.Time)
+	for it.Next() {
+		ev := it.Event
+		if ev.Raw.Removed {
+			continue
+		}
+		block := ev.Raw.BlockNumber
+		if _, ok := times[block]; !ok {
+			header, err := backend.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get header %d", block)
+			}
+			times[block] = time.Unix(int64(header.Time), 0).UTC()
+		}
+		tx, _, err := backend.TransactionByHash(ctx, ev.Raw.TxHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get transaction %s", ev.Raw.TxHash.Hex())
+		}
+		p := Point{Time: times[block], Block: block, Ratio: ev.NewRatio}
+		p.Repair = tx.To() != nil && *tx.To() == feed.Address() && bytes.HasPrefix(tx.Data(), repair)
+		if !p.Repair {
+			if !ev.NewRatio.IsUint64() {
+				return nil, errors.Errorf("ratio %s of %s does not fit the ring", ev.NewRatio, ev.Raw.TxHash.Hex())
+			}
+			h.Ring.push(ev.NewRatio.Uint64(), uint64(p.Time.Unix()))
+		}
+		h.Points = append(h.Points, p)
+	}
+	if err := it.Error(); err != nil {
+		return nil, errors.Wrap(err, "RatioUpdated")
+	}
+	return h, nil
+}
@@ -0,0 +1,143 @@
+// Package apr decodes the RatioFeed historical ratios and computes APR and
+// APY time series from them.
+//
+// RatioFeed keeps the ratio of the first update of every day in a ring of
+// eight entries that averagePercentageRate reads. The ring can be decoded
+// from storage, since the public getter leaves the array out, or rebuilt
+// by replaying RatioUpdated events, which also gives every intraday update
+// with its timestamp.
+package apr
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/ratiorules"
+)
+
+const (
+	// ringSize is the number of days the ring holds.
+	ringSize = 8
+	// historicalRatiosSlot is the storage slot of the historicalRatios
+	// mapping, after the 50 slots of Configurable and _ratios.
+	historicalRatiosSlot = 51
+	// dailyInterval is how far apart updates must be for the second one to
+	// enter the ring.
+	dailyInterval = 24*time.Hour - time.Minute
+)
+
+var (
+	e20  = new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)
+	days = big.NewInt(365)
+)
+
+// Ring is HistoricalRatios of a token.
+type Ring struct {
+	// Offset is historicalRatios[0], the number of ratios ever pushed.
+	Offset uint64
+	// Slots is historicalRatios[1:]. The n-th pushed ratio, counting from
+	// 1, is at Slots[n%8].
+	Slots [ringSize]uint64
+	// LastUpdate is the timestamp of the last pushed ratio.
+	LastUpdate uint64
+}
+
+// ReadRing decodes the ring of token from the RatioFeed storage at block,
+// nil for the latest.
+func ReadRing(ctx context.Context, backend ratiorules.StorageReader, feed, token common.Address, block *big.Int) (*Ring, error) {
+	base := new(big.Int).SetBytes(crypto.Keccak256(
+		common.LeftPadBytes(token.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(historicalRatiosSlot).Bytes(), 32),
+	))
+	// uint64[9] packs four values per slot and takes three slots, the
+	// uint40 lastUpdate takes the fourth
+	var words [4][]byte
+	for i := range words {
+		slot := common.BigToHash(new(big.Int).Add(base, big.NewInt(int64(i))))
+		word, err := backend.StorageAt(ctx, feed, slot, block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read historical ratios")
+		}
+		words[i] = common.LeftPadBytes(word, 32)
+	}
+	value := func(i int) uint64 {
+		word := words[i/4]
+		end := 32 - (i%4)*8
+		return new(big.Int).SetBytes(word[end-8 : end]).Uint64()
+	}
+	r := &Ring{Offset: value(0), LastUpdate: new(big.Int).SetBytes(words[3][27:]).Uint64()}
+	for i := range r.Slots {
+		r.Slots[i] = value(i + 1)
+	}
+	return r, nil
+}
+
+// push records a ratio like updateRatio does, when the last push is at
+// least a day minus a minute old.
+func (r *Ring) push(ratio uint64, timestamp uint64) bool {
+	if timestamp-r.LastUpdate <= uint64(dailyInterval/time.Second) {
+		return false
+	}
+	r.Slots[(r.Offset+1)%ringSize] = ratio
+	r.Offset++
+	r.LastUpdate = timestamp
+	return true
+}
+
+// Ratios returns the ratios the ring still holds, oldest first.
+func (r *Ring) Ratios() []uint64 {
+	n := r.Offset
+	if n > ringSize {
+		n = ringSize
+	}
+	out := make([]uint64, 0, n)
+	for k := r.Offset - n + 1; k <= r.Offset; k++ {
+		out = append(out, r.Slots[k%ringSize])
+	}
+	return out
+}
+
+// Points returns the ratios of the ring as a series. Only the last one has a
+// known timestamp, the others are placed a day apart, which is what
+// averagePercentageRate assumes.
+func (r *Ring) Points() []Point {
+	ratios := r.Ratios()
+	last := time.Unix(int64(r.LastUpdate), 0).UTC()
+	out := make([]Point, len(ratios))
+	for i, ratio := range ratios {
+		out[i] = Point{
+			Time:  last.Add(-time.Duration(len(ratios)-1-i) * 24 * time.Hour),
+			Ratio: new(big.Int).SetUint64(ratio),
+		}
+	}
+	return out
+}
+
+// AveragePercentageRate is averagePercentageRate of the ring for day, from
+// 1 to 7: the APR between the ratio day pushes ago and the latest one, as a
+// percentage with 18 decimals.
+func (r *Ring) AveragePercentageRate(day uint8) (*big.Int, error) {
+	if day == 0 || day >= ringSize {
+		return nil, errors.New("day should be from 1 to 7")
+	}
+	// the contract underflows and panics
+	if r.Offset < uint64(day) {
+		return nil, errors.Errorf("%d ratios pushed, fewer than %d days", r.Offset, day)
+	}
+	oldest := new(big.Int).SetUint64(r.Slots[(r.Offset-uint64(day))%ringSize])
+	newest := new(big.Int).SetUint64(r.Slots[r.Offset%ringSize])
+	if oldest.Cmp(newest) < 0 {
+		return new(big.Int), nil
+	}
+	if oldest.Sign() == 0 {
+		return nil, errors.New("division by zero: oldest ratio is 0")
+	}
+	rate := new(big.Int).Sub(oldest, newest)
+	rate.Mul(rate, e20).Mul(rate, days)
+	return rate.Quo(rate, new(big.Int).Mul(oldest, big.NewInt(int64(day)))), nil
+}
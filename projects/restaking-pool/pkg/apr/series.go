@@ -0,0 +1,135 @@
+package apr
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+)
+
+const year = 365 * 24 * time.Hour
+
+// Sample is the rate earned between two points of a series.
+type Sample struct {
+	Time  time.Time `json:"time"`
+	Block uint64    `json:"block,omitempty"`
+	Ratio *big.Int  `json:"ratio"`
+	// From is the time of the earlier point the rate is measured from.
+	From      time.Time `json:"from"`
+	FromRatio *big.Int  `json:"fromRatio"`
+	// APR is the annualized relative ratio decrease in percent, the formula
+	// of averagePercentageRate with the exact elapsed time.
+	APR float64 `json:"apr"`
+	// APY is the compounded annual growth of the ETH a cToken is worth, in
+	// percent.
+	APY float64 `json:"apy"`
+}
+
+// Rate returns the APR and APY in percent between from and to.
+func Rate(from, to Point) (apr, apy float64, err error) {
+	elapsed := to.Time.Sub(from.Time)
+	if elapsed <= 0 {
+		return 0, 0, errors.Errorf("%s is not after %s", to.Time, from.Time)
+	}
+	if from.Ratio.Sign() <= 0 || to.Ratio.Sign() <= 0 {
+		return 0, 0, errors.New("ratio is not positive")
+	}
+	periods := float64(year) / float64(elapsed)
+	decrease, _ := new(big.Rat).SetFrac(new(big.Int).Sub(from.Ratio, to.Ratio), from.Ratio).Float64()
+	growth, _ := new(big.Rat).SetFrac(from.Ratio, to.Ratio).Float64()
+	return decrease * periods * 100, (math.Pow(growth, periods) - 1) * 100, nil
+}
+
+// Series returns a sample for every point that has an earlier point at
+// least window before it, measured from the latest such point. A window of
+// 0 gives the point rates between consecutive points.
+func Series(points []Point, window time.Duration) []Sample {
+	var out []Sample
+	j := -1
+	for i, p := range points {
+		// j is the latest point at least window before p
+		for j+1 < i && !points[j+1].Time.After(p.Time.Add(-window)) {
+			j++
+		}
+		if j < 0 || !p.Time.After(points[j].Time) {
+			continue
+		}
+		from := points[j]
+		apr, apy, err := Rate(from, p)
+		if err != nil {
+			continue
+		}
+		out = append(out, Sample{Time: p.Time, Block: p.Block, Ratio: p.Ratio, From: from.Time, FromRatio: from.Ratio, APR: apr, APY: apy})
+	}
+	return out
+}
+
+// WriteCSV writes the samples with ratios in wei and rates in percent.
+func WriteCSV(w io.Writer, samples []Sample) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"time", "block", "ratio", "from", "fromRatio", "apr", "apy"}); err != nil {
+		return err
+	}
+	for _, s := range samples {
+		record := []string{
+			s.Time.Format(time.RFC3339), strconv.FormatUint(s.Block, 10), s.Ratio.String(),
+			s.From.Format(time.RFC3339), s.FromRatio.String(),
+			strconv.FormatFloat(s.APR, 'f', 6, 64), strconv.FormatFloat(s.APY, 'f', 6, 64),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the samples as a JSON array.
+func WriteJSON(w io.Writer, samples []Sample) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(samples)
+}
+
+// Check compares averagePercentageRate for a number of days with the rate
+// computed from a ring.
+type Check struct {
+	Day      uint8
+	Contract *big.Int
+	Computed *big.Int
+}
+
+// Match reports whether both rates are equal.
+func (c Check) Match() bool {
+	return c.Contract.Cmp(c.Computed) == 0
+}
+
+// CrossCheck calls averagePercentageRate at block for every day the ring
+// has enough ratios for and compares it with the rate computed from ring,
+// which should be decoded or replayed at the same block.
+func CrossCheck(ctx context.Context, feed *ratiofeed.Contract, token common.Address, ring *Ring, block *big.Int) ([]Check, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+	var out []Check
+	for day := uint8(1); day < ringSize && uint64(day) <= ring.Offset; day++ {
+		want, err := feed.AveragePercentageRate(opts, token, day)
+		if err != nil {
+			return nil, errors.Wrapf(err, "averagePercentageRate(%d)", day)
+		}
+		got, err := ring.AveragePercentageRate(day)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, Check{Day: day, Contract: want, Computed: got})
+	}
+	return out, nil
+}
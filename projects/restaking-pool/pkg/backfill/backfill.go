@@ -0,0 +1,323 @@
+// Package backfill reads the historical logs of a block range quickly.
+//
+// The range is cut into chunks that a pool of workers queries with
+// eth_getLogs. A chunk the provider rejects for returning too many results
+// is halved until it fits; transient and rate limit errors are retried with
+// a backoff, and all requests share a rate limiter. Whatever order the
+// chunks complete in, the decoded events are emitted in (block, log index)
+// order.
+package backfill
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Config configures a backfill.
+type Config struct {
+	// Addresses and Topics filter the logs like in eth_getLogs.
+	Addresses []common.Address
+	Topics    [][]common.Hash
+	// From and To are the first and last block, inclusive.
+	From, To uint64
+	// ChunkSize is the number of blocks a chunk starts with, 2000 by
+	// default.
+	ChunkSize uint64
+	// Workers is the number of concurrent requests, 4 by default.
+	Workers int
+	// RateLimit is the maximum number of requests per second, unlimited
+	// when 0.
+	RateLimit float64
+	// Retries is the number of retries of a failed request, 5 by default.
+	Retries int
+	// Backoff is the delay before the first retry, doubled at every retry,
+	// 500 milliseconds by default.
+	Backoff time.Duration
+	// TooManyResults reports whether err rejects a range for its size,
+	// IsTooManyResults by default.
+	TooManyResults func(err error) bool
+}
+
+func (c Config) withDefaults() Config {
+	if c.ChunkSize == 0 {
+		c.ChunkSize = 2000
+	}
+	if c.Workers <= 0 {
+		c.Workers = 4
+	}
+	if c.Retries == 0 {
+		c.Retries = 5
+	}
+	if c.Backoff == 0 {
+		c.Backoff = 500 * time.Millisecond
+	}
+	if c.TooManyResults == nil {
+		c.TooManyResults = IsTooManyResults
+	}
+	return c
+}
+
+// Event is a log with its decoded form. Decoded is nil for logs the
+// decoder does not know.
+type Event struct {
+	Log     types.Log
+	Decoded interface{}
+}
+
+// Stats counts the work of a backfill.
+type Stats struct {
+	Requests int
+	Splits   int
+	Retries  int
+	Logs     int
+}
+
+// chunk is a block range and its position in the output order.
+type chunk struct {
+	seq      int
+	from, to uint64
+}
+
+type result struct {
+	seq    int
+	events []Event
+	err    error
+}
+
+// Run backfills the logs of cfg through backend and passes every decoded
+// event to emit in chain order. It stops at the first error of a chunk
+// that cannot be split further, of the decoder or of emit.
+func Run(ctx context.Context, backend ethereum.LogFilterer, cfg Config, decoder Decoder, emit func(Event) error) (Stats, error) {
+	cfg = cfg.withDefaults()
+	var stats Stats
+	if cfg.To < cfg.From {
+		return stats, nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	var chunks []chunk
+	for from := cfg.From; ; {
+		to := cfg.To
+		if cfg.To-from >= cfg.ChunkSize {
+			to = from + cfg.ChunkSize - 1
+		}
+		chunks = append(chunks, chunk{seq: len(chunks), from: from, to: to})
+		if to == cfg.To {
+			break
+		}
+		from = to + 1
+	}
+
+	f := &fetcher{backend: backend, cfg: cfg, decoder: decoder, limiter: newLimiter(cfg.RateLimit)}
+	// window bounds the chunks fetched ahead of the next one to emit, so
+	// one slow chunk does not buffer the whole range
+	window := make(chan struct{}, 2*cfg.Workers)
+	jobs := make(chan chunk)
+	results := make(chan result)
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				events, err := f.fetch(ctx, c.from, c.to)
+				select {
+				case results <- result{seq: c.seq, events: events, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for _, c := range chunks {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- c:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	// stop the workers and the dispatcher before returning
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	pending := make(map[int][]Event)
+	for next := 0; next < len(chunks); {
+		var r result
+		select {
+		case r = <-results:
+		case <-ctx.Done():
+			return f.stats(), ctx.Err()
+		}
+		if r.err != nil {
+			c := chunks[r.seq]
+			return f.stats(), errors.Wrapf(r.err, "blocks %d-%d", c.from, c.to)
+		}
+		pending[r.seq] = r.events
+		for events, ok := pending[next]; ok; events, ok = pending[next] {
+			delete(pending, next)
+			for _, e := range events {
+				if err := emit(e); err != nil {
+					return f.stats(), err
+				}
+			}
+			<-window
+			next++
+		}
+	}
+	return f.stats(), nil
+}
+
+// fetcher queries and decodes chunks.
+type fetcher struct {
+	backend ethereum.LogFilterer
+	cfg     Config
+	decoder Decoder
+	limiter *limiter
+
+	mu sync.Mutex
+	st Stats
+}
+
+func (f *fetcher) count(update func(*Stats)) {
+	f.mu.Lock()
+	update(&f.st)
+	f.mu.Unlock()
+}
+
+func (f *fetcher) stats() Stats {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.st
+}
+
+// fetch returns the sorted, decoded events of [from, to], halving the
+// range as long as the provider finds it too large.
+func (f *fetcher) fetch(ctx context.Context, from, to uint64) ([]Event, error) {
+	logs, err := f.query(ctx, from, to)
+	if err != nil && f.cfg.TooManyResults(err) {
+		if from == to {
+			return nil, errors.Wrapf(err, "block %d alone has too many results", from)
+		}
+		f.count(func(s *Stats) { s.Splits++ })
+		mid := from + (to-from)/2
+		first, err := f.fetch(ctx, from, mid)
+		if err != nil {
+			return nil, err
+		}
+		second, err := f.fetch(ctx, mid+1, to)
+		if err != nil {
+			return nil, err
+		}
+		return append(first, second...), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	events := make([]Event, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		e := Event{Log: l}
+		if f.decoder != nil {
+			if e.Decoded, err = f.decoder.Decode(l); err != nil {
+				return nil, errors.Wrapf(err, "could not decode log %d of block %d", l.Index, l.BlockNumber)
+			}
+		}
+		events = append(events, e)
+	}
+	f.count(func(s *Stats) { s.Logs += len(events) })
+	return events, nil
+}
+
+// query runs one eth_getLogs, retrying the errors that are not about the
+// range size.
+func (f *fetcher) query(ctx context.Context, from, to uint64) ([]types.Log, error) {
+	q := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: f.cfg.Addresses,
+		Topics:    f.cfg.Topics,
+	}
+	backoff := f.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		if err := f.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+		f.count(func(s *Stats) { s.Requests++ })
+		logs, err := f.backend.FilterLogs(ctx, q)
+		if err == nil || f.cfg.TooManyResults(err) || attempt == f.cfg.Retries || ctx.Err() != nil {
+			return logs, err
+		}
+		f.count(func(s *Stats) { s.Retries++ })
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// limiter spaces requests evenly at a rate.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(rate float64) *limiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until the next request slot.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
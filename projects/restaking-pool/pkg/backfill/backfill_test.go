@@ -0,0 +1,302 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+var pool = common.HexToAddress("0x5ca1ab1e00000000000000000000000000000001")
+
+// rpcError is a JSON-RPC error with a code.
+type rpcError struct {
+	code int
+	msg  string
+}
+
+func (e rpcError) Error() string  { return e.msg }
+func (e rpcError) ErrorCode() int { return e.code }
+
+// fakeNode serves eth_getLogs like a provider that caps the results of a
+// query, fails some requests once, and answers with random latency and in
+// random order.
+type fakeNode struct {
+	logs  []types.Log
+	limit int
+	// flaky fails the first query from each of these blocks.
+	flaky map[uint64]bool
+
+	mu       sync.Mutex
+	calls    []time.Time
+	tooMany  int
+	failures int
+}
+
+type filterArgs struct {
+	FromBlock hexutil.Uint64   `json:"fromBlock"`
+	ToBlock   hexutil.Uint64   `json:"toBlock"`
+	Address   []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+func (n *fakeNode) GetLogs(ctx context.Context, args filterArgs) ([]types.Log, error) {
+	n.mu.Lock()
+	n.calls = append(n.calls, time.Now())
+	from, to := uint64(args.FromBlock), uint64(args.ToBlock)
+	if n.flaky[from] {
+		delete(n.flaky, from)
+		n.failures++
+		n.mu.Unlock()
+		return nil, rpcError{-32005, "daily request count exceeded, request rate limited"}
+	}
+	n.mu.Unlock()
+	time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+
+	var out []types.Log
+	for _, l := range n.logs {
+		if l.BlockNumber < from || l.BlockNumber > to {
+			continue
+		}
+		if len(args.Address) > 0 && l.Address != args.Address[0] {
+			continue
+		}
+		if len(args.Topics) > 0 && len(args.Topics[0]) > 0 && l.Topics[0] != args.Topics[0][0] {
+			continue
+		}
+		out = append(out, l)
+	}
+	if len(out) > n.limit {
+		n.mu.Lock()
+		n.tooMany++
+		n.mu.Unlock()
+		return nil, rpcError{-32005, fmt.Sprintf("query returned more than %d results", n.limit)}
+	}
+	rand.Shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
+	return out, nil
+}
+
+func (n *fakeNode) serve(t *testing.T) *ethclient.Client {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", n); err != nil {
+		t.Fatal(err)
+	}
+	http := httptest.NewServer(server)
+	backend, err := ethclient.Dial(http.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		backend.Close()
+		http.Close()
+		server.Stop()
+	})
+	return backend
+}
+
+// stakedLogs returns count encoded Staked events in blocks 0 to blocks-1,
+// half of them crowded in a few blocks.
+func stakedLogs(t *testing.T, count int, blocks uint64) []types.Log {
+	t.Helper()
+	parsed, err := restakingpool.ContractMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	staked := parsed.Events["Staked"]
+	rng := rand.New(rand.NewSource(1))
+	perBlock := make(map[uint64]uint)
+	logs := make([]types.Log, 0, count)
+	for i := 0; i < count; i++ {
+		block := uint64(rng.Int63n(int64(blocks)))
+		if i%2 == 0 {
+			block = 5000 + uint64(rng.Intn(16))
+		}
+		data, err := staked.Inputs.NonIndexed().Pack(big.NewInt(int64(i)), big.NewInt(int64(2*i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		logs = append(logs, types.Log{
+			Address:     pool,
+			Topics:      []common.Hash{staked.ID, common.BytesToHash(common.BigToAddress(big.NewInt(int64(i))).Bytes())},
+			Data:        data,
+			BlockNumber: block,
+			BlockHash:   common.BigToHash(new(big.Int).SetUint64(block)),
+			TxHash:      common.BigToHash(big.NewInt(int64(i))),
+			Index:       perBlock[block],
+		})
+		perBlock[block]++
+	}
+	return logs
+}
+
+func ordered(t *testing.T, events []Event, want int) {
+	t.Helper()
+	if len(events) != want {
+		t.Fatalf("%d events, want %d", len(events), want)
+	}
+	for i := 1; i < len(events); i++ {
+		a, b := events[i-1].Log, events[i].Log
+		if a.BlockNumber > b.BlockNumber || a.BlockNumber == b.BlockNumber && a.Index >= b.Index {
+			t.Fatalf("event %d (%d/%d) after (%d/%d)", i, b.BlockNumber, b.Index, a.BlockNumber, a.Index)
+		}
+	}
+}
+
+func TestRunWrapper(t *testing.T) {
+	node := &fakeNode{
+		logs:  stakedLogs(t, 3000, 10000),
+		limit: 200,
+		flaky: map[uint64]bool{0: true, 6000: true},
+	}
+	backend := node.serve(t)
+
+	parsed, err := restakingpool.ContractMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapper, err := restakingpool.NewContract(pool, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []Event
+	stats, err := Run(context.Background(), backend, Config{
+		Addresses: []common.Address{pool},
+		To:        9999,
+		ChunkSize: 1000,
+		Workers:   8,
+		Backoff:   time.Millisecond,
+	}, Wrapper(parsed, wrapper), func(e Event) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ordered(t, events, len(node.logs))
+	for _, e := range events {
+		staked, ok := e.Decoded.(*restakingpool.ContractStaked)
+		if !ok {
+			t.Fatalf("decoded %T", e.Decoded)
+		}
+		i := e.Log.TxHash.Big()
+		if staked.Amount.Cmp(i) != 0 || staked.Shares.Cmp(new(big.Int).Lsh(i, 1)) != 0 || staked.Staker != common.BigToAddress(i) {
+			t.Fatalf("log %s decoded as %+v", e.Log.TxHash.Hex(), staked)
+		}
+	}
+	if stats.Splits == 0 || stats.Splits != node.tooMany {
+		t.Errorf("%d splits for %d too many results errors", stats.Splits, node.tooMany)
+	}
+	if stats.Retries != node.failures || stats.Retries != 2 {
+		t.Errorf("%d retries for %d failures", stats.Retries, node.failures)
+	}
+	if stats.Requests != len(node.calls) || stats.Logs != len(node.logs) {
+		t.Errorf("stats %+v, %d calls", stats, len(node.calls))
+	}
+}
+
+func TestRunABI(t *testing.T) {
+	node := &fakeNode{logs: stakedLogs(t, 500, 10000), limit: 1000}
+	backend := node.serve(t)
+	parsed, err := restakingpool.ContractMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an unknown event is emitted undecoded
+	unknown := types.Log{Address: pool, Topics: []common.Hash{{1}}, BlockNumber: 9999, BlockHash: common.Hash{2}, Index: 1000}
+	node.logs = append(node.logs, unknown)
+
+	var events []Event
+	_, err = Run(context.Background(), backend, Config{To: 9999, ChunkSize: 700, Workers: 3}, ABI(parsed), func(e Event) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ordered(t, events, len(node.logs))
+	for _, e := range events[:len(events)-1] {
+		ev, ok := e.Decoded.(*ABIEvent)
+		if !ok || ev.Name != "Staked" {
+			t.Fatalf("decoded %+v", e.Decoded)
+		}
+		i := e.Log.TxHash.Big()
+		if ev.Args["amount"].(*big.Int).Cmp(i) != 0 || ev.Args["staker"].(common.Address) != common.BigToAddress(i) {
+			t.Fatalf("log %s decoded as %v", e.Log.TxHash.Hex(), ev.Args)
+		}
+	}
+	if last := events[len(events)-1]; last.Decoded != nil {
+		t.Errorf("unknown event decoded as %+v", last.Decoded)
+	}
+}
+
+func TestRunRateLimit(t *testing.T) {
+	node := &fakeNode{limit: 1}
+	backend := node.serve(t)
+	const rate = 100
+	_, err := Run(context.Background(), backend, Config{To: 39, ChunkSize: 2, Workers: 8, RateLimit: rate}, nil, func(Event) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(node.calls) != 20 {
+		t.Fatalf("%d calls", len(node.calls))
+	}
+	// every window of a second holds at most rate requests, that is the
+	// calls are spaced 1/rate apart on average
+	elapsed := node.calls[len(node.calls)-1].Sub(node.calls[0])
+	if min := time.Duration(len(node.calls)-1) * time.Second / rate; elapsed < min*9/10 {
+		t.Errorf("%d calls in %s, want at least %s", len(node.calls), elapsed, min)
+	}
+}
+
+func TestRunStops(t *testing.T) {
+	node := &fakeNode{logs: stakedLogs(t, 1000, 10000), limit: 1000}
+	backend := node.serve(t)
+	stop := errors.New("stop")
+	var n int
+	_, err := Run(context.Background(), backend, Config{To: 9999, ChunkSize: 100, Workers: 4}, nil, func(Event) error {
+		if n++; n == 10 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Fatalf("got %v", err)
+	}
+
+	// a single block over the limit cannot be split
+	node.limit = 10
+	_, err = Run(context.Background(), backend, Config{From: 5000, To: 5003, Backoff: time.Millisecond}, nil, func(Event) error { return nil })
+	if err == nil || !IsTooManyResults(err) {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestIsTooManyResults(t *testing.T) {
+	for msg, want := range map[string]bool{
+		"query returned more than 10000 results":                                                    true,
+		"Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range": true,
+		"block range is too wide":                                                                   true,
+		"eth_getLogs is limited to a 10,000 range":                                                  true,
+		"429 Too Many Requests":                                                                     false,
+		"daily request count exceeded, request rate limited":                                        false,
+		"execution reverted":                                                                        false,
+	} {
+		if got := IsTooManyResults(errors.Wrap(errors.New(msg), "blocks")); got != want {
+			t.Errorf("%q: got %v", msg, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package backfill
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+)
+
+// Decoder decodes a log. It returns nil for logs it does not know.
+type Decoder interface {
+	Decode(log types.Log) (interface{}, error)
+}
+
+// DecoderFunc adapts a function to Decoder.
+type DecoderFunc func(log types.Log) (interface{}, error)
+
+// Decode calls f.
+func (f DecoderFunc) Decode(log types.Log) (interface{}, error) {
+	return f(log)
+}
+
+// LogParser is implemented by every generated wrapper with events.
+type LogParser interface {
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+}
+
+// Wrapper decodes logs into the event structs of a generated wrapper, for
+// example *restakingpool.ContractStaked. The wrapper does not need a
+// backend: restakingpool.NewContract(address, nil) decodes fine.
+func Wrapper(events *abi.ABI, p LogParser) Decoder {
+	return DecoderFunc(func(log types.Log) (interface{}, error) {
+		if len(log.Topics) == 0 {
+			return nil, nil
+		}
+		if _, err := events.EventByID(log.Topics[0]); err != nil {
+			return nil, nil
+		}
+		return p.ParseLog(log)
+	})
+}
+
+// ABIEvent is a log decoded with a raw ABI.
+type ABIEvent struct {
+	Name string
+	// Args holds the indexed and non-indexed arguments by name. Indexed
+	// dynamic arguments are only available as their hash.
+	Args map[string]interface{}
+	Raw  types.Log
+}
+
+// ABI decodes logs into ABIEvent with a raw ABI.
+func ABI(a *abi.ABI) Decoder {
+	return DecoderFunc(func(log types.Log) (interface{}, error) {
+		if len(log.Topics) == 0 {
+			return nil, nil
+		}
+		event, err := a.EventByID(log.Topics[0])
+		if err != nil {
+			return nil, nil
+		}
+		args := make(map[string]interface{})
+		if len(log.Data) > 0 {
+			if err := event.Inputs.UnpackIntoMap(args, log.Data); err != nil {
+				return nil, errors.Wrap(err, event.Name)
+			}
+		}
+		var indexed abi.Arguments
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				indexed = append(indexed, input)
+			}
+		}
+		if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+			return nil, errors.Wrap(err, event.Name)
+		}
+		return &ABIEvent{Name: event.Name, Args: args, Raw: log}, nil
+	})
+}
@@ -0,0 +1,39 @@
+package backfill
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// tooManyResults are fragments of the errors providers return for an
+// eth_getLogs range that matches too many logs or spans too many blocks.
+var tooManyResults = []string{
+	"query returned more than",       // Infura, geth based nodes
+	"log response size exceeded",     // Alchemy
+	"response size exceeded",         // Erigon
+	"too many results",               //
+	"logs matched by query exceeds",  // Ankr
+	"block range is too wide",        // Chainstack
+	"block range too large",          //
+	"range is too large",             // QuickNode
+	"exceed maximum block range",     // BlastAPI
+	"query exceeds max results",      // Nethermind
+	"eth_getlogs is limited to",      // QuickNode
+	"requested too many blocks from", // Cloudflare
+}
+
+// IsTooManyResults reports whether err rejects an eth_getLogs range for its
+// size, so that a smaller range would succeed.
+func IsTooManyResults(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(errors.Cause(err).Error())
+	for _, fragment := range tooManyResults {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
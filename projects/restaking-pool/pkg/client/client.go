@@ -0,0 +1,271 @@
+// Package client provides a high-level entry point to a deployed protocol.
+// Everything is discovered from the ProtocolConfig contract, which is the
+// root of trust for component addresses.
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakerdeployer "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerDeployer"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// Addresses is the set of component addresses resolved from ProtocolConfig.
+type Addresses struct {
+	ProtocolConfig   common.Address
+	CToken           common.Address
+	RatioFeed        common.Address
+	RestakingPool    common.Address
+	RestakerDeployer common.Address
+	Governance       common.Address
+	Operator         common.Address
+	Treasury         common.Address
+}
+
+// Client holds bound wrappers for every protocol component. The wrappers are
+// swapped atomically whenever the configuration changes, so callers should
+// fetch them from the Client on each use instead of caching them.
+type Client struct {
+	backend bind.ContractBackend
+	config  *protocolconfig.Contract
+
+	mu               sync.RWMutex
+	addrs            Addresses
+	cToken           *ctoken.Contract
+	ratioFeed        *ratiofeed.Contract
+	restakingPool    *restakingpool.Contract
+	restakerDeployer *restakerdeployer.Contract
+}
+
+// New binds the ProtocolConfig at configAddress and resolves all components.
+func New(ctx context.Context, configAddress common.Address, backend bind.ContractBackend) (*Client, error) {
+	config, err := protocolconfig.NewContract(configAddress, backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not bind ProtocolConfig")
+	}
+	c := &Client{backend: backend, config: config}
+	if err := c.Resolve(ctx); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Resolve re-reads every component address from ProtocolConfig and rebinds
+// the wrappers.
+func (c *Client) Resolve(ctx context.Context) error {
+	opts := &bind.CallOpts{Context: ctx}
+	addrs := Addresses{ProtocolConfig: c.config.Address()}
+	var err error
+	if addrs.CToken, err = c.config.GetCToken(opts); err != nil {
+		return errors.Wrap(err, "could not get cToken")
+	}
+	if addrs.RatioFeed, err = c.config.GetRatioFeed(opts); err != nil {
+		return errors.Wrap(err, "could not get RatioFeed")
+	}
+	if addrs.RestakingPool, err = c.config.GetRestakingPool(opts); err != nil {
+		return errors.Wrap(err, "could not get RestakingPool")
+	}
+	if addrs.RestakerDeployer, err = c.config.GetRestakerDeployer(opts); err != nil {
+		return errors.Wrap(err, "could not get RestakerDeployer")
+	}
+	if addrs.Governance, err = c.config.GetGovernance(opts); err != nil {
+		return errors.Wrap(err, "could not get governance")
+	}
+	if addrs.Operator, err = c.config.GetOperator(opts); err != nil {
+		return errors.Wrap(err, "could not get operator")
+	}
+	if addrs.Treasury, err = c.config.GetTreasury(opts); err != nil {
+		return errors.Wrap(err, "could not get treasury")
+	}
+	return c.bind(addrs)
+}
+
+func (c *Client) bind(addrs Addresses) error {
+	token, err := ctoken.NewContract(addrs.CToken, c.backend)
+	if err != nil {
+		return errors.Wrap(err, "could not bind cToken")
+	}
+	feed, err := ratiofeed.NewContract(addrs.RatioFeed, c.backend)
+	if err != nil {
+		return errors.Wrap(err, "could not bind RatioFeed")
+	}
+	pool, err := restakingpool.NewContract(addrs.RestakingPool, c.backend)
+	if err != nil {
+		return errors.Wrap(err, "could not bind RestakingPool")
+	}
+	deployer, err := restakerdeployer.NewContract(addrs.RestakerDeployer, c.backend)
+	if err != nil {
+		return errors.Wrap(err, "could not bind RestakerDeployer")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addrs = addrs
+	c.cToken = token
+	c.ratioFeed = feed
+	c.restakingPool = pool
+	c.restakerDeployer = deployer
+	return nil
+}
+
+// Backend returns the backend the wrappers are bound to.
+func (c *Client) Backend() bind.ContractBackend {
+	return c.backend
+}
+
+// Addresses returns the currently resolved component addresses.
+func (c *Client) Addresses() Addresses {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.addrs
+}
+
+// ProtocolConfig returns the root configuration contract.
+func (c *Client) ProtocolConfig() *protocolconfig.Contract {
+	return c.config
+}
+
+// CToken returns the bound cToken.
+func (c *Client) CToken() *ctoken.Contract {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cToken
+}
+
+// RatioFeed returns the bound RatioFeed.
+func (c *Client) RatioFeed() *ratiofeed.Contract {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ratioFeed
+}
+
+// RestakingPool returns the bound RestakingPool.
+func (c *Client) RestakingPool() *restakingpool.Contract {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.restakingPool
+}
+
+// RestakerDeployer returns the bound RestakerDeployer.
+func (c *Client) RestakerDeployer() *restakerdeployer.Contract {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.restakerDeployer
+}
+
+// Wrappers returns the wrappers of every component, the known contracts of a
+// generated.Waiter.
+func (c *Client) Wrappers() []generated.Wrapper {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return []generated.Wrapper{c.config, c.cToken, c.ratioFeed, c.restakingPool, c.restakerDeployer}
+}
+
+// changeTopics are the topics of the ProtocolConfig events HandleLog applies.
+var changeTopics = []common.Hash{
+	protocolconfig.ContractCTokenChanged{}.Topic(),
+	protocolconfig.ContractRatioFeedChanged{}.Topic(),
+	protocolconfig.ContractRestakingPoolChanged{}.Topic(),
+	protocolconfig.ContractRestakerDeployerChanged{}.Topic(),
+	protocolconfig.ContractGovernanceChanged{}.Topic(),
+	protocolconfig.ContractOperatorChanged{}.Topic(),
+	protocolconfig.ContractTreasuryChanged{}.Topic(),
+}
+
+// HandleLog applies a ProtocolConfig log to the client. It reports whether
+// the log changed the resolved addresses. Removed (reorged) logs trigger a
+// full re-resolution since the previous value is no longer known. Logs of
+// other events, such as the Upgraded event of the proxy, are ignored.
+func (c *Client) HandleLog(ctx context.Context, log types.Log) (bool, error) {
+	if log.Address != c.config.Address() || len(log.Topics) == 0 || !isChange(log.Topics[0]) {
+		return false, nil
+	}
+	if log.Removed {
+		return true, c.Resolve(ctx)
+	}
+	decoded, err := c.config.ParseLog(log)
+	if err != nil {
+		return false, err
+	}
+
+	addrs := c.Addresses()
+	switch e := decoded.(type) {
+	case *protocolconfig.ContractCTokenChanged:
+		addrs.CToken = e.NewValue
+	case *protocolconfig.ContractRatioFeedChanged:
+		addrs.RatioFeed = e.NewValue
+	case *protocolconfig.ContractRestakingPoolChanged:
+		addrs.RestakingPool = e.NewValue
+	case *protocolconfig.ContractRestakerDeployerChanged:
+		addrs.RestakerDeployer = e.NewValue
+	case *protocolconfig.ContractGovernanceChanged:
+		addrs.Governance = e.NewValue
+	case *protocolconfig.ContractOperatorChanged:
+		addrs.Operator = e.NewValue
+	case *protocolconfig.ContractTreasuryChanged:
+		addrs.Treasury = e.NewValue
+	default:
+		return false, nil
+	}
+	if addrs == c.Addresses() {
+		return false, nil
+	}
+	return true, c.bind(addrs)
+}
+
+func isChange(topic common.Hash) bool {
+	for _, t := range changeTopics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch subscribes to the ProtocolConfig logs HandleLog applies and keeps
+// the client up to date until the subscription is closed or ctx is done.
+// Every applied change is reported to onChange, which may be nil.
+func (c *Client) Watch(ctx context.Context, onChange func(Addresses)) (event.Subscription, error) {
+	logs := make(chan types.Log)
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{c.config.Address()},
+		Topics:    [][]common.Hash{changeTopics},
+	}
+	sub, err := c.backend.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to ProtocolConfig logs")
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				changed, err := c.HandleLog(ctx, log)
+				if err != nil {
+					return err
+				}
+				if changed && onChange != nil {
+					onChange(c.Addresses())
+				}
+			case err := <-sub.Err():
+				return err
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
@@ -0,0 +1,135 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+func TestClient(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := client.Addresses{
+		ProtocolConfig:   f.ProtocolConfig.Address(),
+		CToken:           f.CToken.Address(),
+		RatioFeed:        f.RatioFeed.Address(),
+		RestakingPool:    f.RestakingPool.Address(),
+		RestakerDeployer: f.RestakerDeployer.Address(),
+		Governance:       f.Governance.Address,
+		Operator:         f.Operator.Address,
+		Treasury:         f.Treasury.Address,
+	}
+	if got := c.Addresses(); got != want {
+		t.Fatalf("addresses %+v, want %+v", got, want)
+	}
+
+	// a Changed log rebinds the wrapper
+	feed := common.HexToAddress("0x00000000000000000000000000000000000feed1")
+	receipt, err := f.Send(f.ProtocolConfig.SetRatioFeed(f.Governance.TransactOpts(), feed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changedLog := *receipt.Logs[0]
+	if changed, err := c.HandleLog(ctx, changedLog); err != nil || !changed {
+		t.Fatalf("RatioFeedChanged applied %v: %v", changed, err)
+	}
+	if c.Addresses().RatioFeed != feed || c.RatioFeed().Address() != feed {
+		t.Fatalf("RatioFeed bound to %s, want %s", c.RatioFeed().Address(), feed)
+	}
+	if changed, err := c.HandleLog(ctx, changedLog); err != nil || changed {
+		t.Errorf("log applied twice changed the addresses %v: %v", changed, err)
+	}
+
+	// a removed log re-resolves: the reorg put the old feed back
+	if _, err := f.Send(f.ProtocolConfig.SetRatioFeed(f.Governance.TransactOpts(), f.RatioFeed.Address())); err != nil {
+		t.Fatal(err)
+	}
+	removed := changedLog
+	removed.Removed = true
+	if changed, err := c.HandleLog(ctx, removed); err != nil || !changed {
+		t.Fatalf("removed log applied %v: %v", changed, err)
+	}
+	if c.RatioFeed().Address() != f.RatioFeed.Address() {
+		t.Fatalf("RatioFeed bound to %s after the removed log, want %s", c.RatioFeed().Address(), f.RatioFeed.Address())
+	}
+
+	// logs of other contracts are ignored
+	poolLog := changedLog
+	poolLog.Address = f.RestakingPool.Address()
+	if changed, err := c.HandleLog(ctx, poolLog); err != nil || changed {
+		t.Errorf("log of another contract applied %v: %v", changed, err)
+	}
+
+	// events of the proxy are not in the ProtocolConfig ABI and are ignored
+	upgraded := changedLog
+	upgraded.Topics = []common.Hash{crypto.Keccak256Hash([]byte("Upgraded(address)")), common.BytesToHash(feed.Bytes())}
+	for _, removed := range []bool{false, true} {
+		upgraded.Removed = removed
+		if changed, err := c.HandleLog(ctx, upgraded); err != nil || changed {
+			t.Errorf("Upgraded log removed %v applied %v: %v", removed, changed, err)
+		}
+	}
+
+	// Resolve reads changes the client has not seen
+	treasury := f.Users[0].Address
+	if _, err := f.Send(f.ProtocolConfig.SetTreasury(f.Governance.TransactOpts(), treasury)); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Resolve(ctx); err != nil || c.Addresses().Treasury != treasury {
+		t.Fatalf("treasury %s after Resolve: %v", c.Addresses().Treasury, err)
+	}
+
+	// Watch applies changes as they are mined
+	changes := make(chan client.Addresses, 1)
+	sub, err := c.Watch(ctx, func(a client.Addresses) { changes <- a })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+	pool := common.HexToAddress("0x00000000000000000000000000000000000b0011")
+	if _, err := f.Send(f.ProtocolConfig.SetRestakingPool(f.Governance.TransactOpts(), pool)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case a := <-changes:
+		if a.RestakingPool != pool || c.RestakingPool().Address() != pool {
+			t.Errorf("RestakingPool %s after the watched change, want %s", a.RestakingPool, pool)
+		}
+	case err := <-sub.Err():
+		t.Fatalf("watch stopped: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watched change not applied")
+	}
+
+	// Watch stops with its context
+	watchCtx, cancel := context.WithCancel(ctx)
+	stopped, err := c.Watch(watchCtx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stopped.Unsubscribe()
+	cancel()
+	select {
+	case err := <-stopped.Err():
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("watch stopped with %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch not stopped by its context")
+	}
+}
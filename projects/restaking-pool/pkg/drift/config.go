@@ -0,0 +1,208 @@
+// Package drift compares the governance parameters of a deployed protocol
+// with the values a per-network config file wants, and builds the setter
+// calls that reconcile them. A config file lists only the parameters it
+// checks:
+//
+//	network: mainnet
+//	chainId: 1
+//	protocolConfig: 0x...
+//	restakingPool:
+//	  minStake: 0.01 ether
+//	  maxTVL: 10000 ether
+//	  distributeGasLimit: 250000
+//	  maxBonusRate: 15e7
+//	  optimalBonusRate: 25e6
+//	  stakeUtilizationKink: 25e8
+//	  protocolFee: 50e8
+//	  rewardsTimeline: 7d
+//	ratioFeed:
+//	  ratioThreshold: 1e7
+//	feeCollectors:
+//	  - address: 0x...
+//	    commission: 1000
+//
+// Parameters set by one setter, such as the three stake bonus parameters,
+// are reconciled by one call that keeps the live value of the members the
+// file does not list.
+package drift
+
+import (
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+// Config is the desired configuration of a network.
+type Config struct {
+	Network string `yaml:"network"`
+	// ChainID, when set, is checked against the node.
+	ChainID        uint64 `yaml:"chainId"`
+	ProtocolConfig string `yaml:"protocolConfig"`
+
+	RestakingPool map[string]interface{} `yaml:"restakingPool"`
+	RatioFeed     map[string]interface{} `yaml:"ratioFeed"`
+	FeeCollectors []FeeCollector         `yaml:"feeCollectors"`
+}
+
+// FeeCollector is the desired configuration of a FeeCollector, which
+// ProtocolConfig does not list.
+type FeeCollector struct {
+	Address    string      `yaml:"address"`
+	Commission interface{} `yaml:"commission"`
+}
+
+// kind is how a parameter is parsed and printed.
+type kind int
+
+const (
+	number kind = iota
+	amount
+	duration
+)
+
+// param is a governance parameter of a contract. Parameters sharing a
+// setter are its arguments, in the order they are listed.
+type param struct {
+	name   string
+	kind   kind
+	setter string
+}
+
+var (
+	poolParams = []param{
+		{"minStake", amount, "setMinStake"},
+		{"minUnstake", amount, "setMinUnstake"},
+		{"maxTVL", amount, "setMaxTVL"},
+		{"distributeGasLimit", number, "setDistributeGasLimit"},
+		{"maxBonusRate", number, "setStakeBonusParams"},
+		{"optimalBonusRate", number, "setStakeBonusParams"},
+		{"stakeUtilizationKink", number, "setStakeBonusParams"},
+		{"maxFlashFeeRate", number, "setFlashUnstakeFeeParams"},
+		{"optimalUnstakeRate", number, "setFlashUnstakeFeeParams"},
+		{"unstakeUtilizationKink", number, "setFlashUnstakeFeeParams"},
+		{"protocolFee", number, "setProtocolFee"},
+		{"targetCapacity", number, "setTargetFlashCapacity"},
+		{"rewardsTimeline", duration, "setRewardsTimeline"},
+	}
+	feedParams      = []param{{"ratioThreshold", number, "setRatioThreshold"}}
+	collectorParams = []param{{"commission", number, "setCommission"}}
+)
+
+// Parse decodes a config file. JSON is accepted as well since it is a
+// subset of YAML.
+func Parse(data []byte) (*Config, error) {
+	var c Config
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, errors.Wrap(err, "could not parse config")
+	}
+	if !common.IsHexAddress(c.ProtocolConfig) {
+		return nil, errors.Errorf("invalid protocolConfig %q", c.ProtocolConfig)
+	}
+	if _, err := desired(poolParams, c.RestakingPool); err != nil {
+		return nil, errors.Wrap(err, "restakingPool")
+	}
+	if _, err := desired(feedParams, c.RatioFeed); err != nil {
+		return nil, errors.Wrap(err, "ratioFeed")
+	}
+	for i, fc := range c.FeeCollectors {
+		if !common.IsHexAddress(fc.Address) {
+			return nil, errors.Errorf("feeCollectors %d: invalid address %q", i, fc.Address)
+		}
+		if _, err := desired(collectorParams, fc.values()); err != nil {
+			return nil, errors.Wrapf(err, "feeCollectors %d", i)
+		}
+	}
+	return &c, nil
+}
+
+// Load reads and parses a config file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(data)
+	return c, errors.Wrap(err, path)
+}
+
+func (fc FeeCollector) values() map[string]interface{} {
+	if fc.Commission == nil {
+		return nil
+	}
+	return map[string]interface{}{"commission": fc.Commission}
+}
+
+// desired parses the values of a config section.
+func desired(params []param, values map[string]interface{}) (map[string]*big.Int, error) {
+	kinds := make(map[string]kind, len(params))
+	for _, p := range params {
+		kinds[p.name] = p.kind
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make(map[string]*big.Int, len(values))
+	for _, name := range names {
+		k, ok := kinds[name]
+		if !ok {
+			return nil, errors.Errorf("unknown parameter %q", name)
+		}
+		v, err := parseValue(values[name], k)
+		if err != nil {
+			return nil, errors.Wrap(err, name)
+		}
+		out[name] = v
+	}
+	return out, nil
+}
+
+func parseValue(v interface{}, k kind) (*big.Int, error) {
+	if s, ok := v.(string); ok && k == duration {
+		d, err := parse.Duration(s)
+		if err != nil {
+			return nil, err
+		}
+		if d%time.Second != 0 {
+			return nil, errors.Errorf("%s is not a whole number of seconds", s)
+		}
+		return big.NewInt(int64(d / time.Second)), nil
+	}
+	n, err := parse.Amount(v)
+	if err != nil {
+		return nil, err
+	}
+	if n.Sign() < 0 {
+		return nil, errors.Errorf("negative value %s", n)
+	}
+	return n, nil
+}
+
+var ether = new(big.Rat).SetInt64(1e18)
+
+// format prints a value of kind k.
+func format(v *big.Int, k kind) string {
+	switch k {
+	case amount:
+		eth := strings.TrimRight(strings.TrimRight(new(big.Rat).Quo(new(big.Rat).SetInt(v), ether).FloatString(18), "0"), ".")
+		return eth + " ether"
+	case duration:
+		if !v.IsInt64() {
+			return v.String() + "s"
+		}
+		if s := v.Int64(); s%86400 == 0 && s > 0 {
+			return big.NewInt(s/86400).String() + "d"
+		}
+		return (time.Duration(v.Int64()) * time.Second).String()
+	}
+	return v.String()
+}
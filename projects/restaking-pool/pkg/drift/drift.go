@@ -0,0 +1,303 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/ratiorules"
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+// Backend is what a check needs from a node.
+type Backend interface {
+	bind.ContractBackend
+	ratiorules.StorageReader
+}
+
+// Diff is a parameter whose live value is not the desired one.
+type Diff struct {
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	Param    string         `json:"param"`
+	Live     *big.Int       `json:"live"`
+	Desired  *big.Int       `json:"desired"`
+
+	kind kind
+}
+
+// Call is a setter call reconciling one or more parameters, sent with no
+// value by the governance.
+type Call struct {
+	Contract string         `json:"contract"`
+	To       common.Address `json:"to"`
+	// Method is the setter signature, such as setMaxTVL(uint256).
+	Method string        `json:"method"`
+	Args   []string      `json:"args"`
+	Data   hexutil.Bytes `json:"data"`
+}
+
+// Batch is the calls in the shape of the scheduleBatch and executeBatch
+// arguments of an OpenZeppelin TimelockController.
+type Batch struct {
+	Targets  []common.Address `json:"targets"`
+	Values   []string         `json:"values"`
+	Payloads []hexutil.Bytes  `json:"payloads"`
+}
+
+// Report is the outcome of a check.
+type Report struct {
+	Network string `json:"network,omitempty"`
+	ChainID uint64 `json:"chainId,omitempty"`
+	// Block is the block every live value was read at.
+	Block   uint64 `json:"block"`
+	Checked int    `json:"checked"`
+	Diffs   []Diff `json:"diffs"`
+	Calls   []Call `json:"calls"`
+	Batch   Batch  `json:"batch"`
+}
+
+// Storage slots of the RestakingPool parameters without a getter:
+// Configurable takes slots 0 to 49. getMinStake and getMinUnstake return at
+// least the smallest amount the cToken converts, not the configured value.
+const (
+	minStakeSlot           = 50
+	minUnstakeSlot         = 51
+	distributeGasLimitSlot = 58
+)
+
+// read is a live parameter read.
+type read struct {
+	name string
+	call func(*bind.CallOpts) (*big.Int, error)
+}
+
+func u64(call func(*bind.CallOpts) (uint64, error)) func(*bind.CallOpts) (*big.Int, error) {
+	return func(opts *bind.CallOpts) (*big.Int, error) {
+		v, err := call(opts)
+		return new(big.Int).SetUint64(v), err
+	}
+}
+
+func u16(call func(*bind.CallOpts) (uint16, error)) func(*bind.CallOpts) (*big.Int, error) {
+	return func(opts *bind.CallOpts) (*big.Int, error) {
+		v, err := call(opts)
+		return new(big.Int).SetUint64(uint64(v)), err
+	}
+}
+
+// slot reads the low bits of a storage slot.
+func slot(ctx context.Context, backend Backend, address common.Address, index int64, bits uint) func(*bind.CallOpts) (*big.Int, error) {
+	return func(opts *bind.CallOpts) (*big.Int, error) {
+		value, err := backend.StorageAt(ctx, address, common.BigToHash(big.NewInt(index)), opts.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+		return mask.And(mask, new(big.Int).SetBytes(value)), nil
+	}
+}
+
+// target is a contract to check.
+type target struct {
+	contract string
+	address  common.Address
+	meta     *bind.MetaData
+	params   []param
+	reads    []read
+	desired  map[string]interface{}
+}
+
+// Check reads the live parameters of the protocol resolved by c at the head
+// block and compares them with cfg.
+func Check(ctx context.Context, c *client.Client, backend Backend, cfg *Config) (*Report, error) {
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head")
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	r := &Report{Network: cfg.Network, ChainID: cfg.ChainID, Block: head.Number.Uint64(), Diffs: []Diff{}, Calls: []Call{}}
+
+	pool, feed := c.RestakingPool(), c.RatioFeed()
+	targets := []target{{
+		contract: "RestakingPool", address: pool.Address(), meta: restakingpool.ContractMetaData,
+		params: poolParams, desired: cfg.RestakingPool,
+		reads: []read{
+			{"minStake", slot(ctx, backend, pool.Address(), minStakeSlot, 256)},
+			{"minUnstake", slot(ctx, backend, pool.Address(), minUnstakeSlot, 256)},
+			{"maxTVL", pool.MaxTVL},
+			{"distributeGasLimit", slot(ctx, backend, pool.Address(), distributeGasLimitSlot, 32)},
+			{"maxBonusRate", u64(pool.MaxBonusRate)},
+			{"optimalBonusRate", u64(pool.OptimalBonusRate)},
+			{"stakeUtilizationKink", u64(pool.StakeUtilizationKink)},
+			{"maxFlashFeeRate", u64(pool.MaxFlashFeeRate)},
+			{"optimalUnstakeRate", u64(pool.OptimalUnstakeRate)},
+			{"unstakeUtilizationKink", u64(pool.UnstakeUtilizationKink)},
+			{"protocolFee", u64(pool.ProtocolFee)},
+			{"targetCapacity", u64(pool.TargetCapacity)},
+			{"rewardsTimeline", pool.RewardsTimeline},
+		},
+	}, {
+		contract: "RatioFeed", address: feed.Address(), meta: ratiofeed.ContractMetaData,
+		params: feedParams, desired: cfg.RatioFeed,
+		reads: []read{{"ratioThreshold", feed.RatioThreshold}},
+	}}
+	for _, fc := range cfg.FeeCollectors {
+		collector, err := feecollector.NewContract(common.HexToAddress(fc.Address), backend)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not bind FeeCollector")
+		}
+		targets = append(targets, target{
+			contract: "FeeCollector", address: collector.Address(), meta: feecollector.ContractMetaData,
+			params: collectorParams, desired: fc.values(),
+			reads: []read{{"commission", u16(collector.Commission)}},
+		})
+	}
+
+	for _, t := range targets {
+		if err := r.check(opts, t); err != nil {
+			return nil, errors.Wrapf(err, "%s %s at block %d", t.contract, t.address.Hex(), r.Block)
+		}
+	}
+	for _, call := range r.Calls {
+		r.Batch.Targets = append(r.Batch.Targets, call.To)
+		r.Batch.Values = append(r.Batch.Values, "0")
+		r.Batch.Payloads = append(r.Batch.Payloads, call.Data)
+	}
+	return r, nil
+}
+
+// check compares the desired parameters of t and adds the diffs and calls.
+func (r *Report) check(opts *bind.CallOpts, t target) error {
+	want, err := desired(t.params, t.desired)
+	if err != nil || len(want) == 0 {
+		return err
+	}
+	live := make(map[string]*big.Int, len(t.reads))
+	for _, rd := range t.reads {
+		if live[rd.name], err = rd.call(opts); err != nil {
+			return errors.Wrapf(err, "could not read %s", rd.name)
+		}
+	}
+
+	drifted := make(map[string]bool)
+	for _, p := range t.params {
+		v, ok := want[p.name]
+		if !ok {
+			continue
+		}
+		r.Checked++
+		if v.Cmp(live[p.name]) != 0 {
+			r.Diffs = append(r.Diffs, Diff{Contract: t.contract, Address: t.address, Param: p.name, Live: live[p.name], Desired: v, kind: p.kind})
+			drifted[p.setter] = true
+		}
+	}
+	if len(drifted) == 0 {
+		return nil
+	}
+	parsed, err := t.meta.GetAbi()
+	if err != nil {
+		return err
+	}
+	done := make(map[string]bool)
+	for _, p := range t.params {
+		if !drifted[p.setter] || done[p.setter] {
+			continue
+		}
+		done[p.setter] = true
+		var values []*big.Int
+		for _, q := range t.params {
+			if q.setter != p.setter {
+				continue
+			}
+			if v, ok := want[q.name]; ok {
+				values = append(values, v)
+			} else {
+				values = append(values, live[q.name])
+			}
+		}
+		call, err := pack(parsed, t.address, p.setter, values)
+		if err != nil {
+			return err
+		}
+		call.Contract = t.contract
+		r.Calls = append(r.Calls, *call)
+	}
+	return nil
+}
+
+// pack encodes a setter call, converting the values to the argument types.
+func pack(parsed *abi.ABI, to common.Address, setter string, values []*big.Int) (*Call, error) {
+	method, ok := parsed.Methods[setter]
+	if !ok || len(method.Inputs) != len(values) {
+		return nil, errors.Errorf("no setter %s with %d arguments", setter, len(values))
+	}
+	args := make([]interface{}, len(values))
+	texts := make([]string, len(values))
+	for i, v := range values {
+		input := method.Inputs[i].Type
+		if input.T != abi.UintTy || v.BitLen() > input.Size {
+			return nil, errors.Errorf("%s: %s does not fit %s", setter, v, input)
+		}
+		switch input.Size {
+		case 8, 16, 32, 64:
+			args[i] = convert(v.Uint64(), input.Size)
+		default:
+			args[i] = v
+		}
+		texts[i] = v.String()
+	}
+	data, err := parsed.Pack(setter, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not pack %s", setter)
+	}
+	return &Call{To: to, Method: method.Sig, Args: texts, Data: data}, nil
+}
+
+// convert returns v as the Go type the ABI packer expects for a uint of
+// size bits.
+func convert(v uint64, size int) interface{} {
+	switch size {
+	case 8:
+		return uint8(v)
+	case 16:
+		return uint16(v)
+	case 32:
+		return uint32(v)
+	}
+	return v
+}
+
+// Drifted reports whether a parameter differs from the config.
+func (r *Report) Drifted() bool {
+	return len(r.Diffs) > 0
+}
+
+// Print writes the diffs and the calls reconciling them.
+func (r *Report) Print(w io.Writer) error {
+	name := r.Network
+	if name == "" {
+		name = "network"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s at block %d: %d parameters checked, %d drifted\n", name, r.Block, r.Checked, len(r.Diffs))
+	for _, d := range r.Diffs {
+		fmt.Fprintf(&b, "  %s.%s: %s, want %s\n", d.Contract, d.Param, format(d.Live, d.kind), format(d.Desired, d.kind))
+	}
+	for i, c := range r.Calls {
+		fmt.Fprintf(&b, "\ncall %d: %s.%s(%s)\n  to:    %s\n  value: 0\n  data:  %s\n", i+1, c.Contract, strings.SplitN(c.Method, "(", 2)[0], strings.Join(c.Args, ", "), c.To.Hex(), c.Data)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,138 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// config is a config file of the fixture network, with the given sections
+// after protocolConfig.
+func config(t *testing.T, f *fixture.Fixture, sections string) *Config {
+	t.Helper()
+	c, err := Parse([]byte(fmt.Sprintf("network: fixture\nchainId: 1337\nprotocolConfig: %s\n%s", f.ProtocolConfig.Address().Hex(), sections)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestParse(t *testing.T) {
+	for _, bad := range []string{
+		"protocolConfig: 0x1",
+		"protocolConfig: 0x0000000000000000000000000000000000000001\nrestakingPool: {minStake: 1 gwei, stakeBonus: 1}",
+		"protocolConfig: 0x0000000000000000000000000000000000000001\nrestakingPool: {rewardsTimeline: 1.5s}",
+		"protocolConfig: 0x0000000000000000000000000000000000000001\nratioFeed: {ratioThreshold: -1}",
+		"protocolConfig: 0x0000000000000000000000000000000000000001\nfeeCollectors: [{address: 0x2, commission: 1}]",
+	} {
+		if _, err := Parse([]byte(bad)); err == nil {
+			t.Errorf("%q parsed", bad)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the fixture values
+	cfg := config(t, f, fmt.Sprintf(`
+restakingPool:
+  minStake: 0
+  minUnstake: 0
+  maxTVL: 32 ether
+  distributeGasLimit: 250000
+  maxBonusRate: 15e7
+  optimalBonusRate: 25e6
+  stakeUtilizationKink: 25e8
+  maxFlashFeeRate: 30e7
+  optimalUnstakeRate: 5e7
+  unstakeUtilizationKink: 25e8
+  protocolFee: 50e8
+  targetCapacity: 1
+  rewardsTimeline: 7d
+ratioFeed:
+  ratioThreshold: 1e7
+feeCollectors:
+  - address: %s
+    commission: 1000
+`, f.FeeCollector.Address().Hex()))
+	r, err := Check(ctx, c, f.Backend, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Checked != 15 || r.Drifted() || len(r.Calls) != 0 {
+		t.Fatalf("report %+v", r)
+	}
+
+	cfg = config(t, f, fmt.Sprintf(`
+restakingPool:
+  minStake: 0.1 ether
+  maxTVL: 64 ether
+  distributeGasLimit: 300000
+  optimalBonusRate: 5e7
+  rewardsTimeline: 14d
+  protocolFee: 50e8
+ratioFeed:
+  ratioThreshold: 2e7
+feeCollectors:
+  - address: %s
+    commission: 500
+`, f.FeeCollector.Address().Hex()))
+	r, err = Check(ctx, c, f.Backend, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Checked != 8 || len(r.Diffs) != 7 {
+		t.Fatalf("diffs %+v", r.Diffs)
+	}
+	var methods []string
+	for _, call := range r.Calls {
+		methods = append(methods, call.Method)
+	}
+	want := "setMinStake(uint256) setMaxTVL(uint256) setDistributeGasLimit(uint32) setStakeBonusParams(uint64,uint64,uint64) setRewardsTimeline(uint256) setRatioThreshold(uint256) setCommission(uint16)"
+	if strings.Join(methods, " ") != want {
+		t.Fatalf("calls %v", methods)
+	}
+	// the unlisted stake bonus parameters keep their live values
+	if args := strings.Join(r.Calls[3].Args, ","); args != "150000000,50000000,2500000000" {
+		t.Errorf("setStakeBonusParams%v", r.Calls[3].Args)
+	}
+	if len(r.Batch.Targets) != len(r.Calls) || r.Batch.Payloads[0].String() != r.Calls[0].Data.String() {
+		t.Errorf("batch %+v", r.Batch)
+	}
+	var out strings.Builder
+	if err := r.Print(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"7 drifted", "RestakingPool.minStake: 0 ether, want 0.1 ether", "rewardsTimeline: 7d, want 14d", "call 4: RestakingPool.setStakeBonusParams(150000000, 50000000, 2500000000)"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("no %q in\n%s", s, out.String())
+		}
+	}
+
+	// the calls, sent by the governance, reconcile the configuration
+	for _, call := range r.Calls {
+		contract := bind.NewBoundContract(call.To, abi.ABI{}, nil, f.Backend, nil)
+		if _, err := f.Send(contract.RawTransact(f.Governance.TransactOpts(), call.Data)); err != nil {
+			t.Fatalf("%s: %v", call.Method, err)
+		}
+	}
+	if r, err = Check(ctx, c, f.Backend, cfg); err != nil || r.Drifted() {
+		t.Fatalf("drift after the calls %+v: %v", r, err)
+	}
+}
@@ -0,0 +1,285 @@
+package fixture
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	delegationmanagermock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/DelegationManagerMock"
+	eigenpodmanagermock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/EigenPodManagerMock"
+	eigenpodmock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/EigenPodMock"
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	inceptionlibrary "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/InceptionLibrary"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restaker "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/Restaker"
+	restakerdeployer "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerDeployer"
+	restakerfacets "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerFacets"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	rewardscoordinator "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RewardsCoordinator"
+	simplebeacon "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/SimpleBeacon"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// beaconProxyABI describes the constructor of OpenZeppelin's BeaconProxy,
+// whose creation code is read from RestakerDeployer.BEACON_PROXY_BYTECODE.
+const beaconProxyABI = `[{"type":"constructor","stateMutability":"payable","inputs":[{"name":"beacon","type":"address"},{"name":"data","type":"bytes"}]}]`
+
+// inceptionLibraryName is the fully qualified name solc uses to build the
+// link placeholder of InceptionLibrary in the RestakingPool bytecode.
+const inceptionLibraryName = "contracts/libraries/InceptionLibrary.sol:InceptionLibrary"
+
+// LinkLibrary replaces the solc placeholder of the library fullName in bin
+// with addr.
+func LinkLibrary(bin string, fullName string, addr common.Address) string {
+	placeholder := "__$" + crypto.Keccak256Hash([]byte(fullName)).Hex()[2:36] + "$__"
+	return strings.ReplaceAll(bin, placeholder, strings.ToLower(addr.Hex()[2:]))
+}
+
+func (f *Fixture) deployEigenMocks() error {
+	opts := f.Deployer.TransactOpts
+	var zero common.Address
+
+	podImpl, tx, _, err := eigenpodmock.DeployContract(opts(), f.Backend, zero, zero, zero, big.NewInt(0))
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "EigenPodMock")
+	}
+	podBeacon, tx, _, err := simplebeacon.DeployContract(opts(), f.Backend, podImpl)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "SimpleBeacon")
+	}
+	podManager, tx, _, err := eigenpodmanagermock.DeployContract(opts(), f.Backend, zero, podBeacon, zero, zero)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "EigenPodManagerMock")
+	}
+	delegationManager, tx, _, err := delegationmanagermock.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "DelegationManagerMock")
+	}
+	coordinator, tx, _, err := rewardscoordinator.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "RewardsCoordinator")
+	}
+
+	if f.PodBeacon, err = simplebeacon.NewContract(podBeacon, f.Backend); err != nil {
+		return err
+	}
+	if f.EigenPodManager, err = eigenpodmanagermock.NewContract(podManager, f.Backend); err != nil {
+		return err
+	}
+	if f.DelegationManager, err = delegationmanagermock.NewContract(delegationManager, f.Backend); err != nil {
+		return err
+	}
+	f.RewardsCoordinator, err = rewardscoordinator.NewContract(coordinator, f.Backend)
+	return err
+}
+
+// deployRestakerContracts deploys the Restaker beacon, RestakerDeployer and
+// RestakerFacets. The deployer is needed first because it carries the
+// BeaconProxy creation code used for every other proxy, so the facets proxy
+// address is precomputed from the deployer nonce.
+func (f *Fixture) deployRestakerContracts() error {
+	opts := f.Deployer.TransactOpts
+
+	restakerImpl, tx, _, err := restaker.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "Restaker")
+	}
+	beacon, tx, _, err := simplebeacon.DeployContract(opts(), f.Backend, restakerImpl)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "SimpleBeacon")
+	}
+
+	nonce, err := f.Backend.PendingNonceAt(context.Background(), f.Deployer.Address)
+	if err != nil {
+		return err
+	}
+	// nonce: RestakerDeployer, +1: facets implementation, +2: facets beacon, +3: facets proxy
+	expectedFacets := crypto.CreateAddress(f.Deployer.Address, nonce+3)
+	deployer, tx, _, err := restakerdeployer.DeployContract(opts(), f.Backend, beacon, expectedFacets)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "RestakerDeployer")
+	}
+	if f.RestakerDeployer, err = restakerdeployer.NewContract(deployer, f.Backend); err != nil {
+		return err
+	}
+	if f.proxyBytecode, err = f.RestakerDeployer.BEACONPROXYBYTECODE(nil); err != nil {
+		return errors.Wrap(err, "could not read BeaconProxy bytecode")
+	}
+
+	facetsImpl, tx, _, err := restakerfacets.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "RestakerFacets")
+	}
+	facets, err := f.deployProxy(facetsImpl, restakerfacets.ContractMetaData,
+		f.Governance.Address, f.EigenPodManager.Address(), f.DelegationManager.Address())
+	if err != nil {
+		return errors.Wrap(err, "RestakerFacets proxy")
+	}
+	if facets != expectedFacets {
+		return errors.Errorf("RestakerFacets deployed at %s, expected %s", facets, expectedFacets)
+	}
+
+	if f.RestakerBeacon, err = simplebeacon.NewContract(beacon, f.Backend); err != nil {
+		return err
+	}
+	f.RestakerFacets, err = restakerfacets.NewContract(facets, f.Backend)
+	return err
+}
+
+func (f *Fixture) deployConfig() error {
+	impl, tx, _, err := protocolconfig.DeployContract(f.Deployer.TransactOpts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "ProtocolConfig")
+	}
+	addr, err := f.deployProxy(impl, protocolconfig.ContractMetaData,
+		f.Governance.Address, f.Operator.Address, f.Treasury.Address)
+	if err != nil {
+		return errors.Wrap(err, "ProtocolConfig proxy")
+	}
+	if f.ProtocolConfig, err = protocolconfig.NewContract(addr, f.Backend); err != nil {
+		return err
+	}
+	_, err = f.Send(f.ProtocolConfig.SetRestakerDeployer(f.Governance.TransactOpts(), f.RestakerDeployer.Address()))
+	return errors.Wrap(err, "setRestakerDeployer")
+}
+
+func (f *Fixture) deployLiquidRestaking() error {
+	opts := f.Deployer.TransactOpts
+	gov := f.Governance.TransactOpts
+	config := f.ProtocolConfig.Address()
+
+	// cToken
+	impl, tx, _, err := ctoken.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "cToken")
+	}
+	addr, err := f.deployProxy(impl, ctoken.ContractMetaData, config, f.Options.TokenName, f.Options.TokenSymbol)
+	if err != nil {
+		return errors.Wrap(err, "cToken proxy")
+	}
+	if f.CToken, err = ctoken.NewContract(addr, f.Backend); err != nil {
+		return err
+	}
+	if _, err := f.Send(f.ProtocolConfig.SetCToken(gov(), addr)); err != nil {
+		return errors.Wrap(err, "setCToken")
+	}
+
+	// Library
+	lib, tx, _, err := inceptionlibrary.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "InceptionLibrary")
+	}
+	f.InceptionLibrary = lib
+
+	// Pool
+	parsed, err := restakingpool.ContractMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	bin := LinkLibrary(restakingpool.ContractBin, inceptionLibraryName, lib)
+	impl, tx, _, err = bind.DeployContract(opts(), *parsed, common.FromHex(bin), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "RestakingPool")
+	}
+	addr, err = f.deployProxy(impl, restakingpool.ContractMetaData, config, f.Options.DistributeGasLimit, f.Options.MaxTVL)
+	if err != nil {
+		return errors.Wrap(err, "RestakingPool proxy")
+	}
+	if f.RestakingPool, err = restakingpool.NewContract(addr, f.Backend); err != nil {
+		return err
+	}
+	if _, err := f.Send(f.ProtocolConfig.SetRestakingPool(gov(), addr)); err != nil {
+		return errors.Wrap(err, "setRestakingPool")
+	}
+
+	// RatioFeed
+	impl, tx, _, err = ratiofeed.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "RatioFeed")
+	}
+	addr, err = f.deployProxy(impl, ratiofeed.ContractMetaData, config, f.Options.RatioThreshold)
+	if err != nil {
+		return errors.Wrap(err, "RatioFeed proxy")
+	}
+	if f.RatioFeed, err = ratiofeed.NewContract(addr, f.Backend); err != nil {
+		return err
+	}
+	if _, err := f.Send(f.ProtocolConfig.SetRatioFeed(gov(), addr)); err != nil {
+		return errors.Wrap(err, "setRatioFeed")
+	}
+	// force update ratio to 1e18
+	if _, err := f.Send(f.RatioFeed.RepairRatio(gov(), f.CToken.Address(), Ether)); err != nil {
+		return errors.Wrap(err, "repairRatio")
+	}
+
+	// FeeCollector
+	impl, tx, _, err = feecollector.DeployContract(opts(), f.Backend)
+	if _, err := f.Send(tx, err); err != nil {
+		return errors.Wrap(err, "FeeCollector")
+	}
+	addr, err = f.deployProxy(impl, feecollector.ContractMetaData, config, f.Options.Commission)
+	if err != nil {
+		return errors.Wrap(err, "FeeCollector proxy")
+	}
+	f.FeeCollector, err = feecollector.NewContract(addr, f.Backend)
+	return err
+}
+
+// configurePool applies the pool parameters the TypeScript specs set up
+// before every test.
+func (f *Fixture) configurePool() error {
+	gov := f.Governance.TransactOpts
+	o := f.Options
+	pool := f.RestakingPool
+
+	if _, err := f.Send(pool.SetFlashUnstakeFeeParams(gov(), o.MaxFlashFeeRate, o.OptimalUnstakeRate, o.UnstakeKink)); err != nil {
+		return errors.Wrap(err, "setFlashUnstakeFeeParams")
+	}
+	if _, err := f.Send(pool.SetStakeBonusParams(gov(), o.MaxBonusRate, o.OptimalBonusRate, o.StakeKink)); err != nil {
+		return errors.Wrap(err, "setStakeBonusParams")
+	}
+	if _, err := f.Send(pool.SetProtocolFee(gov(), o.ProtocolFee)); err != nil {
+		return errors.Wrap(err, "setProtocolFee")
+	}
+	if _, err := f.Send(pool.SetTargetFlashCapacity(gov(), o.TargetCapacity)); err != nil {
+		return errors.Wrap(err, "setTargetFlashCapacity")
+	}
+	timeline := big.NewInt(int64(o.RewardsTimeline.Seconds()))
+	if _, err := f.Send(pool.SetRewardsTimeline(gov(), timeline)); err != nil {
+		return errors.Wrap(err, "setRewardsTimeline")
+	}
+	return nil
+}
+
+// deployProxy puts impl behind a SimpleBeacon and a BeaconProxy, calling
+// initialize with args during construction.
+func (f *Fixture) deployProxy(impl common.Address, meta *bind.MetaData, args ...interface{}) (common.Address, error) {
+	implABI, err := meta.GetAbi()
+	if err != nil {
+		return common.Address{}, err
+	}
+	data, err := implABI.Pack("initialize", args...)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "could not pack initialize")
+	}
+	beacon, tx, _, err := simplebeacon.DeployContract(f.Deployer.TransactOpts(), f.Backend, impl)
+	if _, err := f.Send(tx, err); err != nil {
+		return common.Address{}, errors.Wrap(err, "SimpleBeacon")
+	}
+	proxyABI, err := abi.JSON(strings.NewReader(beaconProxyABI))
+	if err != nil {
+		return common.Address{}, err
+	}
+	proxy, tx, _, err := bind.DeployContract(f.Deployer.TransactOpts(), proxyABI, f.proxyBytecode, f.Backend, beacon, data)
+	if _, err := f.Send(tx, err); err != nil {
+		return common.Address{}, errors.Wrap(err, "BeaconProxy")
+	}
+	return proxy, nil
+}
@@ -0,0 +1,264 @@
+// Package fixture deploys the whole protocol on go-ethereum's simulated
+// backend, mirroring test/helpers/deploy.ts, so Go services and tests can
+// run against the real contract logic.
+package fixture
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	delegationmanagermock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/DelegationManagerMock"
+	eigenpodmanagermock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/EigenPodManagerMock"
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakerdeployer "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerDeployer"
+	restakerfacets "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerFacets"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	rewardscoordinator "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RewardsCoordinator"
+	simplebeacon "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/SimpleBeacon"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// ChainID is the chain id of the simulated backend.
+var ChainID = big.NewInt(1337)
+
+// Ether is 1e18 wei.
+var Ether = big.NewInt(1e18)
+
+const (
+	// GasLimit is the block gas limit of the simulated chain.
+	GasLimit = 30_000_000
+	// StartTime is the timestamp the chain is moved to right after genesis.
+	// RestakingPool.totalAssets divides by the rewards timeline in days, so
+	// the chain must not be younger than a day.
+	StartTime = 1_700_000_000
+)
+
+// Options configures the deployed protocol. New uses every field as given,
+// so a zero fee or rate is deployed as zero; start from DefaultOptions.
+type Options struct {
+	// Users is the number of funded user accounts besides the protocol roles.
+	Users int
+	// Balance is the genesis balance of every account.
+	Balance *big.Int
+
+	TokenName          string
+	TokenSymbol        string
+	DistributeGasLimit uint32
+	MaxTVL             *big.Int
+	RatioThreshold     *big.Int
+	Commission         uint16
+
+	TargetCapacity     uint64
+	MaxBonusRate       uint64
+	OptimalBonusRate   uint64
+	StakeKink          uint64
+	MaxFlashFeeRate    uint64
+	OptimalUnstakeRate uint64
+	UnstakeKink        uint64
+	ProtocolFee        uint64
+	// RewardsTimeline must be a multiple of a day.
+	RewardsTimeline time.Duration
+}
+
+// DefaultOptions returns the options used by the TypeScript specs.
+func DefaultOptions() Options {
+	return Options{
+		Users:              4,
+		Balance:            new(big.Int).Mul(big.NewInt(1_000_000), Ether),
+		TokenName:          "Token Name",
+		TokenSymbol:        "Token Symbol",
+		DistributeGasLimit: 250_000,
+		MaxTVL:             new(big.Int).Mul(big.NewInt(32), Ether),
+		RatioThreshold:     big.NewInt(10_000_000),
+		Commission:         1000,
+		TargetCapacity:     1,
+		MaxBonusRate:       15e7,
+		OptimalBonusRate:   25e6,
+		StakeKink:          25e8,
+		MaxFlashFeeRate:    30e7,
+		OptimalUnstakeRate: 5e7,
+		UnstakeKink:        25e8,
+		ProtocolFee:        50e8,
+		RewardsTimeline:    7 * 24 * time.Hour,
+	}
+}
+
+// Account is a funded key on the simulated chain.
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// TransactOpts returns fresh transaction options signed by the account.
+func (a *Account) TransactOpts() *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(a.Key, ChainID)
+	if err != nil {
+		panic(err) // only fails on a nil chain id
+	}
+	return opts
+}
+
+// WithValue returns transaction options that send value wei.
+func (a *Account) WithValue(value *big.Int) *bind.TransactOpts {
+	opts := a.TransactOpts()
+	opts.Value = value
+	return opts
+}
+
+// Fixture is a deployed protocol on a simulated backend.
+type Fixture struct {
+	Backend *backends.SimulatedBackend
+	Options Options
+
+	Deployer   *Account
+	Governance *Account
+	Operator   *Account
+	Treasury   *Account
+	Users      []*Account
+
+	// EigenLayer mocks
+	PodBeacon          *simplebeacon.Contract
+	EigenPodManager    *eigenpodmanagermock.Contract
+	DelegationManager  *delegationmanagermock.Contract
+	RewardsCoordinator *rewardscoordinator.Contract
+
+	// Protocol
+	ProtocolConfig   *protocolconfig.Contract
+	CToken           *ctoken.Contract
+	RatioFeed        *ratiofeed.Contract
+	RestakingPool    *restakingpool.Contract
+	FeeCollector     *feecollector.Contract
+	RestakerFacets   *restakerfacets.Contract
+	RestakerBeacon   *simplebeacon.Contract
+	RestakerDeployer *restakerdeployer.Contract
+	InceptionLibrary common.Address
+
+	proxyBytecode []byte
+}
+
+// New deploys a fresh protocol.
+func New(opts Options) (*Fixture, error) {
+	if opts.Balance == nil || opts.MaxTVL == nil || opts.RatioThreshold == nil {
+		return nil, errors.New("options without Balance, MaxTVL or RatioThreshold, start from DefaultOptions")
+	}
+	f := &Fixture{Options: opts}
+
+	alloc := core.GenesisAlloc{}
+	newAccount := func(i int) *Account {
+		key, err := crypto.ToECDSA(crypto.Keccak256([]byte(fmt.Sprintf("fixture account %d", i))))
+		if err != nil {
+			panic(err) // a keccak output is always a valid key
+		}
+		a := &Account{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+		alloc[a.Address] = core.GenesisAccount{Balance: new(big.Int).Set(opts.Balance)}
+		return a
+	}
+	f.Deployer = newAccount(0)
+	f.Governance = newAccount(1)
+	f.Operator = newAccount(2)
+	f.Treasury = newAccount(3)
+	for i := 0; i < opts.Users; i++ {
+		f.Users = append(f.Users, newAccount(4+i))
+	}
+
+	f.Backend = backends.NewSimulatedBackend(alloc, GasLimit)
+	if err := f.Backend.AdjustTime(StartTime * time.Second); err != nil {
+		return nil, errors.Wrap(err, "could not move chain to start time")
+	}
+	f.Backend.Commit()
+
+	if err := f.deployEigenMocks(); err != nil {
+		return nil, errors.Wrap(err, "could not deploy EigenLayer mocks")
+	}
+	if err := f.deployRestakerContracts(); err != nil {
+		return nil, errors.Wrap(err, "could not deploy restaker contracts")
+	}
+	if err := f.deployConfig(); err != nil {
+		return nil, errors.Wrap(err, "could not deploy ProtocolConfig")
+	}
+	if err := f.deployLiquidRestaking(); err != nil {
+		return nil, errors.Wrap(err, "could not deploy liquid restaking")
+	}
+	if err := f.configurePool(); err != nil {
+		return nil, errors.Wrap(err, "could not configure pool")
+	}
+	return f, nil
+}
+
+// Close releases the simulated backend.
+func (f *Fixture) Close() error {
+	return f.Backend.Close()
+}
+
+// Client returns a discovery client rooted at the fixture's ProtocolConfig.
+func (f *Fixture) Client(ctx context.Context) (*client.Client, error) {
+	return client.New(ctx, f.ProtocolConfig.Address(), f.Backend)
+}
+
+// Send mines a transaction returned by a generated Transactor method and
+// returns its receipt. A reverted transaction is reported as an error.
+func (f *Fixture) Send(tx *types.Transaction, err error) (*types.Receipt, error) {
+	if err != nil {
+		return nil, err
+	}
+	f.Backend.Commit()
+	receipt, err := f.Backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get receipt of %s", tx.Hash())
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, errors.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
+
+func (f *Fixture) callOpts() *bind.CallOpts {
+	return &bind.CallOpts{Context: context.Background()}
+}
+
+// Balance returns the current balance of addr.
+func (f *Fixture) Balance(addr common.Address) (*big.Int, error) {
+	return f.Backend.BalanceAt(context.Background(), addr, nil)
+}
+
+// Fund transfers value wei from the deployer to addr.
+func (f *Fixture) Fund(addr common.Address, value *big.Int) error {
+	ctx := context.Background()
+	nonce, err := f.Backend.PendingNonceAt(ctx, f.Deployer.Address)
+	if err != nil {
+		return err
+	}
+	gasPrice, err := f.Backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	gas, err := f.Backend.EstimateGas(ctx, ethereum.CallMsg{From: f.Deployer.Address, To: &addr, Value: value})
+	if err != nil {
+		return err
+	}
+	tx := types.NewTransaction(nonce, addr, value, gas, gasPrice, nil)
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(ChainID), f.Deployer.Key)
+	if err != nil {
+		return err
+	}
+	if err := f.Backend.SendTransaction(ctx, signed); err != nil {
+		return err
+	}
+	_, err = f.Send(signed, nil)
+	return err
+}
@@ -0,0 +1,100 @@
+package fixture
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+func TestFixture(t *testing.T) {
+	f, err := New(DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrs := c.Addresses()
+	if addrs.RestakingPool != f.RestakingPool.Address() || addrs.CToken != f.CToken.Address() ||
+		addrs.RatioFeed != f.RatioFeed.Address() || addrs.RestakerDeployer != f.RestakerDeployer.Address() {
+		t.Fatalf("client resolved %+v", addrs)
+	}
+	if addrs.Governance != f.Governance.Address || addrs.Operator != f.Operator.Address {
+		t.Fatalf("client resolved roles %+v", addrs)
+	}
+
+	user := f.Users[0]
+	if _, err := f.Send(f.RestakingPool.Stake(user.WithValue(Ether))); err != nil {
+		t.Fatal(err)
+	}
+	shares, err := f.CToken.BalanceOf(nil, user.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shares.Cmp(Ether) != 0 {
+		t.Fatalf("got %s shares for 1 ETH at ratio 1", shares)
+	}
+
+	// The ratio may only decrease, once per interval.
+	ratio := new(big.Int).Div(new(big.Int).Mul(Ether, big.NewInt(99)), big.NewInt(100))
+	if err := f.UpdateRatio(ratio); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Send(f.RatioFeed.UpdateRatio(f.Operator.TransactOpts(), f.CToken.Address(), ratio)); err == nil {
+		t.Fatal("expected updateRatio to be rejected before the interval")
+	}
+	got, err := f.RatioFeed.GetRatio(nil, f.CToken.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(ratio) != 0 {
+		t.Fatalf("ratio %s, want %s", got, ratio)
+	}
+
+	// Rewards vest over the timeline.
+	if err := f.AddRewards(big.NewInt(7e17)); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.AddRewards(big.NewInt(1)); err == nil {
+		t.Fatal("expected addRewards to be rejected before the timeline is over")
+	}
+	if err := f.AdvanceRewardsTimeline(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.AddRewards(big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZeroRates(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Commission, opts.ProtocolFee = 0, 0
+	opts.MaxBonusRate, opts.OptimalBonusRate = 0, 0
+	opts.MaxFlashFeeRate, opts.OptimalUnstakeRate = 0, 0
+	f, err := New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	for name, get := range map[string]func(*bind.CallOpts) (uint64, error){
+		"protocolFee":        f.RestakingPool.ProtocolFee,
+		"maxBonusRate":       f.RestakingPool.MaxBonusRate,
+		"optimalBonusRate":   f.RestakingPool.OptimalBonusRate,
+		"maxFlashFeeRate":    f.RestakingPool.MaxFlashFeeRate,
+		"optimalUnstakeRate": f.RestakingPool.OptimalUnstakeRate,
+	} {
+		if v, err := get(nil); err != nil || v != 0 {
+			t.Errorf("%s is %v: %v", name, v, err)
+		}
+	}
+	if commission, err := f.FeeCollector.Commission(nil); err != nil || commission != 0 {
+		t.Errorf("commission is %v: %v", commission, err)
+	}
+}
@@ -0,0 +1,103 @@
+package fixture
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RatioUpdateInterval is the minimum delay RatioFeed enforces between two
+	// updates of the same token.
+	RatioUpdateInterval = 12 * time.Hour
+	// Day is the granularity of the rewards timeline.
+	Day = 24 * time.Hour
+)
+
+// Now returns the timestamp of the latest block.
+func (f *Fixture) Now() (time.Time, error) {
+	header, err := f.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(header.Time), 0), nil
+}
+
+// AdvanceTime mines an empty block d later than the latest one.
+func (f *Fixture) AdvanceTime(d time.Duration) error {
+	if err := f.Backend.AdjustTime(d); err != nil {
+		return errors.Wrap(err, "could not adjust time")
+	}
+	f.Backend.Commit()
+	return nil
+}
+
+// AdvanceRatioInterval moves the chain past the RatioFeed cooldown so the
+// next updateRatio is not rejected as too often.
+func (f *Fixture) AdvanceRatioInterval() error {
+	return f.AdvanceTime(RatioUpdateInterval)
+}
+
+// UpdateRatio waits out the RatioFeed cooldown and sets the cToken ratio
+// as the operator.
+func (f *Fixture) UpdateRatio(ratio *big.Int) error {
+	if err := f.AdvanceRatioInterval(); err != nil {
+		return err
+	}
+	_, err := f.Send(f.RatioFeed.UpdateRatio(f.Operator.TransactOpts(), f.CToken.Address(), ratio))
+	return errors.Wrap(err, "updateRatio")
+}
+
+// AddRewards starts a new rewards timeline with amount wei sent by the
+// operator.
+func (f *Fixture) AddRewards(amount *big.Int) error {
+	_, err := f.Send(f.RestakingPool.AddRewards(f.Operator.WithValue(amount)))
+	return errors.Wrap(err, "addRewards")
+}
+
+// AdvanceRewardsDays moves the chain n whole days forward, vesting n daily
+// portions of the current rewards.
+func (f *Fixture) AdvanceRewardsDays(n int) error {
+	return f.AdvanceTime(time.Duration(n) * Day)
+}
+
+// AdvanceRewardsTimeline moves the chain to the end of the current rewards
+// timeline, after which all rewards are vested and addRewards is accepted
+// again.
+func (f *Fixture) AdvanceRewardsTimeline() error {
+	opts := f.callOpts()
+	start, err := f.RestakingPool.StartTimeline(opts)
+	if err != nil {
+		return err
+	}
+	timeline, err := f.RestakingPool.RewardsTimeline(opts)
+	if err != nil {
+		return err
+	}
+	now, err := f.Now()
+	if err != nil {
+		return err
+	}
+	end := time.Unix(new(big.Int).Add(start, timeline).Int64(), 0)
+	if !now.Before(end) {
+		return nil
+	}
+	return f.AdvanceTime(end.Sub(now))
+}
+
+// Snapshot returns an identifier of the current chain state for Revert.
+func (f *Fixture) Snapshot() uint64 {
+	return f.Backend.Blockchain().CurrentBlock().Number.Uint64()
+}
+
+// Revert rewinds the chain to a snapshot, discarding every later block and
+// any pending transaction. It is much cheaper than deploying a new fixture.
+func (f *Fixture) Revert(snapshot uint64) error {
+	if err := f.Backend.Blockchain().SetHead(snapshot); err != nil {
+		return errors.Wrap(err, "could not rewind chain")
+	}
+	f.Backend.Rollback()
+	return nil
+}
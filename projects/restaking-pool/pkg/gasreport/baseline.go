@@ -0,0 +1,149 @@
+package gasreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// Baseline is the saved result of a previous run.
+type Baseline struct {
+	Entries []Entry `json:"entries"`
+}
+
+// LoadBaseline reads a baseline file. A missing file yields an empty
+// baseline so the first run can create it.
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Baseline{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, errors.Wrapf(err, "could not parse baseline %s", path)
+	}
+	return &b, nil
+}
+
+// SaveBaseline writes entries sorted by key so diffs of the file stay small.
+func SaveBaseline(path string, entries []Entry) error {
+	sorted := append([]Entry(nil), entries...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key() < sorted[j].Key() })
+	data, err := json.MarshalIndent(Baseline{Entries: sorted}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Status classifies a row of the comparison.
+type Status string
+
+const (
+	StatusSame      Status = ""
+	StatusRegressed Status = "REGRESSED"
+	StatusImproved  Status = "improved"
+	StatusNew       Status = "new"
+	StatusRemoved   Status = "removed"
+)
+
+// Row compares one entry with its baseline.
+type Row struct {
+	Method   string
+	Input    string
+	Baseline uint64
+	Current  uint64
+	Status   Status
+}
+
+// Delta is the gas difference against the baseline.
+func (r Row) Delta() int64 {
+	return int64(r.Current) - int64(r.Baseline)
+}
+
+// Percent is Delta relative to the baseline.
+func (r Row) Percent() float64 {
+	if r.Baseline == 0 {
+		return 0
+	}
+	return float64(r.Delta()) * 100 / float64(r.Baseline)
+}
+
+// Compare matches current entries against the baseline, keeping the order
+// of current. A change counts as a regression or improvement when it
+// exceeds threshold percent.
+func Compare(baseline *Baseline, current []Entry, threshold float64) []Row {
+	previous := make(map[string]Entry, len(baseline.Entries))
+	for _, e := range baseline.Entries {
+		previous[e.Key()] = e
+	}
+	var rows []Row
+	for _, e := range current {
+		row := Row{Method: e.Method, Input: e.Input, Current: e.Gas}
+		prev, ok := previous[e.Key()]
+		delete(previous, e.Key())
+		switch {
+		case !ok:
+			row.Status = StatusNew
+		default:
+			row.Baseline = prev.Gas
+			if p := row.Percent(); p > threshold {
+				row.Status = StatusRegressed
+			} else if p < -threshold {
+				row.Status = StatusImproved
+			}
+		}
+		rows = append(rows, row)
+	}
+	// entries that are gone, in a stable order
+	var removed []Entry
+	for _, e := range previous {
+		removed = append(removed, e)
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Key() < removed[j].Key() })
+	for _, e := range removed {
+		rows = append(rows, Row{Method: e.Method, Input: e.Input, Baseline: e.Gas, Status: StatusRemoved})
+	}
+	return rows
+}
+
+// Regressions returns the rows that got more expensive.
+func Regressions(rows []Row) []Row {
+	var out []Row
+	for _, r := range rows {
+		if r.Status == StatusRegressed {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// WriteTable prints rows as an aligned table.
+func WriteTable(w io.Writer, rows []Row) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "method\tinput\tbaseline\tcurrent\tdelta\t%\tstatus\t")
+	for _, r := range rows {
+		baseline, delta, percent := "-", "-", "-"
+		if r.Status != StatusNew {
+			baseline = fmt.Sprint(r.Baseline)
+		}
+		if r.Status != StatusNew && r.Status != StatusRemoved {
+			delta = fmt.Sprintf("%+d", r.Delta())
+			percent = fmt.Sprintf("%+.2f", r.Percent())
+		}
+		current := "-"
+		if r.Status != StatusRemoved {
+			current = fmt.Sprint(r.Current)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n", r.Method, r.Input, baseline, current, delta, percent, r.Status)
+	}
+	return tw.Flush()
+}
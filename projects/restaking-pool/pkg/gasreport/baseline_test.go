@@ -0,0 +1,64 @@
+package gasreport
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	baseline := &Baseline{Entries: []Entry{
+		{"stake", "first", 100_000},
+		{"unstake", "first", 200_000},
+		{"claimUnstake", "single", 50_000},
+		{"addRewards", "first", 90_000},
+	}}
+	current := []Entry{
+		{"stake", "first", 100_400},
+		{"unstake", "first", 210_000},
+		{"claimUnstake", "single", 40_000},
+		{"distributeUnstakes", "unstakes=1", 170_000},
+	}
+	rows := Compare(baseline, current, 0.5)
+
+	want := []Status{StatusSame, StatusRegressed, StatusImproved, StatusNew, StatusRemoved}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, r := range rows {
+		if r.Status != want[i] {
+			t.Errorf("%s/%s: status %q, want %q", r.Method, r.Input, r.Status, want[i])
+		}
+	}
+	if reg := Regressions(rows); len(reg) != 1 || reg[0].Delta() != 10_000 || reg[0].Percent() != 5 {
+		t.Errorf("regressions %+v", reg)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, Regressions(rows)); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "+10000") || !strings.Contains(buf.String(), "+5.00") {
+		t.Errorf("table:\n%s", buf.String())
+	}
+}
+
+func TestBaselineRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	missing, err := LoadBaseline(path)
+	if err != nil || len(missing.Entries) != 0 {
+		t.Fatalf("missing baseline: %+v, %v", missing, err)
+	}
+	entries := []Entry{{"unstake", "first", 2}, {"stake", "first", 1}}
+	if err := SaveBaseline(path, entries); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Entries) != 2 || loaded.Entries[0].Key() != "stake/first" {
+		t.Fatalf("loaded %+v", loaded.Entries)
+	}
+}
@@ -0,0 +1,199 @@
+// Package gasreport measures the gas used by RestakingPool methods over a
+// representative call matrix and compares it against a saved baseline.
+package gasreport
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// Entry is the gas used by one call of Method with the given Input size.
+type Entry struct {
+	Method string `json:"method"`
+	Input  string `json:"input"`
+	Gas    uint64 `json:"gas"`
+}
+
+// Key identifies an entry across runs.
+func (e Entry) Key() string {
+	return e.Method + "/" + e.Input
+}
+
+// QueueSizes are the numbers of queued unstakes distributeUnstakes is
+// measured with.
+var QueueSizes = []int{1, 5, 10, 25, 50}
+
+// distributeGas leaves room for the largest queue. distributeUnstakes stops
+// once gasleft() drops under the distribute gas limit, so the limit must be
+// explicit rather than estimated.
+const distributeGas = 15_000_000
+
+// Options returns the fixture options the matrix is designed for.
+func Options() fixture.Options {
+	opts := fixture.DefaultOptions()
+	opts.MaxTVL = new(big.Int).Mul(big.NewInt(10_000), fixture.Ether)
+	return opts
+}
+
+type measurer struct {
+	f       *fixture.Fixture
+	entries []Entry
+}
+
+// record returns a function that stores the gas used by the receipt
+// returned from fixture.Send.
+func (m *measurer) record(method, input string) func(*types.Receipt, error) error {
+	return func(receipt *types.Receipt, err error) error {
+		if err != nil {
+			return errors.Wrapf(err, "%s/%s", method, input)
+		}
+		m.entries = append(m.entries, Entry{Method: method, Input: input, Gas: receipt.GasUsed})
+		return nil
+	}
+}
+
+// Measure runs the call matrix on f, which must be a fresh fixture created
+// with Options. Every case starts from the same snapshot.
+func Measure(f *fixture.Fixture) ([]Entry, error) {
+	m := &measurer{f: f}
+	snapshot := f.Snapshot()
+	cases := []func() error{
+		m.stake,
+		m.unstake,
+		m.flashUnstake,
+		m.claimUnstake,
+		m.ratioAndRewards,
+	}
+	for _, size := range QueueSizes {
+		size := size
+		cases = append(cases, func() error { return m.distribute(size) })
+	}
+	for _, c := range cases {
+		if err := f.Revert(snapshot); err != nil {
+			return nil, err
+		}
+		if err := c(); err != nil {
+			return nil, err
+		}
+	}
+	return m.entries, nil
+}
+
+func (m *measurer) stake() error {
+	f := m.f
+	pool := f.RestakingPool
+	ether := fixture.Ether
+
+	if err := m.record("stake", "first")(f.Send(pool.Stake(f.Users[0].WithValue(ether)))); err != nil {
+		return err
+	}
+	if err := m.record("stake", "repeated")(f.Send(pool.Stake(f.Users[0].WithValue(ether)))); err != nil {
+		return err
+	}
+	var code [32]byte
+	copy(code[:], "referral")
+	if err := m.record("stake", "referral")(f.Send(pool.Stake0(f.Users[1].WithValue(ether), code))); err != nil {
+		return err
+	}
+
+	// A flash unstake funds the stake bonus paid to the next staker.
+	if _, err := f.Send(pool.FlashUnstake(f.Users[0].TransactOpts(), ether, f.Users[0].Address)); err != nil {
+		return errors.Wrap(err, "flashUnstake")
+	}
+	return m.record("stake", "bonus")(f.Send(pool.Stake(f.Users[2].WithValue(ether))))
+}
+
+func (m *measurer) unstake() error {
+	f := m.f
+	user := f.Users[0]
+	if _, err := f.Send(f.RestakingPool.Stake(user.WithValue(fixture.Ether))); err != nil {
+		return errors.Wrap(err, "stake")
+	}
+	half := new(big.Int).Div(fixture.Ether, big.NewInt(2))
+	if err := m.record("unstake", "first")(f.Send(f.RestakingPool.Unstake(user.TransactOpts(), user.Address, half))); err != nil {
+		return err
+	}
+	return m.record("unstake", "repeated")(f.Send(f.RestakingPool.Unstake(user.TransactOpts(), user.Address, half)))
+}
+
+func (m *measurer) flashUnstake() error {
+	f := m.f
+	user := f.Users[0]
+	if _, err := f.Send(f.RestakingPool.Stake(user.WithValue(new(big.Int).Mul(big.NewInt(10), fixture.Ether)))); err != nil {
+		return errors.Wrap(err, "stake")
+	}
+	return m.record("flashUnstake", "1 ether")(f.Send(f.RestakingPool.FlashUnstake(user.TransactOpts(), fixture.Ether, f.Users[1].Address)))
+}
+
+func (m *measurer) claimUnstake() error {
+	f := m.f
+	user := f.Users[0]
+	if err := m.queue(1); err != nil {
+		return err
+	}
+	opts := f.Operator.TransactOpts()
+	opts.GasLimit = distributeGas
+	if _, err := f.Send(f.RestakingPool.DistributeUnstakes(opts)); err != nil {
+		return errors.Wrap(err, "distributeUnstakes")
+	}
+	return m.record("claimUnstake", "single")(f.Send(f.RestakingPool.ClaimUnstake(user.TransactOpts(), user.Address)))
+}
+
+func (m *measurer) ratioAndRewards() error {
+	f := m.f
+	if _, err := f.Send(f.RestakingPool.Stake(f.Users[0].WithValue(fixture.Ether))); err != nil {
+		return errors.Wrap(err, "stake")
+	}
+	rewards := new(big.Int).Div(fixture.Ether, big.NewInt(10))
+	if err := m.record("addRewards", "first")(f.Send(f.RestakingPool.AddRewards(f.Operator.WithValue(rewards)))); err != nil {
+		return err
+	}
+	if err := f.AdvanceRatioInterval(); err != nil {
+		return err
+	}
+	ratio := new(big.Int).Sub(fixture.Ether, big.NewInt(1e15))
+	return m.record("updateRatio", "first")(f.Send(f.RatioFeed.UpdateRatio(f.Operator.TransactOpts(), f.CToken.Address(), ratio)))
+}
+
+// queue stakes and places n unstakes of 0.1 ETH spread across the users.
+func (m *measurer) queue(n int) error {
+	f := m.f
+	for _, u := range f.Users {
+		if _, err := f.Send(f.RestakingPool.Stake(u.WithValue(new(big.Int).Mul(big.NewInt(10), fixture.Ether)))); err != nil {
+			return errors.Wrap(err, "stake")
+		}
+	}
+	amount := new(big.Int).Div(fixture.Ether, big.NewInt(10))
+	for i := 0; i < n; i++ {
+		u := f.Users[i%len(f.Users)]
+		if _, err := f.Send(f.RestakingPool.Unstake(u.TransactOpts(), u.Address, amount)); err != nil {
+			return errors.Wrap(err, "unstake")
+		}
+	}
+	return nil
+}
+
+func (m *measurer) distribute(n int) error {
+	if err := m.queue(n); err != nil {
+		return err
+	}
+	f := m.f
+	opts := f.Operator.TransactOpts()
+	opts.GasLimit = distributeGas
+	if err := m.record("distributeUnstakes", fmt.Sprintf("unstakes=%d", n))(f.Send(f.RestakingPool.DistributeUnstakes(opts))); err != nil {
+		return err
+	}
+	left, err := f.RestakingPool.GetTotalPendingUnstakes(nil)
+	if err != nil {
+		return err
+	}
+	if left.Sign() != 0 {
+		return errors.Errorf("distributeUnstakes left %s wei queued with %d unstakes", left, n)
+	}
+	return nil
+}
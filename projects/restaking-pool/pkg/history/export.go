@@ -0,0 +1,41 @@
+package history
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// header is the CSV header row.
+var header = []string{"block", "time", "tx", "log_index", "sender", "contract", "address", "event", "param", "previous", "new"}
+
+// WriteCSV writes entries as CSV with a header row.
+func WriteCSV(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		err := cw.Write([]string{
+			strconv.FormatUint(e.Block, 10), e.Time.Format(time.RFC3339), e.Tx.Hex(), strconv.FormatUint(uint64(e.LogIndex), 10),
+			e.Sender.Hex(), e.Contract, e.Address.Hex(), e.Event, e.Param, e.Previous, e.New,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes entries as an indented JSON array.
+func WriteJSON(w io.Writer, entries []Entry) error {
+	if entries == nil {
+		entries = []Entry{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
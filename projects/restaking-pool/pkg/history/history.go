@@ -0,0 +1,248 @@
+// Package history replays the governance parameter changes of the protocol
+// into one chronological timeline.
+//
+// The *Changed events of the RestakingPool, RatioFeed, ProtocolConfig and
+// FeeCollector contracts are read with a backfill and turned into one entry
+// per parameter, with the previous and new value, the block time and the
+// sender of the transaction. StakeBonusParamsChanged and
+// UnstakeFeeParamsChanged only carry the new values: their previous values
+// are those of the event before them in the replay, and empty for the first.
+package history
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/backfill"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/webhook"
+)
+
+// Entry is the change of a parameter. Values are decimal integers, or hex
+// for addresses.
+type Entry struct {
+	Block    uint64         `json:"block"`
+	Time     time.Time      `json:"time"`
+	Tx       common.Hash    `json:"tx"`
+	LogIndex uint           `json:"logIndex"`
+	Sender   common.Address `json:"sender"`
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	Event    string         `json:"event"`
+	Param    string         `json:"param"`
+	// Previous is empty when neither the event nor an earlier one of the
+	// replay tells it.
+	Previous string `json:"previous"`
+	New      string `json:"new"`
+}
+
+// change is an event changing parameters, with the struct fields holding
+// their previous and new values. prev is nil when the event does not carry
+// the previous values.
+type change struct {
+	params []string
+	prev   []string
+	next   []string
+}
+
+func single(param string) change {
+	return change{[]string{param}, []string{"PrevValue"}, []string{"NewValue"}}
+}
+
+var changes = map[string]change{
+	// RestakingPool
+	"MinStakeChanged":           single("minStake"),
+	"MinUnstakeChanged":         single("minUnstake"),
+	"MaxTVLChanged":             single("maxTVL"),
+	"DistributeGasLimitChanged": single("distributeGasLimit"),
+	"StakeBonusParamsChanged": {
+		params: []string{"maxBonusRate", "optimalBonusRate", "stakeUtilizationKink"},
+		next:   []string{"NewMaxBonusRate", "NewOptimalBonusRate", "NewDepositUtilizationKink"},
+	},
+	"UnstakeFeeParamsChanged": {
+		params: []string{"maxFlashFeeRate", "optimalUnstakeRate", "unstakeUtilizationKink"},
+		next:   []string{"NewMaxFlashFeeRate", "NewOptimalWithdrawalRate", "NewWithdrawUtilizationKink"},
+	},
+	"ProtocolFeeChanged":     single("protocolFee"),
+	"TargetCapacityChanged":  single("targetCapacity"),
+	"RewardsTimelineChanged": {[]string{"rewardsTimeline"}, []string{"RewardsTimeline"}, []string{"NewTimelineInSeconds"}},
+	// RatioFeed
+	"RatioThresholdChanged": {[]string{"ratioThreshold"}, []string{"OldValue"}, []string{"NewValue"}},
+	// FeeCollector
+	"CommissionChanged": single("commission"),
+	// ProtocolConfig
+	"OperatorChanged":         single("operator"),
+	"GovernanceChanged":       single("governance"),
+	"TreasuryChanged":         single("treasury"),
+	"RatioFeedChanged":        single("ratioFeed"),
+	"CTokenChanged":           single("cToken"),
+	"RestakingPoolChanged":    single("restakingPool"),
+	"EigenManagerChanged":     single("eigenPodManager"),
+	"RestakerDeployerChanged": single("restakerDeployer"),
+}
+
+// Source is a contract whose changes are replayed.
+type Source struct {
+	Name    string
+	Address common.Address
+	Meta    interface{ GetAbi() (*abi.ABI, error) }
+	Parser  backfill.LogParser
+}
+
+// Sources returns the RestakingPool, RatioFeed and ProtocolConfig resolved
+// by c, followed by the FeeCollectors at feeCollectors, which ProtocolConfig
+// does not list.
+func Sources(c *client.Client, feeCollectors []common.Address) ([]Source, error) {
+	addrs := c.Addresses()
+	sources := []Source{
+		{"RestakingPool", addrs.RestakingPool, restakingpool.ContractMetaData, c.RestakingPool()},
+		{"RatioFeed", addrs.RatioFeed, ratiofeed.ContractMetaData, c.RatioFeed()},
+		{"ProtocolConfig", addrs.ProtocolConfig, protocolconfig.ContractMetaData, c.ProtocolConfig()},
+	}
+	for _, address := range feeCollectors {
+		collector, err := feecollector.NewContract(address, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not bind FeeCollector")
+		}
+		sources = append(sources, Source{"FeeCollector", address, feecollector.ContractMetaData, collector})
+	}
+	return sources, nil
+}
+
+// Backend is what a replay needs from a node.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// Replay reads the changes of sources in the block range of cfg, whose
+// Addresses and Topics it sets, and returns them in chain order.
+func Replay(ctx context.Context, backend Backend, sources []Source, cfg backfill.Config) ([]Entry, backfill.Stats, error) {
+	names := make(map[common.Address]string, len(sources))
+	decoders := make(map[common.Address]backfill.Decoder, len(sources))
+	topics := make(map[common.Hash]bool)
+	cfg.Addresses, cfg.Topics = nil, [][]common.Hash{nil}
+	for _, s := range sources {
+		parsed, err := s.Meta.GetAbi()
+		if err != nil {
+			return nil, backfill.Stats{}, err
+		}
+		for name, event := range parsed.Events {
+			if _, ok := changes[name]; ok && !topics[event.ID] {
+				topics[event.ID] = true
+				cfg.Topics[0] = append(cfg.Topics[0], event.ID)
+			}
+		}
+		names[s.Address], decoders[s.Address] = s.Name, backfill.Wrapper(parsed, s.Parser)
+		cfg.Addresses = append(cfg.Addresses, s.Address)
+	}
+	decoder := backfill.DecoderFunc(func(l types.Log) (interface{}, error) {
+		return decoders[l.Address].Decode(l)
+	})
+
+	r := &replay{backend: backend, last: make(map[string]string), times: make(map[uint64]time.Time), senders: make(map[common.Hash]common.Address)}
+	stats, err := backfill.Run(ctx, backend, cfg, decoder, func(e backfill.Event) error {
+		event, ok := e.Decoded.(generated.AbigenLog)
+		if !ok {
+			return nil
+		}
+		return r.add(ctx, names[e.Log.Address], e.Log, event)
+	})
+	return r.entries, stats, err
+}
+
+// replay collects the entries of a replay.
+type replay struct {
+	backend Backend
+	entries []Entry
+	// last is the latest value of every parameter, by address and name.
+	last    map[string]string
+	times   map[uint64]time.Time
+	senders map[common.Hash]common.Address
+}
+
+func (r *replay) add(ctx context.Context, contract string, l types.Log, event generated.AbigenLog) error {
+	name := webhook.EventName(event)
+	ch, ok := changes[name]
+	if !ok {
+		return nil
+	}
+	at, err := r.time(ctx, l.BlockNumber)
+	if err != nil {
+		return err
+	}
+	sender, err := r.sender(ctx, l.TxHash)
+	if err != nil {
+		return err
+	}
+	v := reflect.Indirect(reflect.ValueOf(event))
+	for i, param := range ch.params {
+		key := l.Address.Hex() + "/" + param
+		e := Entry{
+			Block: l.BlockNumber, Time: at, Tx: l.TxHash, LogIndex: l.Index, Sender: sender,
+			Contract: contract, Address: l.Address, Event: name, Param: param,
+			Previous: r.last[key], New: text(v.FieldByName(ch.next[i])),
+		}
+		if ch.prev != nil {
+			e.Previous = text(v.FieldByName(ch.prev[i]))
+		}
+		r.last[key] = e.New
+		r.entries = append(r.entries, e)
+	}
+	return nil
+}
+
+func (r *replay) time(ctx context.Context, block uint64) (time.Time, error) {
+	if t, ok := r.times[block]; ok {
+		return t, nil
+	}
+	header, err := r.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "could not get block %d", block)
+	}
+	t := time.Unix(int64(header.Time), 0).UTC()
+	r.times[block] = t
+	return t, nil
+}
+
+func (r *replay) sender(ctx context.Context, hash common.Hash) (common.Address, error) {
+	if s, ok := r.senders[hash]; ok {
+		return s, nil
+	}
+	tx, _, err := r.backend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "could not get transaction %s", hash.Hex())
+	}
+	s, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return common.Address{}, errors.Wrapf(err, "could not recover the sender of %s", hash.Hex())
+	}
+	r.senders[hash] = s
+	return s, nil
+}
+
+// text formats an event value.
+func text(v reflect.Value) string {
+	switch x := v.Interface().(type) {
+	case *big.Int:
+		return x.String()
+	case common.Address:
+		return x.Hex()
+	}
+	return fmt.Sprint(v.Interface())
+}
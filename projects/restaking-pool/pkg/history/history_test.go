@@ -0,0 +1,118 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/backfill"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+func TestReplay(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	gov := f.Governance.TransactOpts
+	if _, err := f.Send(f.RestakingPool.SetMaxTVL(gov(), new(big.Int).Mul(big.NewInt(64), fixture.Ether))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Send(f.RestakingPool.SetStakeBonusParams(gov(), 20e7, 25e6, 30e8)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Send(f.FeeCollector.SetCommission(gov(), 500)); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources, err := Sources(c, []common.Address{f.FeeCollector.Address()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := f.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, _, err := Replay(ctx, f.Backend, sources, backfill.Config{To: head.Number.Uint64(), ChunkSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	find := func(param string) []Entry {
+		var out []Entry
+		for _, e := range entries {
+			if e.Param == param {
+				out = append(out, e)
+			}
+		}
+		return out
+	}
+	for i := 1; i < len(entries); i++ {
+		a, b := entries[i-1], entries[i]
+		if a.Block > b.Block || a.Block == b.Block && a.LogIndex > b.LogIndex || a.Time.After(b.Time) {
+			t.Fatalf("entry %d %+v before %+v", i, a, b)
+		}
+	}
+
+	maxTVL := find("maxTVL")
+	if len(maxTVL) != 2 {
+		t.Fatalf("maxTVL entries %+v", maxTVL)
+	}
+	last := maxTVL[1]
+	if last.Previous != "32000000000000000000" || last.New != "64000000000000000000" || last.Sender != f.Governance.Address || last.Contract != "RestakingPool" || last.Event != "MaxTVLChanged" {
+		t.Errorf("entry %+v", last)
+	}
+	header, err := f.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(last.Block))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Time.Unix() != int64(header.Time) {
+		t.Errorf("time %v, block time %d", last.Time, header.Time)
+	}
+
+	// the previous bonus parameters come from the event before
+	bonus := find("maxBonusRate")
+	if len(bonus) != 2 || bonus[0].Previous != "" || bonus[0].New != "150000000" || bonus[1].Previous != "150000000" || bonus[1].New != "200000000" {
+		t.Errorf("maxBonusRate entries %+v", bonus)
+	}
+	if kink := find("stakeUtilizationKink"); len(kink) != 2 || kink[1].Previous != "2500000000" || kink[1].New != "3000000000" {
+		t.Errorf("stakeUtilizationKink entries %+v", kink)
+	}
+	if commission := find("commission"); len(commission) == 0 || commission[len(commission)-1].Previous != "1000" || commission[len(commission)-1].New != "500" {
+		t.Errorf("commission entries %+v", commission)
+	}
+	if governance := find("governance"); len(governance) == 0 || governance[0].New != f.Governance.Address.Hex() {
+		t.Errorf("governance entries %+v", governance)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(entries)+1 || rows[0][0] != "block" || rows[len(rows)-1][10] != "500" {
+		t.Errorf("%d rows for %d entries: %v", len(rows), len(entries), rows[len(rows)-1])
+	}
+	buf.Reset()
+	if err := WriteJSON(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []Entry
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || len(decoded) != len(entries) || decoded[0] != entries[0] {
+		t.Errorf("JSON %s: %v", buf.String(), err)
+	}
+}
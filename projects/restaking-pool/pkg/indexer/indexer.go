@@ -0,0 +1,375 @@
+// Package indexer follows the protocol contracts and stores their logs.
+//
+// Instead of the Watch* methods, which lose logs when the subscription
+// drops, the indexer reads logs with FilterLogs up to the block that has
+// the configured number of confirmations. A new head subscription only
+// wakes it up; polling takes over when the subscription fails. Before each
+// step the checkpoint hash is compared with the canonical chain and, after
+// a reorg, the logs above the common ancestor are rolled back and handed
+// to the handler again with Removed set.
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen"
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+)
+
+// Backend is what the indexer needs from a node.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// headSubscriber is implemented by backends that push new heads, such as
+// ethclient over a websocket and the simulated backend.
+type headSubscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+// LogParser decodes the logs of a contract; every generated wrapper with
+// events implements it.
+type LogParser interface {
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+}
+
+// Contract is a followed contract.
+type Contract struct {
+	Name    string
+	Address common.Address
+	Parser  LogParser
+}
+
+// Contracts returns the protocol contracts resolved by c.
+func Contracts(c *client.Client) []Contract {
+	addrs := c.Addresses()
+	return []Contract{
+		{"ProtocolConfig", addrs.ProtocolConfig, c.ProtocolConfig()},
+		{"cToken", addrs.CToken, c.CToken()},
+		{"RatioFeed", addrs.RatioFeed, c.RatioFeed()},
+		{"RestakingPool", addrs.RestakingPool, c.RestakingPool()},
+		{"RestakerDeployer", addrs.RestakerDeployer, c.RestakerDeployer()},
+	}
+}
+
+// Event is a decoded log. Log.Removed is set for the logs of blocks a reorg
+// dropped. Decoded is nil for events the wrapper does not know.
+type Event struct {
+	Contract string
+	Log      types.Log
+	Decoded  generated.AbigenLog
+}
+
+// Handler receives the events of a step in chain order: first the removed
+// ones, newest first, then the new ones. The step is only committed when
+// the handler succeeds, so events are delivered at least once.
+type Handler func(ctx context.Context, events []Event) error
+
+// Config configures an Indexer.
+type Config struct {
+	// Start is the first block to index when the store is empty, usually
+	// the deployment block of the protocol.
+	Start uint64
+	// Confirmations is the number of blocks, including its own, a block
+	// waits for before it is indexed. 1 indexes the head.
+	Confirmations uint64
+	// BatchSize is the number of blocks per FilterLogs call, 1000 by
+	// default.
+	BatchSize uint64
+	// ReorgDepth is how many blocks of hashes are kept to find the common
+	// ancestor after a reorg, 128 by default. When every indexed block was
+	// reorged the indexer starts over from Start; a deeper reorg of a
+	// longer indexed range is an error every step returns until the store
+	// is deleted.
+	ReorgDepth uint64
+	// PollInterval is the delay between two steps without a head
+	// subscription, 12 seconds by default.
+	PollInterval time.Duration
+}
+
+// Progress describes a step.
+type Progress struct {
+	Head       uint64
+	Checkpoint Checkpoint
+	Indexed    int
+	Removed    int
+	// Synced reports every confirmed block is indexed.
+	Synced bool
+}
+
+// Indexer indexes the logs of a set of contracts into a Store.
+type Indexer struct {
+	backend   Backend
+	store     *Store
+	contracts map[common.Address]Contract
+	addresses []common.Address
+	cfg       Config
+	handler   Handler
+}
+
+// New returns an indexer of contracts. handler may be nil.
+func New(backend Backend, store *Store, contracts []Contract, cfg Config, handler Handler) *Indexer {
+	if cfg.Confirmations == 0 {
+		cfg.Confirmations = 1
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = 1000
+	}
+	if cfg.ReorgDepth == 0 {
+		cfg.ReorgDepth = 128
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = 12 * time.Second
+	}
+	if handler == nil {
+		handler = func(context.Context, []Event) error { return nil }
+	}
+	ix := &Indexer{backend: backend, store: store, contracts: make(map[common.Address]Contract), cfg: cfg, handler: handler}
+	for _, c := range contracts {
+		ix.contracts[c.Address] = c
+		ix.addresses = append(ix.addresses, c.Address)
+	}
+	return ix
+}
+
+// Run steps until ctx is done, after every new head or PollInterval.
+// report receives every step that changed something and every error; step
+// errors do not stop the indexer.
+func (ix *Indexer) Run(ctx context.Context, report func(Progress, error)) error {
+	var (
+		heads chan *types.Header
+		sub   ethereum.Subscription
+	)
+	defer func() {
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	}()
+	for {
+		if sub == nil {
+			if hs, ok := ix.backend.(headSubscriber); ok {
+				heads = make(chan *types.Header, 16)
+				var err error
+				if sub, err = hs.SubscribeNewHead(ctx, heads); err != nil {
+					sub = nil
+					report(Progress{}, errors.Wrap(err, "could not subscribe to new heads, polling"))
+				}
+			}
+		}
+		for {
+			p, err := ix.Step(ctx)
+			if err != nil || p.Indexed > 0 || p.Removed > 0 {
+				report(p, err)
+			}
+			// catch up in several steps before waiting for a head
+			if err != nil || p.Synced {
+				break
+			}
+		}
+
+		var errc <-chan error
+		if sub != nil {
+			errc = sub.Err()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-heads:
+		case err := <-errc:
+			// the subscription dropped; poll until it is back
+			sub.Unsubscribe()
+			sub = nil
+			report(Progress{}, errors.Wrap(err, "head subscription dropped"))
+		case <-time.After(ix.cfg.PollInterval):
+		}
+	}
+}
+
+// Step handles a reorg of the indexed blocks, if any, then indexes up to
+// BatchSize confirmed blocks.
+func (ix *Indexer) Step(ctx context.Context) (Progress, error) {
+	head, err := ix.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return Progress{}, errors.Wrap(err, "could not get head")
+	}
+	p := Progress{Head: head.Number.Uint64()}
+	stored, ok, err := ix.store.Checkpoint()
+	if err != nil {
+		return p, err
+	}
+	cp, from, indexed := stored, ix.cfg.Start, ok
+	var events []Event
+	if ok {
+		if cp, indexed, events, err = ix.unwind(ctx, stored); err != nil {
+			return p, err
+		}
+		if indexed {
+			from = cp.Number + 1
+		}
+	}
+	p.Checkpoint, p.Removed = cp, len(events)
+
+	var (
+		logs   []types.Log
+		blocks []Checkpoint
+		next   = cp
+	)
+	p.Synced = true
+	if p.Head+1 >= ix.cfg.Confirmations && from <= p.Head+1-ix.cfg.Confirmations {
+		to := p.Head + 1 - ix.cfg.Confirmations
+		if to-from+1 > ix.cfg.BatchSize {
+			p.Synced = false
+			to = from + ix.cfg.BatchSize - 1
+		}
+		var indexed []Event
+		if logs, blocks, indexed, err = ix.fetch(ctx, from, to); err != nil {
+			return p, err
+		}
+		events = append(events, indexed...)
+		next = blocks[0]
+	}
+	rollback := ok && (!indexed || cp != stored)
+	if !rollback && len(blocks) == 0 {
+		return p, nil
+	}
+
+	if len(events) > 0 {
+		if err := ix.handler(ctx, events); err != nil {
+			return p, errors.Wrap(err, "handler")
+		}
+	}
+	u := update{rollback: rollback, removeFrom: from, logs: logs, hashes: blocks}
+	if indexed || len(blocks) > 0 {
+		u.checkpoint = &next
+	}
+	if len(blocks) > 0 && next.Number > ix.cfg.ReorgDepth {
+		u.keepFrom = next.Number - ix.cfg.ReorgDepth
+	}
+	if err := ix.store.write(u); err != nil {
+		return p, err
+	}
+	p.Checkpoint, p.Indexed = next, len(logs)
+	return p, nil
+}
+
+// fetch reads and decodes the logs of blocks from to to. The first returned
+// block is to, followed by the blocks that have logs.
+func (ix *Indexer) fetch(ctx context.Context, from, to uint64) ([]types.Log, []Checkpoint, []Event, error) {
+	end, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(to))
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "could not get header %d", to)
+	}
+	logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: ix.addresses,
+	})
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "could not filter logs %d-%d", from, to)
+	}
+
+	// a reorg between reading the end block and the logs shows as a
+	// different hash, here or at the next step
+	blocks := []Checkpoint{{Number: to, Hash: end.Hash()}}
+	hashes := map[uint64]common.Hash{to: end.Hash()}
+	out := make([]types.Log, 0, len(logs))
+	events := make([]Event, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		if h, ok := hashes[l.BlockNumber]; !ok {
+			hashes[l.BlockNumber] = l.BlockHash
+			blocks = append(blocks, Checkpoint{Number: l.BlockNumber, Hash: l.BlockHash})
+		} else if h != l.BlockHash {
+			return nil, nil, nil, errors.Errorf("block %d changed while indexing", l.BlockNumber)
+		}
+		l = abigen.DeepCopyLog(l)
+		out = append(out, l)
+		events = append(events, ix.decode(l))
+	}
+	return out, blocks, events, nil
+}
+
+// unwind compares the checkpoint with the canonical chain. After a reorg
+// it returns the common ancestor and the removed events, newest first,
+// without changing the store. It reports false when every indexed block
+// was reorged and there is no ancestor to resume from.
+func (ix *Indexer) unwind(ctx context.Context, cp Checkpoint) (Checkpoint, bool, []Event, error) {
+	canonical := func(c Checkpoint) (bool, error) {
+		header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(c.Number))
+		if errors.Is(err, ethereum.NotFound) {
+			// the new chain is shorter than the indexed one
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get header %d", c.Number)
+		}
+		return header.Hash() == c.Hash, nil
+	}
+	if ok, err := canonical(cp); ok || err != nil {
+		return cp, true, nil, err
+	}
+
+	var from uint64
+	if cp.Number > ix.cfg.ReorgDepth {
+		from = cp.Number - ix.cfg.ReorgDepth
+	}
+	known, err := ix.store.hashes(from, cp.Number)
+	if err != nil {
+		return cp, true, nil, err
+	}
+	ancestor, found := Checkpoint{}, false
+	for _, c := range known {
+		ok, err := canonical(c)
+		if err != nil {
+			return cp, true, nil, err
+		}
+		if ok {
+			ancestor, found = c, true
+			break
+		}
+	}
+	removeFrom := ancestor.Number + 1
+	if !found {
+		if from > ix.cfg.Start {
+			return cp, true, nil, errors.Errorf("no common ancestor within %d blocks of %d, delete the store to index again", ix.cfg.ReorgDepth, cp.Number)
+		}
+		// every indexed block was reorged, start over
+		removeFrom = ix.cfg.Start
+	}
+
+	logs, err := ix.store.Logs(removeFrom, cp.Number)
+	if err != nil {
+		return cp, true, nil, err
+	}
+	removed := make([]Event, 0, len(logs))
+	for i := len(logs) - 1; i >= 0; i-- {
+		l := abigen.DeepCopyLog(logs[i])
+		l.Removed = true
+		removed = append(removed, ix.decode(l))
+	}
+	return ancestor, found, removed, nil
+}
+
+// decode parses l with the wrapper of its contract. ParseLog rejects the
+// events the ABI does not declare, which are kept undecoded.
+func (ix *Indexer) decode(l types.Log) Event {
+	c := ix.contracts[l.Address]
+	ev := Event{Contract: c.Name, Log: l}
+	if c.Parser != nil && len(l.Topics) > 0 {
+		if decoded, err := c.Parser.ParseLog(l); err == nil {
+			ev.Decoded = decoded
+		}
+	}
+	return ev
+}
@@ -0,0 +1,276 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+type recorder struct {
+	events []Event
+}
+
+func (r *recorder) handle(_ context.Context, events []Event) error {
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func sync(t *testing.T, ix *Indexer) Progress {
+	t.Helper()
+	for {
+		p, err := ix.Step(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Synced {
+			return p
+		}
+	}
+}
+
+// canonical returns the logs of the followed contracts up to block to.
+func canonical(t *testing.T, f *fixture.Fixture, ix *Indexer, to uint64) []types.Log {
+	t.Helper()
+	logs, err := f.Backend.FilterLogs(context.Background(), ethereum.FilterQuery{
+		FromBlock: new(big.Int),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: ix.addresses,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return logs
+}
+
+func sameLogs(t *testing.T, got, want []types.Log) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%d logs stored, %d on chain", len(got), len(want))
+	}
+	for i := range got {
+		if got[i].BlockHash != want[i].BlockHash || got[i].Index != want[i].Index || got[i].TxHash != want[i].TxHash {
+			t.Fatalf("log %d: stored %+v, on chain %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func head(t *testing.T, f *fixture.Fixture) *types.Header {
+	t.Helper()
+	h, err := f.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestIndexer(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "index")
+	store, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := Config{Confirmations: 2, BatchSize: 10}
+	rec := new(recorder)
+	ix := New(f.Backend, store, Contracts(c), cfg, rec.handle)
+
+	for _, u := range f.Users {
+		if _, err := f.Send(f.RestakingPool.Stake(u.WithValue(fixture.Ether))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p := sync(t, ix)
+	if p.Checkpoint.Number != p.Head-1 {
+		t.Fatalf("checkpoint %d with head %d and 2 confirmations", p.Checkpoint.Number, p.Head)
+	}
+	logs, err := store.Logs(0, p.Checkpoint.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameLogs(t, logs, canonical(t, f, ix, p.Checkpoint.Number))
+	staked := 0
+	for _, ev := range rec.events {
+		if _, ok := ev.Decoded.(*restakingpool.ContractStaked); ok && ev.Contract == "RestakingPool" {
+			staked++
+		}
+	}
+	// the last stake is not confirmed yet
+	if staked != len(f.Users)-1 || len(rec.events) != len(logs) {
+		t.Fatalf("%d Staked of %d events", staked, len(rec.events))
+	}
+
+	// a restart resumes at the checkpoint
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if store, err = Open(path); err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	rec = new(recorder)
+	ix = New(f.Backend, store, Contracts(c), cfg, rec.handle)
+	if p = sync(t, ix); p.Indexed != 0 || len(rec.events) != 0 {
+		t.Fatalf("restart indexed %d logs again", p.Indexed)
+	}
+
+	// replace the last two indexed blocks with a longer side chain
+	fork := p.Checkpoint.Number - 2
+	dropped, err := store.Logs(fork+1, p.Checkpoint.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent, err := f.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(fork))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Backend.Fork(ctx, parent.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.RestakingPool.Stake(f.Users[0].WithValue(new(big.Int).Mul(big.NewInt(2), fixture.Ether))); err != nil {
+		t.Fatal(err)
+	}
+	for head(t, f).Number.Uint64() <= p.Head {
+		f.Backend.Commit()
+	}
+	f.Backend.Commit()
+
+	p = sync(t, ix)
+	var removed []Event
+	for _, ev := range rec.events {
+		if ev.Log.Removed {
+			removed = append(removed, ev)
+		}
+	}
+	if len(dropped) == 0 || len(removed) != len(dropped) {
+		t.Fatalf("%d removed events for %d dropped logs", len(removed), len(dropped))
+	}
+	for i, ev := range removed {
+		// newest first
+		if want := dropped[len(dropped)-1-i]; ev.Log.TxHash != want.TxHash || ev.Log.Index != want.Index || ev.Decoded == nil {
+			t.Fatalf("removed event %d: %+v, want %+v", i, ev.Log, want)
+		}
+	}
+	if logs, err = store.Logs(0, p.Checkpoint.Number); err != nil {
+		t.Fatal(err)
+	}
+	sameLogs(t, logs, canonical(t, f, ix, p.Checkpoint.Number))
+}
+
+func TestReorgOfEveryBlock(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := Open(filepath.Join(t.TempDir(), "index"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	rec := new(recorder)
+	ix := New(f.Backend, store, Contracts(c), Config{}, rec.handle)
+	p := sync(t, ix)
+	indexed := len(rec.events)
+	if indexed == 0 {
+		t.Fatal("nothing indexed")
+	}
+
+	// a side chain from genesis drops every indexed block, with Start 0
+	genesis, err := f.Backend.HeaderByNumber(ctx, new(big.Int))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Backend.Fork(ctx, genesis.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	for head(t, f).Number.Uint64() <= p.Head {
+		f.Backend.Commit()
+	}
+	p = sync(t, ix)
+	if p.Removed != indexed || len(rec.events) != 2*indexed {
+		t.Fatalf("%d of %d indexed events removed", p.Removed, indexed)
+	}
+	logs, err := store.Logs(0, p.Checkpoint.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 0 {
+		t.Fatalf("%d logs left after the reorg", len(logs))
+	}
+	if cp, ok, err := store.Checkpoint(); err != nil || !ok || cp != p.Checkpoint {
+		t.Fatalf("checkpoint %+v %v, want %+v: %v", cp, ok, p.Checkpoint, err)
+	}
+}
+
+func TestRunFollowsHeads(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := Open(filepath.Join(t.TempDir(), "index"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	ix := New(f.Backend, store, Contracts(c), Config{PollInterval: time.Hour}, nil)
+	done := make(chan error, 1)
+	go func() {
+		done <- ix.Run(ctx, func(_ Progress, err error) {
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}()
+
+	receipt, err := f.Send(f.RestakingPool.Stake(f.Users[0].WithValue(fixture.Ether)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		cp, ok, err := store.Checkpoint()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok && cp.Number >= receipt.BlockNumber.Uint64() {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("new head not indexed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,173 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Key layout. Numbers are big endian so that keys sort by block, then by
+// log index.
+var (
+	checkpointKey = []byte("checkpoint")
+	hashPrefix    = []byte("h/") // h/<block> -> block hash
+	logPrefix     = []byte("l/") // l/<block><log index> -> log JSON
+)
+
+// Checkpoint is the last indexed block.
+type Checkpoint struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// Store persists the indexed logs, the hashes of the recent indexed blocks
+// and the checkpoint in a LevelDB database. Every indexing step, with the
+// rollback it starts with, is written in one batch, so a restart resumes
+// exactly at the checkpoint.
+type Store struct {
+	db *leveldb.DB
+}
+
+// Open opens or creates the store at path.
+func Open(path string) (*Store, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open store %s", path)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Checkpoint returns the last indexed block, and false when nothing was
+// indexed yet.
+func (s *Store) Checkpoint() (Checkpoint, bool, error) {
+	var cp Checkpoint
+	data, err := s.db.Get(checkpointKey, nil)
+	if err == leveldb.ErrNotFound {
+		return cp, false, nil
+	}
+	if err != nil {
+		return cp, false, errors.Wrap(err, "could not read checkpoint")
+	}
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return cp, false, errors.Wrap(err, "could not decode checkpoint")
+	}
+	return cp, true, nil
+}
+
+// Logs returns the indexed logs of blocks from to to, in chain order.
+func (s *Store) Logs(from, to uint64) ([]types.Log, error) {
+	it := s.db.NewIterator(&util.Range{Start: logKey(from, 0), Limit: logKey(to+1, 0)}, nil)
+	defer it.Release()
+	var logs []types.Log
+	for it.Next() {
+		var l types.Log
+		if err := json.Unmarshal(it.Value(), &l); err != nil {
+			return nil, errors.Wrapf(err, "could not decode log %x", it.Key())
+		}
+		logs = append(logs, l)
+	}
+	return logs, errors.Wrap(it.Error(), "could not read logs")
+}
+
+// hashes returns the stored block hashes from to to, newest first.
+func (s *Store) hashes(from, to uint64) ([]Checkpoint, error) {
+	it := s.db.NewIterator(&util.Range{Start: hashKey(from), Limit: hashKey(to + 1)}, nil)
+	defer it.Release()
+	var out []Checkpoint
+	for it.Next() {
+		key := it.Key()
+		out = append(out, Checkpoint{
+			Number: binary.BigEndian.Uint64(key[len(hashPrefix):]),
+			Hash:   common.BytesToHash(it.Value()),
+		})
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out, errors.Wrap(it.Error(), "could not read block hashes")
+}
+
+// update is what a step writes to the store.
+type update struct {
+	// rollback deletes the logs and block hashes from block removeFrom on.
+	rollback   bool
+	removeFrom uint64
+	logs       []types.Log
+	hashes     []Checkpoint
+	// keepFrom prunes the older block hashes when it is not zero.
+	keepFrom uint64
+	// checkpoint is nil when a rollback left nothing indexed.
+	checkpoint *Checkpoint
+}
+
+// write applies u in one batch: the rollback, then the logs and block
+// hashes of the indexed range, the pruning and the checkpoint.
+func (s *Store) write(u update) error {
+	batch := new(leveldb.Batch)
+	if u.rollback {
+		for _, r := range []*util.Range{
+			{Start: logKey(u.removeFrom, 0), Limit: util.BytesPrefix(logPrefix).Limit},
+			{Start: hashKey(u.removeFrom), Limit: util.BytesPrefix(hashPrefix).Limit},
+		} {
+			if err := s.deleteRange(batch, r); err != nil {
+				return errors.Wrap(err, "could not roll back")
+			}
+		}
+	}
+	for _, l := range u.logs {
+		data, err := json.Marshal(l)
+		if err != nil {
+			return errors.Wrap(err, "could not encode log")
+		}
+		batch.Put(logKey(l.BlockNumber, l.Index), data)
+	}
+	for _, h := range u.hashes {
+		batch.Put(hashKey(h.Number), h.Hash.Bytes())
+	}
+	if u.keepFrom > 0 {
+		if err := s.deleteRange(batch, &util.Range{Start: hashKey(0), Limit: hashKey(u.keepFrom)}); err != nil {
+			return errors.Wrap(err, "could not prune block hashes")
+		}
+	}
+	if u.checkpoint == nil {
+		batch.Delete(checkpointKey)
+	} else {
+		data, err := json.Marshal(u.checkpoint)
+		if err != nil {
+			return err
+		}
+		batch.Put(checkpointKey, data)
+	}
+	return errors.Wrap(s.db.Write(batch, nil), "could not write batch")
+}
+
+// deleteRange adds the deletion of the stored keys in r to batch.
+func (s *Store) deleteRange(batch *leveldb.Batch, r *util.Range) error {
+	it := s.db.NewIterator(r, nil)
+	defer it.Release()
+	for it.Next() {
+		batch.Delete(append([]byte(nil), it.Key()...))
+	}
+	return it.Error()
+}
+
+func hashKey(number uint64) []byte {
+	key := append([]byte(nil), hashPrefix...)
+	return binary.BigEndian.AppendUint64(key, number)
+}
+
+func logKey(number uint64, index uint) []byte {
+	key := append([]byte(nil), logPrefix...)
+	key = binary.BigEndian.AppendUint64(key, number)
+	return binary.BigEndian.AppendUint32(key, uint32(index))
+}
@@ -0,0 +1,196 @@
+package invariant
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// Op is a kind of random action.
+type Op uint8
+
+const (
+	OpStake Op = iota
+	OpUnstake
+	OpFlashUnstake
+	OpDistributeUnstakes
+	OpClaimUnstake
+	OpAddRewards
+	OpUpdateRatio
+	OpAdvanceTime
+
+	numOps
+)
+
+var opNames = [numOps]string{
+	"stake", "unstake", "flashUnstake", "distributeUnstakes",
+	"claimUnstake", "addRewards", "updateRatio", "advanceTime",
+}
+
+func (op Op) String() string {
+	if op < numOps {
+		return opNames[op]
+	}
+	return fmt.Sprintf("op(%d)", uint8(op))
+}
+
+// actionSize is the number of input bytes consumed per action.
+const actionSize = 4
+
+// distributeGas is the gas limit of distributeUnstakes. Its loop stops once
+// gasleft() drops under the distribute gas limit, so an estimated limit
+// would never pay anything out.
+const distributeGas = 2_000_000
+
+// Action is one step of a random sequence. Actor indexes the fixture users
+// and Param scales the amount, see Apply.
+type Action struct {
+	Op    Op
+	Actor uint8
+	Param uint16
+}
+
+func (a Action) String() string {
+	return fmt.Sprintf("%s(user%d, %d)", a.Op, a.Actor, a.Param)
+}
+
+// MaxActions bounds a sequence so a single fuzz input stays fast to run.
+const MaxActions = 64
+
+// Decode splits fuzzer input into at most MaxActions actions, ignoring a
+// trailing partial one.
+func Decode(data []byte, users int) []Action {
+	if len(data) > MaxActions*actionSize {
+		data = data[:MaxActions*actionSize]
+	}
+	actions := make([]Action, 0, len(data)/actionSize)
+	for ; len(data) >= actionSize; data = data[actionSize:] {
+		actions = append(actions, Action{
+			Op:    Op(data[0] % uint8(numOps)),
+			Actor: data[1] % uint8(users),
+			Param: uint16(data[2])<<8 | uint16(data[3]),
+		})
+	}
+	return actions
+}
+
+// Encode is the inverse of Decode, used to write seeds.
+func Encode(actions ...Action) []byte {
+	data := make([]byte, 0, len(actions)*actionSize)
+	for _, a := range actions {
+		data = append(data, byte(a.Op), a.Actor, byte(a.Param>>8), byte(a.Param))
+	}
+	return data
+}
+
+// Format renders a sequence one action per line.
+func Format(actions []Action) string {
+	lines := make([]string, len(actions))
+	for i, a := range actions {
+		lines[i] = fmt.Sprintf("%3d: %s", i, a)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Apply executes an action. Reverts are part of normal operation (too small
+// amounts, empty queue, ratio cooldown...) and reported as applied=false;
+// only infrastructure failures return an error.
+func Apply(f *fixture.Fixture, a Action) (applied bool, err error) {
+	user := f.Users[int(a.Actor)%len(f.Users)]
+	pool := f.RestakingPool
+	param := big.NewInt(int64(a.Param))
+
+	switch a.Op {
+	case OpStake:
+		// up to ~6.5 ETH in 0.0001 ETH steps
+		amount := new(big.Int).Mul(param, big.NewInt(1e14))
+		return sent(f.Send(pool.Stake(user.WithValue(amount))))
+	case OpUnstake, OpFlashUnstake:
+		balance, err := f.CToken.BalanceOf(nil, user.Address)
+		if err != nil {
+			return false, err
+		}
+		shares := fraction(balance, a.Param)
+		if a.Op == OpUnstake {
+			return sent(f.Send(pool.Unstake(user.TransactOpts(), user.Address, shares)))
+		}
+		return sent(f.Send(pool.FlashUnstake(user.TransactOpts(), shares, user.Address)))
+	case OpDistributeUnstakes:
+		opts := f.Operator.TransactOpts()
+		opts.GasLimit = distributeGas
+		return sent(f.Send(pool.DistributeUnstakes(opts)))
+	case OpClaimUnstake:
+		return sent(f.Send(pool.ClaimUnstake(user.TransactOpts(), user.Address)))
+	case OpAddRewards:
+		// up to ~0.65 ETH
+		amount := new(big.Int).Mul(param, big.NewInt(1e13))
+		return sent(f.Send(pool.AddRewards(f.Operator.WithValue(amount))))
+	case OpUpdateRatio:
+		ratio, ok, err := fairRatio(f)
+		if err != nil || !ok {
+			return false, err
+		}
+		return sent(f.Send(f.RatioFeed.UpdateRatio(f.Operator.TransactOpts(), f.CToken.Address(), ratio)))
+	case OpAdvanceTime:
+		// up to ~45 days in minutes
+		if err := f.AdvanceTime(time.Duration(a.Param) * time.Minute); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, errors.Errorf("unknown op %d", a.Op)
+}
+
+// sent tells reverted transactions apart from other failures. The
+// simulated backend reports most reverts from gas estimation, before
+// anything is mined.
+func sent(_ interface{}, err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if strings.Contains(err.Error(), "revert") {
+		return false, nil
+	}
+	return false, err
+}
+
+// fraction returns value * param / 65535.
+func fraction(value *big.Int, param uint16) *big.Int {
+	n := new(big.Int).Mul(value, big.NewInt(int64(param)))
+	return n.Div(n, big.NewInt(0xffff))
+}
+
+// fairRatio is what an honest oracle would report: shares per backing ETH,
+// clamped to the decrease RatioFeed accepts in one update. ok is false when
+// there is nothing to report or the ratio would have to go up.
+func fairRatio(f *fixture.Fixture) (*big.Int, bool, error) {
+	s, err := ReadState(f)
+	if err != nil {
+		return nil, false, err
+	}
+	if s.Supply.Sign() == 0 || s.Backing().Sign() <= 0 {
+		return nil, false, nil
+	}
+	// rounded up so the supply is never valued above its backing
+	ratio := new(big.Int).Mul(s.Supply, fixture.Ether)
+	ratio.Add(ratio, s.Backing()).Sub(ratio, big.NewInt(1)).Div(ratio, s.Backing())
+	if ratio.Cmp(s.Ratio) >= 0 {
+		return nil, false, nil
+	}
+	threshold, err := f.RatioFeed.RatioThreshold(nil)
+	if err != nil {
+		return nil, false, err
+	}
+	floor := new(big.Int).Mul(s.Ratio, threshold)
+	floor.Div(floor, big.NewInt(1e8))
+	floor.Sub(s.Ratio, floor)
+	if ratio.Cmp(floor) < 0 {
+		ratio = floor
+	}
+	return ratio, true, nil
+}
@@ -0,0 +1,85 @@
+package invariant
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+var (
+	fuzzOnce     sync.Once
+	fuzzMu       sync.Mutex
+	fuzzFixture  *fixture.Fixture
+	fuzzSnapshot uint64
+	fuzzErr      error
+)
+
+// sharedFixture deploys once per process. Every input starts from the same
+// snapshot, which keeps the fuzzer fast and its findings reproducible.
+func sharedFixture(t *testing.T) *fixture.Fixture {
+	fuzzOnce.Do(func() {
+		opts := fixture.DefaultOptions()
+		opts.Users, opts.MaxTVL = 3, new(big.Int).Mul(big.NewInt(1000), fixture.Ether)
+		fuzzFixture, fuzzErr = fixture.New(opts)
+		if fuzzErr == nil {
+			fuzzSnapshot = fuzzFixture.Snapshot()
+		}
+	})
+	if fuzzErr != nil {
+		t.Fatal(fuzzErr)
+	}
+	if err := fuzzFixture.Revert(fuzzSnapshot); err != nil {
+		t.Fatal(err)
+	}
+	return fuzzFixture
+}
+
+func FuzzRestakingPool(f *testing.F) {
+	f.Add(Encode(
+		Action{OpStake, 0, 10000},
+		Action{OpUnstake, 0, 30000},
+		Action{OpDistributeUnstakes, 0, 0},
+		Action{OpClaimUnstake, 0, 0},
+	))
+	f.Add(Encode(
+		Action{OpStake, 0, 20000},
+		Action{OpStake, 1, 5000},
+		Action{OpFlashUnstake, 1, 40000},
+		Action{OpStake, 2, 3000},
+		Action{OpUnstake, 0, 65535},
+		Action{OpDistributeUnstakes, 0, 0},
+	))
+	f.Add(Encode(
+		Action{OpStake, 0, 30000},
+		Action{OpAddRewards, 0, 5000},
+		Action{OpAdvanceTime, 0, 3 * 24 * 60},
+		Action{OpUpdateRatio, 0, 0},
+		Action{OpAdvanceTime, 0, 5 * 24 * 60},
+		Action{OpUpdateRatio, 0, 0},
+		Action{OpUnstake, 0, 20000},
+		Action{OpFlashUnstake, 0, 20000},
+		Action{OpStake, 1, 1000},
+	))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fuzzMu.Lock()
+		defer fuzzMu.Unlock()
+		fx := sharedFixture(t)
+
+		actions := Decode(data, len(fx.Users))
+		for i, a := range actions {
+			if _, err := Apply(fx, a); err != nil {
+				t.Fatalf("step %d: %v\n%s", i, err, Format(actions[:i+1]))
+			}
+			s, err := ReadState(fx)
+			if err == nil {
+				err = Check(s, int64(2*(i+1)))
+			}
+			if err != nil {
+				t.Fatalf("invariant broken after step %d: %v\n%s", i, err, Format(actions[:i+1]))
+			}
+		}
+	})
+}
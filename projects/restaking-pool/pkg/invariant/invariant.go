@@ -0,0 +1,157 @@
+// Package invariant drives random action sequences through RestakingPool,
+// cToken and RatioFeed on a fixture and checks protocol invariants after
+// every step.
+//
+// The fuzz target keeps failing inputs, minimized by the Go fuzzer, in
+// testdata/fuzz/FuzzRestakingPool where plain `go test` replays them:
+//
+//	go test -fuzz=FuzzRestakingPool -fuzztime=5m ./pkg/invariant
+package invariant
+
+import (
+	"fmt"
+	"math/big"
+
+	"go.uber.org/multierr"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// State is a snapshot of the views the invariants are checked on.
+type State struct {
+	PoolBalance      *big.Int
+	TotalAssets      *big.Int
+	TotalClaimable   *big.Int
+	PendingUnstakes  *big.Int
+	StakeBonus       *big.Int
+	Supply           *big.Int
+	Ratio            *big.Int
+	TokenTotalAssets *big.Int
+	FreeBalance      *big.Int
+	Pending          *big.Int
+
+	// QueueSum is the sum of getUnstakes.
+	QueueSum *big.Int
+	// Per fixture user.
+	Shares         []*big.Int
+	Claimable      []*big.Int
+	UnstakesOf     []*big.Int
+	UnstakesOfSums []*big.Int
+}
+
+// Backing is the ETH that belongs to cToken holders: assets minus what is
+// owed to the queue and claimers and the reserved stake bonus.
+func (s *State) Backing() *big.Int {
+	b := new(big.Int).Sub(s.TotalAssets, s.TotalClaimable)
+	b.Sub(b, s.PendingUnstakes)
+	return b.Sub(b, s.StakeBonus)
+}
+
+// ReadState reads the pool, token and feed views.
+func ReadState(f *fixture.Fixture) (*State, error) {
+	pool, token := f.RestakingPool, f.CToken
+	s := &State{}
+	var err error
+	read := func(v **big.Int, get func() (*big.Int, error)) {
+		if err != nil {
+			return
+		}
+		*v, err = get()
+	}
+	read(&s.PoolBalance, func() (*big.Int, error) { return f.Balance(pool.Address()) })
+	read(&s.TotalAssets, func() (*big.Int, error) { return pool.TotalAssets(nil) })
+	read(&s.TotalClaimable, func() (*big.Int, error) { return pool.GetTotalClaimable(nil) })
+	read(&s.PendingUnstakes, func() (*big.Int, error) { return pool.GetTotalPendingUnstakes(nil) })
+	read(&s.StakeBonus, func() (*big.Int, error) { return pool.StakeBonusAmount(nil) })
+	read(&s.Supply, func() (*big.Int, error) { return token.TotalSupply(nil) })
+	read(&s.Ratio, func() (*big.Int, error) { return f.RatioFeed.GetRatio(nil, token.Address()) })
+	read(&s.TokenTotalAssets, func() (*big.Int, error) { return token.TotalAssets(nil) })
+	read(&s.FreeBalance, func() (*big.Int, error) { return pool.GetFreeBalance(nil) })
+	read(&s.Pending, func() (*big.Int, error) { return pool.GetPending(nil) })
+	read(&s.QueueSum, func() (*big.Int, error) {
+		queue, err := pool.GetUnstakes(nil)
+		sum := new(big.Int)
+		for _, u := range queue {
+			sum.Add(sum, u.Amount)
+		}
+		return sum, err
+	})
+	for _, u := range f.Users {
+		var shares, claimable, unstakesOf, unstakesOfSum *big.Int
+		read(&shares, func() (*big.Int, error) { return token.BalanceOf(nil, u.Address) })
+		read(&claimable, func() (*big.Int, error) { return pool.ClaimableOf(nil, u.Address) })
+		read(&unstakesOf, func() (*big.Int, error) { return pool.GetTotalUnstakesOf(nil, u.Address) })
+		read(&unstakesOfSum, func() (*big.Int, error) {
+			queue, err := pool.GetUnstakesOf(nil, u.Address)
+			sum := new(big.Int)
+			for _, u := range queue {
+				sum.Add(sum, u.Amount)
+			}
+			return sum, err
+		})
+		s.Shares = append(s.Shares, shares)
+		s.Claimable = append(s.Claimable, claimable)
+		s.UnstakesOf = append(s.UnstakesOf, unstakesOf)
+		s.UnstakesOfSums = append(s.UnstakesOfSums, unstakesOfSum)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Check verifies the invariants on s. Rounding in cToken conversions may
+// leave up to dust wei on either side of the solvency check.
+func Check(s *State, dust int64) error {
+	var err error
+	fail := func(format string, args ...interface{}) {
+		err = multierr.Append(err, fmt.Errorf(format, args...))
+	}
+	sum := func(values []*big.Int) *big.Int {
+		total := new(big.Int)
+		for _, v := range values {
+			total.Add(total, v)
+		}
+		return total
+	}
+
+	// Queue accounting
+	if s.QueueSum.Cmp(s.PendingUnstakes) != 0 {
+		fail("sum of getUnstakes %s != getTotalPendingUnstakes %s", s.QueueSum, s.PendingUnstakes)
+	}
+	if total := sum(s.UnstakesOf); total.Cmp(s.PendingUnstakes) != 0 {
+		fail("sum of getTotalUnstakesOf %s != getTotalPendingUnstakes %s", total, s.PendingUnstakes)
+	}
+	for i := range s.UnstakesOf {
+		if s.UnstakesOf[i].Cmp(s.UnstakesOfSums[i]) != 0 {
+			fail("user%d: getTotalUnstakesOf %s != sum of getUnstakesOf %s", i, s.UnstakesOf[i], s.UnstakesOfSums[i])
+		}
+	}
+
+	// Claims
+	if total := sum(s.Claimable); total.Cmp(s.TotalClaimable) != 0 {
+		fail("sum of claimableOf %s != getTotalClaimable %s", total, s.TotalClaimable)
+	}
+	if s.TotalClaimable.Cmp(s.PoolBalance) > 0 {
+		fail("getTotalClaimable %s exceeds pool balance %s", s.TotalClaimable, s.PoolBalance)
+	}
+	if s.TotalAssets.Cmp(s.PoolBalance) > 0 {
+		fail("totalAssets %s exceeds pool balance %s", s.TotalAssets, s.PoolBalance)
+	}
+
+	// Shares
+	if total := sum(s.Shares); total.Cmp(s.Supply) != 0 {
+		fail("sum of balances %s != totalSupply %s", total, s.Supply)
+	}
+	if s.FreeBalance.Cmp(s.Pending) > 0 {
+		fail("getFreeBalance %s exceeds getPending %s", s.FreeBalance, s.Pending)
+	}
+
+	// Solvency: supply valued at the ratio never exceeds what backs it. The
+	// ratio may lag behind rewards, so the other direction is not checked.
+	limit := new(big.Int).Add(s.Backing(), big.NewInt(dust))
+	if s.TokenTotalAssets.Cmp(limit) > 0 {
+		fail("cToken.totalAssets %s exceeds backing %s (ratio %s)", s.TokenTotalAssets, s.Backing(), s.Ratio)
+	}
+	return err
+}
@@ -0,0 +1,230 @@
+// Package metrics exports the protocol state to Prometheus.
+//
+// Every scrape reads the pool, cToken and RatioFeed state at the head block
+// seen when the scrape started, so the values of a scrape are consistent
+// with each other. Event counters are fed by an indexer through Handle.
+package metrics
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/ratiorules"
+)
+
+const namespace = "genesis"
+
+// Backend is what a scrape needs from a node.
+type Backend interface {
+	ratiorules.StorageReader
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Snapshot is the protocol state at a block. Amounts are in wei and ratios
+// scaled by 1e18.
+type Snapshot struct {
+	Block uint64
+	Time  uint64
+
+	TotalAssets          *big.Int
+	FlashCapacity        *big.Int
+	AvailableToStake     *big.Int
+	MaxTVL               *big.Int
+	TotalPendingUnstakes *big.Int
+	TotalClaimable       *big.Int
+	FreeBalance          *big.Int
+
+	Ratio       *big.Int
+	TotalSupply *big.Int
+
+	FeedRatio *big.Int
+	// LastUpdate is the timestamp of the last updateRatio of the cToken.
+	LastUpdate uint64
+}
+
+// Read reads the snapshot at the head block.
+func Read(ctx context.Context, c *client.Client, backend Backend) (*Snapshot, error) {
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head")
+	}
+	s := &Snapshot{Block: head.Number.Uint64(), Time: head.Time}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+
+	pool := c.RestakingPool()
+	for _, read := range []struct {
+		name string
+		dst  **big.Int
+		call func(*bind.CallOpts) (*big.Int, error)
+	}{
+		{"totalAssets", &s.TotalAssets, pool.TotalAssets},
+		{"getFlashCapacity", &s.FlashCapacity, pool.GetFlashCapacity},
+		{"availableToStake", &s.AvailableToStake, pool.AvailableToStake},
+		{"maxTVL", &s.MaxTVL, pool.MaxTVL},
+		{"getTotalPendingUnstakes", &s.TotalPendingUnstakes, pool.GetTotalPendingUnstakes},
+		{"getTotalClaimable", &s.TotalClaimable, pool.GetTotalClaimable},
+		{"getFreeBalance", &s.FreeBalance, pool.GetFreeBalance},
+		{"ratio", &s.Ratio, c.CToken().Ratio},
+		{"totalSupply", &s.TotalSupply, c.CToken().TotalSupply},
+	} {
+		if *read.dst, err = read.call(opts); err != nil {
+			return nil, errors.Wrapf(err, "%s at block %d", read.name, s.Block)
+		}
+	}
+
+	feed := c.RatioFeed()
+	state, err := ratiorules.ReadState(ctx, backend, feed, c.Addresses().CToken, head.Number)
+	if err != nil {
+		return nil, errors.Wrapf(err, "RatioFeed at block %d", s.Block)
+	}
+	s.FeedRatio, s.LastUpdate = state.Ratio, state.LastUpdate
+	return s, nil
+}
+
+// Exporter is a Prometheus collector of the protocol state.
+type Exporter struct {
+	client  *client.Client
+	backend Backend
+	timeout time.Duration
+	// OnError, when set, receives the error of every failed scrape.
+	OnError func(error)
+
+	up, block, duration *prometheus.Desc
+	gauges              []gauge
+	events, removed     *prometheus.CounterVec
+}
+
+// gauge is a metric of a snapshot.
+type gauge struct {
+	desc  *prometheus.Desc
+	value func(*Snapshot) float64
+}
+
+// New returns an exporter of the protocol resolved by c. A scrape gives up
+// after timeout.
+func New(c *client.Client, backend Backend, timeout time.Duration) *Exporter {
+	desc := func(subsystem, name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, name), help, nil, nil)
+	}
+	eth := func(v func(*Snapshot) *big.Int) func(*Snapshot) float64 {
+		return func(s *Snapshot) float64 { return scaled(v(s)) }
+	}
+	return &Exporter{
+		client:   c,
+		backend:  backend,
+		timeout:  timeout,
+		up:       desc("", "up", "Whether the last scrape read every value."),
+		block:    desc("", "scrape_block", "Block the values of the scrape were read at."),
+		duration: desc("", "scrape_duration_seconds", "Time the scrape took."),
+		gauges: []gauge{
+			{desc("pool", "total_assets_eth", "RestakingPool totalAssets."), eth(func(s *Snapshot) *big.Int { return s.TotalAssets })},
+			{desc("pool", "flash_capacity_eth", "RestakingPool getFlashCapacity."), eth(func(s *Snapshot) *big.Int { return s.FlashCapacity })},
+			{desc("pool", "available_to_stake_eth", "RestakingPool availableToStake."), eth(func(s *Snapshot) *big.Int { return s.AvailableToStake })},
+			{desc("pool", "max_tvl_eth", "RestakingPool maxTVL."), eth(func(s *Snapshot) *big.Int { return s.MaxTVL })},
+			{desc("pool", "pending_unstakes_eth", "RestakingPool getTotalPendingUnstakes."), eth(func(s *Snapshot) *big.Int { return s.TotalPendingUnstakes })},
+			{desc("pool", "claimable_eth", "RestakingPool getTotalClaimable."), eth(func(s *Snapshot) *big.Int { return s.TotalClaimable })},
+			{desc("pool", "free_balance_eth", "RestakingPool getFreeBalance."), eth(func(s *Snapshot) *big.Int { return s.FreeBalance })},
+			{desc("ctoken", "ratio", "cToken ratio, cToken per ETH."), eth(func(s *Snapshot) *big.Int { return s.Ratio })},
+			{desc("ctoken", "total_supply", "cToken totalSupply in tokens."), eth(func(s *Snapshot) *big.Int { return s.TotalSupply })},
+			{desc("ratiofeed", "ratio", "RatioFeed getRatio of the cToken."), eth(func(s *Snapshot) *big.Int { return s.FeedRatio })},
+			{desc("ratiofeed", "last_update_timestamp_seconds", "Time of the last updateRatio of the cToken."), func(s *Snapshot) float64 { return float64(s.LastUpdate) }},
+			{desc("ratiofeed", "last_update_age_seconds", "Time between the last updateRatio and the scraped block."), func(s *Snapshot) float64 { return float64(s.Time) - float64(s.LastUpdate) }},
+		},
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Name: "events_total", Help: "Events indexed, by contract and event.",
+		}, []string{"contract", "event"}),
+		removed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace, Name: "events_removed_total", Help: "Events rolled back by a reorg, by contract and event.",
+		}, []string{"contract", "event"}),
+	}
+}
+
+// scaled divides a wei amount or ratio by 1e18.
+func scaled(v *big.Int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(v), big.NewFloat(1e18)).Float64()
+	return f
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- e.up
+	ch <- e.block
+	ch <- e.duration
+	for _, g := range e.gauges {
+		ch <- g.desc
+	}
+	e.events.Describe(ch)
+	e.removed.Describe(ch)
+}
+
+// Collect implements prometheus.Collector. A failed read reports up 0 and
+// no state, rather than values of different blocks.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+	s, err := Read(ctx, e.client, e.backend)
+	up := 1.0
+	if err != nil {
+		up = 0
+		if e.OnError != nil {
+			e.OnError(err)
+		}
+	} else {
+		ch <- prometheus.MustNewConstMetric(e.block, prometheus.GaugeValue, float64(s.Block))
+		for _, g := range e.gauges {
+			ch <- prometheus.MustNewConstMetric(g.desc, prometheus.GaugeValue, g.value(s))
+		}
+	}
+	ch <- prometheus.MustNewConstMetric(e.up, prometheus.GaugeValue, up)
+	ch <- prometheus.MustNewConstMetric(e.duration, prometheus.GaugeValue, time.Since(start).Seconds())
+	e.events.Collect(ch)
+	e.removed.Collect(ch)
+}
+
+// Handle counts the events of an indexer step; it is an indexer.Handler.
+func (e *Exporter) Handle(_ context.Context, events []indexer.Event) error {
+	for _, ev := range events {
+		name := "unknown"
+		if ev.Decoded != nil {
+			t := reflect.TypeOf(ev.Decoded)
+			if t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			name = strings.TrimPrefix(t.Name(), "Contract")
+		}
+		counter := e.events
+		if ev.Log.Removed {
+			counter = e.removed
+		}
+		counter.WithLabelValues(ev.Contract, name).Inc()
+	}
+	return nil
+}
+
+var _ indexer.Handler = (*Exporter)(nil).Handle
+
+// Handler returns the /metrics handler of a registry holding the exporter
+// and the Go runtime metrics.
+func (e *Exporter) Handler() (http.Handler, error) {
+	registry := prometheus.NewRegistry()
+	for _, c := range []prometheus.Collector{e, collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})} {
+		if err := registry.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{}), nil
+}
@@ -0,0 +1,213 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+)
+
+// recorder records the block every read is made at.
+type recorder struct {
+	*backends.SimulatedBackend
+
+	mu     sync.Mutex
+	blocks []*big.Int
+	fail   bool
+}
+
+func (r *recorder) record(block *big.Int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blocks = append(r.blocks, block)
+	if r.fail {
+		return errors.New("node down")
+	}
+	return nil
+}
+
+func (r *recorder) CallContract(ctx context.Context, call ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	if err := r.record(block); err != nil {
+		return nil, err
+	}
+	return r.SimulatedBackend.CallContract(ctx, call, block)
+}
+
+func (r *recorder) StorageAt(ctx context.Context, account common.Address, key common.Hash, block *big.Int) ([]byte, error) {
+	if err := r.record(block); err != nil {
+		return nil, err
+	}
+	return r.SimulatedBackend.StorageAt(ctx, account, key, block)
+}
+
+func setup(t *testing.T) (*fixture.Fixture, *recorder, *client.Client) {
+	t.Helper()
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	for _, u := range f.Users {
+		if _, err := f.Send(f.RestakingPool.Stake(u.WithValue(fixture.Ether))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.UpdateRatio(big.NewInt(0.99e18)); err != nil {
+		t.Fatal(err)
+	}
+	r := &recorder{SimulatedBackend: f.Backend}
+	c, err := client.New(context.Background(), f.ProtocolConfig.Address(), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, r, c
+}
+
+func TestRead(t *testing.T) {
+	f, r, c := setup(t)
+	ctx := context.Background()
+	r.blocks = nil
+	s, err := Read(ctx, c, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every read is pinned to the same block
+	if len(r.blocks) < 11 {
+		t.Fatalf("%d reads", len(r.blocks))
+	}
+	for i, block := range r.blocks {
+		if block == nil || block.Uint64() != s.Block {
+			t.Fatalf("read %d at block %v, scrape at %d", i, block, s.Block)
+		}
+	}
+
+	assets, err := f.RestakingPool.TotalAssets(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now, err := f.Now()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.TotalAssets.Cmp(assets) != 0 || s.Ratio.Cmp(big.NewInt(0.99e18)) != 0 || s.FeedRatio.Cmp(s.Ratio) != 0 {
+		t.Errorf("snapshot %+v, totalAssets %s", s, assets)
+	}
+	if s.Time != uint64(now.Unix()) || s.LastUpdate == 0 || s.LastUpdate > s.Time {
+		t.Errorf("last update %d at %d", s.LastUpdate, s.Time)
+	}
+}
+
+func scrape(t *testing.T, h http.Handler) map[string]*dto.MetricFamily {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	families, err := new(expfmt.TextParser).TextToMetricFamilies(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return families
+}
+
+func value(t *testing.T, families map[string]*dto.MetricFamily, name string, labels ...string) float64 {
+	t.Helper()
+	family, ok := families[name]
+	if !ok {
+		t.Fatalf("no %s", name)
+	}
+next:
+	for _, m := range family.Metric {
+		for i := 0; i < len(labels); i += 2 {
+			found := false
+			for _, l := range m.Label {
+				found = found || l.GetName() == labels[i] && l.GetValue() == labels[i+1]
+			}
+			if !found {
+				continue next
+			}
+		}
+		if m.Gauge != nil {
+			return m.Gauge.GetValue()
+		}
+		return m.Counter.GetValue()
+	}
+	t.Fatalf("no %s%v", name, labels)
+	return 0
+}
+
+func TestExporter(t *testing.T) {
+	f, r, c := setup(t)
+	ctx := context.Background()
+	e := New(c, r, 10*time.Second)
+	var scrapeErr error
+	e.OnError = func(err error) { scrapeErr = err }
+	h, err := e.Handler()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// count the events of the fixture through an indexer
+	store, err := indexer.Open(filepath.Join(t.TempDir(), "index"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	ix := indexer.New(f.Backend, store, indexer.Contracts(c), indexer.Config{BatchSize: 1000}, e.Handle)
+	if p, err := ix.Step(ctx); err != nil || !p.Synced {
+		t.Fatalf("step %+v: %v", p, err)
+	}
+
+	families := scrape(t, h)
+	if value(t, families, "genesis_up") != 1 || scrapeErr != nil {
+		t.Fatalf("scrape failed: %v", scrapeErr)
+	}
+	s, err := Read(ctx, c, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := value(t, families, "genesis_scrape_block"); got != float64(s.Block) {
+		t.Errorf("block %v, want %d", got, s.Block)
+	}
+	if got, want := value(t, families, "genesis_pool_total_assets_eth"), scaled(s.TotalAssets); got != want || want < 4 {
+		t.Errorf("total assets %v, want %v", got, want)
+	}
+	if got := value(t, families, "genesis_ctoken_ratio"); got != 0.99 {
+		t.Errorf("ratio %v", got)
+	}
+	if got := value(t, families, "genesis_ratiofeed_last_update_age_seconds"); got != float64(s.Time-s.LastUpdate) {
+		t.Errorf("last update age %v", got)
+	}
+	if got := value(t, families, "genesis_events_total", "contract", "RestakingPool", "event", "Staked"); got != float64(len(f.Users)) {
+		t.Errorf("%v Staked events", got)
+	}
+	if got := value(t, families, "genesis_events_total", "contract", "RatioFeed", "event", "RatioUpdated"); got < 1 {
+		t.Errorf("%v RatioUpdated events", got)
+	}
+
+	// a failed read exports no state
+	r.fail = true
+	families = scrape(t, h)
+	if value(t, families, "genesis_up") != 0 || scrapeErr == nil {
+		t.Fatalf("up after a failed read, error %v", scrapeErr)
+	}
+	if _, ok := families["genesis_pool_total_assets_eth"]; ok {
+		t.Error("state exported after a failed read")
+	}
+}
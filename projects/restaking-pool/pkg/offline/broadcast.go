@@ -0,0 +1,121 @@
+package offline
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Node is what broadcasting needs from a node.
+type Node interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+var (
+	// ErrNonceUsed is returned when a nonce of the file was taken by
+	// another transaction of the sender.
+	ErrNonceUsed = errors.New("nonce used by another transaction")
+	// ErrNonceGap is returned when the sender has transactions to send
+	// before those of the file.
+	ErrNonceGap = errors.New("transactions missing before the file")
+)
+
+// Status is the outcome of the broadcast of a transaction.
+type Status string
+
+const (
+	// StatusMined is a transaction mined before the broadcast.
+	StatusMined Status = "mined"
+	// StatusPending is a transaction in the mempool before the broadcast.
+	StatusPending Status = "pending"
+	// StatusSent is a transaction sent by the broadcast.
+	StatusSent Status = "sent"
+)
+
+// Sent is a broadcast transaction. Status is empty for a transaction the
+// broadcast failed to send.
+type Sent struct {
+	Hash   common.Hash
+	Status Status
+}
+
+// Broadcast verifies the signed file against node and sends the
+// transactions not sent yet, in nonce order. The nonces are checked first:
+// a used nonce must be that of the transaction of the file, and the first
+// transaction to send must have the next nonce of the sender. A broadcast can
+// thus be run again after a failure.
+func Broadcast(ctx context.Context, node Node, f *File) ([]Sent, error) {
+	chainID, err := node.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain id")
+	}
+	if chainID.Cmp(f.ChainID) != 0 {
+		return nil, errors.Errorf("node is on chain %s, transactions are for chain %s", chainID, f.ChainID)
+	}
+	if err := f.Verify(true); err != nil {
+		return nil, err
+	}
+	mined, err := node.NonceAt(ctx, f.From, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get nonce")
+	}
+	pending, err := node.PendingNonceAt(ctx, f.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get pending nonce")
+	}
+
+	sent := make([]Sent, len(f.Transactions))
+	txs := make([]*types.Transaction, len(f.Transactions))
+	for i := range f.Transactions {
+		t := &f.Transactions[i]
+		tx, err := t.signed(f.ChainID, f.From)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i+1)
+		}
+		txs[i], sent[i].Hash = tx, tx.Hash()
+		switch {
+		case t.Nonce < mined:
+			receipt, err := node.TransactionReceipt(ctx, tx.Hash())
+			if errors.Is(err, ethereum.NotFound) || err == nil && receipt == nil {
+				return nil, errors.Wrapf(ErrNonceUsed, "transaction %d, nonce %d", i+1, t.Nonce)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get receipt of transaction %d", i+1)
+			}
+			sent[i].Status = StatusMined
+		case t.Nonce < pending:
+			_, isPending, err := node.TransactionByHash(ctx, tx.Hash())
+			if errors.Is(err, ethereum.NotFound) {
+				return nil, errors.Wrapf(ErrNonceUsed, "transaction %d, nonce %d", i+1, t.Nonce)
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get transaction %d", i+1)
+			}
+			sent[i].Status = StatusMined
+			if isPending {
+				sent[i].Status = StatusPending
+			}
+		case i == 0 && t.Nonce > pending:
+			return nil, errors.Wrapf(ErrNonceGap, "transaction %d has nonce %d, next is %d", i+1, t.Nonce, pending)
+		}
+	}
+	for i, tx := range txs {
+		if sent[i].Status != "" {
+			continue
+		}
+		if err := node.SendTransaction(ctx, tx); err != nil {
+			return sent, errors.Wrapf(err, "could not send transaction %d", i+1)
+		}
+		sent[i].Status = StatusSent
+	}
+	return sent, nil
+}
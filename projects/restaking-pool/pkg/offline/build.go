@@ -0,0 +1,94 @@
+package offline
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Backend is what building needs from a node.
+type Backend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// Builder makes the unsigned transactions of a File.
+type Builder struct {
+	ctx       context.Context
+	file      *File
+	nonce     uint64
+	gasMargin uint64
+}
+
+// NewBuilder starts the File of the transactions of from, with the chain id
+// and the pending nonce of backend. Gas estimates get gasMargin percent more,
+// since they cannot be redone once signed.
+func NewBuilder(ctx context.Context, backend Backend, from common.Address, gasMargin uint64) (*Builder, error) {
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain id")
+	}
+	nonce, err := backend.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get nonce")
+	}
+	return &Builder{
+		ctx:       ctx,
+		file:      &File{ChainID: chainID, From: from},
+		nonce:     nonce,
+		gasMargin: gasMargin,
+	}, nil
+}
+
+// Add builds the transaction of call, a Transactor method of the wrapper of
+// contract, one of ABIs. The method fills in the fees and estimates the gas
+// on the pending state, which does not include the transactions added
+// before; a call depending on them sets opts.GasLimit.
+func (b *Builder) Add(contract string, call func(opts *bind.TransactOpts) (*types.Transaction, error)) error {
+	opts := &bind.TransactOpts{
+		From:    b.file.From,
+		Nonce:   new(big.Int).SetUint64(b.nonce),
+		Context: b.ctx,
+		NoSend:  true,
+		// The transaction is left unsigned.
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+	}
+	tx, err := call(opts)
+	if err != nil {
+		return err
+	}
+	if tx.Type() != types.DynamicFeeTxType || tx.To() == nil {
+		return errors.New("only calls with EIP-1559 fees are supported")
+	}
+	described, err := Describe(contract, tx.Data())
+	if err != nil {
+		return err
+	}
+	gas := tx.Gas()
+	if opts.GasLimit == 0 {
+		gas += gas * b.gasMargin / 100
+	}
+	b.file.Transactions = append(b.file.Transactions, Transaction{
+		Call:                 *described,
+		To:                   *tx.To(),
+		Nonce:                tx.Nonce(),
+		Gas:                  gas,
+		MaxFeePerGas:         tx.GasFeeCap(),
+		MaxPriorityFeePerGas: tx.GasTipCap(),
+		Value:                tx.Value(),
+		Data:                 tx.Data(),
+	})
+	b.nonce++
+	return nil
+}
+
+// File returns the transactions built.
+func (b *Builder) File() *File {
+	return b.file
+}
@@ -0,0 +1,270 @@
+// Package offline moves the transactions of keys kept on an air-gapped
+// machine, such as the governance and treasury keys, through three steps
+// that exchange a JSON File:
+//
+//   - a Builder, online but keyless, makes the unsigned transactions of
+//     generated wrapper calls, with their nonces, gas limits and fees;
+//   - Sign, on the offline machine, signs them;
+//   - Broadcast, online again, checks the chain, the signatures and the
+//     nonces and sends the transactions not mined yet.
+//
+// Every transaction carries the description of its call, decoded with the
+// ABI of its contract. The descriptions are for the reviewers and never
+// trusted: Verify decodes the call data again with the ABIs compiled into
+// the binary, and Sign and Broadcast reject a file whose descriptions do not
+// match.
+package offline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakerdeployer "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerDeployer"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// ABIs are the contracts the transactions of a File may call, by name.
+var ABIs = map[string]*bind.MetaData{
+	"ProtocolConfig":   protocolconfig.ContractMetaData,
+	"cToken":           ctoken.ContractMetaData,
+	"RatioFeed":        ratiofeed.ContractMetaData,
+	"RestakingPool":    restakingpool.ContractMetaData,
+	"RestakerDeployer": restakerdeployer.ContractMetaData,
+	"FeeCollector":     feecollector.ContractMetaData,
+}
+
+// File is the transactions of one sender on one chain, in nonce order.
+type File struct {
+	ChainID      *big.Int       `json:"chainId"`
+	From         common.Address `json:"from"`
+	Transactions []Transaction  `json:"transactions"`
+}
+
+// Transaction is an EIP-1559 transaction and the description of its call.
+// Amounts are in wei.
+type Transaction struct {
+	Call                 Call           `json:"call"`
+	To                   common.Address `json:"to"`
+	Nonce                uint64         `json:"nonce"`
+	Gas                  uint64         `json:"gas"`
+	MaxFeePerGas         *big.Int       `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *big.Int       `json:"maxPriorityFeePerGas"`
+	Value                *big.Int       `json:"value"`
+	Data                 hexutil.Bytes  `json:"data"`
+	// Signed is the signed transaction, set by Sign.
+	Signed hexutil.Bytes `json:"signed,omitempty"`
+}
+
+// Call is a decoded call.
+type Call struct {
+	Contract string `json:"contract"`
+	// Method is the signature, such as setMaxTVL(uint256).
+	Method string `json:"method"`
+	Args   []Arg  `json:"args"`
+}
+
+// Arg is a decoded argument.
+type Arg struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func (c Call) String() string {
+	args := make([]string, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = a.Name + ": " + a.Value
+	}
+	return c.Contract + "." + strings.SplitN(c.Method, "(", 2)[0] + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Describe decodes data, a call to contract, one of ABIs.
+func Describe(contract string, data []byte) (*Call, error) {
+	meta, ok := ABIs[contract]
+	if !ok {
+		return nil, errors.Errorf("unknown contract %s", contract)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, errors.Wrap(err, contract)
+	}
+	if len(data) < 4 {
+		return nil, errors.Errorf("no %s method in %s", contract, hexutil.Bytes(data))
+	}
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return nil, errors.Wrap(err, contract)
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s.%s", contract, method.RawName)
+	}
+	call := &Call{Contract: contract, Method: method.Sig, Args: make([]Arg, len(values))}
+	for i, v := range values {
+		input := method.Inputs[i]
+		call.Args[i] = Arg{Name: input.Name, Type: input.Type.String(), Value: format(v, input.Type)}
+	}
+	return call, nil
+}
+
+// format prints a decoded value: numbers in decimal, addresses and bytes in
+// hex.
+func format(v interface{}, typ abi.Type) string {
+	rv := reflect.ValueOf(v)
+	switch typ.T {
+	case abi.AddressTy:
+		return v.(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(rv.Bytes())
+	case abi.FixedBytesTy, abi.HashTy:
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]string, rv.Len())
+		for i := range elems {
+			elems[i] = format(rv.Index(i).Interface(), *typ.Elem)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case abi.TupleTy:
+		fields := make([]string, len(typ.TupleRawNames))
+		for i, name := range typ.TupleRawNames {
+			fields[i] = name + ": " + format(rv.Field(i).Interface(), *typ.TupleElems[i])
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return fmt.Sprint(v)
+}
+
+// unsigned returns the transaction t describes.
+func (t *Transaction) unsigned(chainID *big.Int) *types.Transaction {
+	to := t.To
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     t.Nonce,
+		GasTipCap: t.MaxPriorityFeePerGas,
+		GasFeeCap: t.MaxFeePerGas,
+		Gas:       t.Gas,
+		To:        &to,
+		Value:     t.Value,
+		Data:      t.Data,
+	})
+}
+
+// signed returns the signed transaction, checking it is t signed by from.
+func (t *Transaction) signed(chainID *big.Int, from common.Address) (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(t.Signed); err != nil {
+		return nil, errors.Wrap(err, "could not decode signed transaction")
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(tx) != signer.Hash(t.unsigned(chainID)) {
+		return nil, errors.New("signed transaction differs from its fields")
+	}
+	if sender, err := types.Sender(signer, tx); err != nil || sender != from {
+		return nil, errors.Errorf("transaction not signed by %s", from.Hex())
+	}
+	return tx, nil
+}
+
+// Verify checks that the file is complete, that its nonces follow each
+// other and that every description is the call data decoded. With signed
+// set, every transaction must also be signed by the sender.
+func (f *File) Verify(signed bool) error {
+	if f.ChainID == nil || f.ChainID.Sign() <= 0 {
+		return errors.New("no chain id")
+	}
+	for i := range f.Transactions {
+		t := &f.Transactions[i]
+		if t.MaxFeePerGas == nil || t.MaxPriorityFeePerGas == nil || t.Value == nil {
+			return errors.Errorf("transaction %d: missing fees or value", i+1)
+		}
+		if i > 0 && t.Nonce != f.Transactions[i-1].Nonce+1 {
+			return errors.Errorf("transaction %d: nonce %d does not follow %d", i+1, t.Nonce, f.Transactions[i-1].Nonce)
+		}
+		call, err := Describe(t.Call.Contract, t.Data)
+		if err != nil {
+			return errors.Wrapf(err, "transaction %d", i+1)
+		}
+		if !reflect.DeepEqual(*call, t.Call) {
+			return errors.Errorf("transaction %d: described as %s, calls %s", i+1, t.Call, call)
+		}
+		if signed {
+			if _, err := t.signed(f.ChainID, f.From); err != nil {
+				return errors.Wrapf(err, "transaction %d", i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// Print writes the transactions for review, with the hash a signed
+// transaction has on chain.
+func (f *File) Print(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "chain %s, from %s, %d transactions\n", f.ChainID, f.From.Hex(), len(f.Transactions))
+	for i, t := range f.Transactions {
+		fmt.Fprintf(&b, "\ntransaction %d: %s\n  to:    %s\n  nonce: %d\n  value: %s\n  gas:   %d at most %s wei per gas, tip %s\n", i+1, t.Call, t.To.Hex(), t.Nonce, t.Value, t.Gas, t.MaxFeePerGas, t.MaxPriorityFeePerGas)
+		if len(t.Signed) > 0 {
+			tx, err := t.signed(f.ChainID, f.From)
+			if err != nil {
+				return errors.Wrapf(err, "transaction %d", i+1)
+			}
+			fmt.Fprintf(&b, "  hash:  %s\n", tx.Hash().Hex())
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Load reads a file.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read transactions")
+	}
+	f := new(File)
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s", path)
+	}
+	return f, nil
+}
+
+// Save writes the file to path through a temporary file, so a crash never
+// leaves a truncated file behind.
+func (f *File) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode transactions")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "could not write transactions")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write transactions")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write transactions")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), path), "could not write transactions")
+}
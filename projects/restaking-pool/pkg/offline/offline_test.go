@@ -0,0 +1,151 @@
+package offline
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/signer"
+)
+
+// node is the simulated backend with a chain id.
+type node struct {
+	*backends.SimulatedBackend
+}
+
+func (node) ChainID(context.Context) (*big.Int, error) {
+	return fixture.ChainID, nil
+}
+
+func build(t *testing.T, f *fixture.Fixture, tvl *big.Int) *File {
+	t.Helper()
+	b, err := NewBuilder(context.Background(), node{f.Backend}, f.Governance.Address, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Add("RestakingPool", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return f.RestakingPool.SetMaxTVL(opts, tvl)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Add("FeeCollector", f.FeeCollector.Withdraw); err != nil {
+		t.Fatal(err)
+	}
+	return b.File()
+}
+
+func TestOffline(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	key, err := governanceKey(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	allow := signer.Allowlist{f.RestakingPool.Address(), f.FeeCollector.Address()}
+	tvl := new(big.Int).Mul(big.NewInt(100), fixture.Ether)
+
+	// the file goes to the offline machine and back
+	path := filepath.Join(t.TempDir(), "txs.json")
+	if err := build(t, f, tvl).Save(path); err != nil {
+		t.Fatal(err)
+	}
+	file, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Transactions) != 2 || file.Transactions[0].Call.String() != "RestakingPool.setMaxTVL(newValue: "+tvl.String()+")" || file.Transactions[1].Call.Method != "withdraw()" {
+		t.Fatalf("calls %+v", file.Transactions)
+	}
+	if _, err := Broadcast(ctx, node{f.Backend}, file); err == nil {
+		t.Fatal("unsigned transactions broadcast")
+	}
+	if err := Sign(ctx, file, key, signer.Allowlist{f.RestakingPool.Address()}); !errors.Is(err, signer.ErrNotAllowed) {
+		t.Fatalf("signed a destination off the allowlist: %v", err)
+	}
+	if err := Sign(ctx, file, key, allow); err != nil {
+		t.Fatal(err)
+	}
+	var review strings.Builder
+	if err := file.Print(&review); err != nil || !strings.Contains(review.String(), "FeeCollector.withdraw()") {
+		t.Fatalf("review %q: %v", review.String(), err)
+	}
+
+	// a description that does not match the call is caught at each step
+	tampered := *file
+	tampered.Transactions = append([]Transaction(nil), file.Transactions...)
+	tampered.Transactions[0].Call.Args = []Arg{{Name: "newValue", Type: "uint256", Value: "1"}}
+	if err := Sign(ctx, &tampered, key, allow); err == nil {
+		t.Error("signed a tampered description")
+	}
+	if _, err := Broadcast(ctx, node{f.Backend}, &tampered); err == nil {
+		t.Error("broadcast a tampered description")
+	}
+
+	sent, err := Broadcast(ctx, node{f.Backend}, file)
+	if err != nil || len(sent) != 2 || sent[0].Status != StatusSent || sent[1].Status != StatusSent {
+		t.Fatalf("broadcast %+v: %v", sent, err)
+	}
+	// the reviewers are shown the hashes the transactions are sent with
+	for _, s := range sent {
+		if !strings.Contains(review.String(), "hash:  "+s.Hash.Hex()+"\n") {
+			t.Errorf("review %q does not show the hash %s", review.String(), s.Hash.Hex())
+		}
+	}
+	if again, err := Broadcast(ctx, node{f.Backend}, file); err != nil || again[0].Status != StatusPending {
+		t.Fatalf("broadcast of pending transactions %+v: %v", again, err)
+	}
+	f.Backend.Commit()
+	for _, s := range sent {
+		receipt, err := f.Backend.TransactionReceipt(ctx, s.Hash)
+		if err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("receipt of %s %+v: %v", s.Hash, receipt, err)
+		}
+	}
+	if got, err := f.RestakingPool.MaxTVL(nil); err != nil || got.Cmp(tvl) != 0 {
+		t.Fatalf("maxTVL %s: %v", got, err)
+	}
+	if again, err := Broadcast(ctx, node{f.Backend}, file); err != nil || again[1].Status != StatusMined {
+		t.Fatalf("broadcast of mined transactions %+v: %v", again, err)
+	}
+
+	// the nonces are checked again before broadcasting
+	file = build(t, f, fixture.Ether)
+	if err := Sign(ctx, file, key, allow); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Send(f.RestakingPool.SetMaxTVL(f.Governance.TransactOpts(), tvl)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Broadcast(ctx, node{f.Backend}, file); !errors.Is(err, ErrNonceUsed) {
+		t.Fatalf("broadcast of a used nonce: %v", err)
+	}
+	file = build(t, f, fixture.Ether)
+	for i := range file.Transactions {
+		file.Transactions[i].Nonce++
+	}
+	if err := Sign(ctx, file, key, allow); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Broadcast(ctx, node{f.Backend}, file); !errors.Is(err, ErrNonceGap) {
+		t.Fatalf("broadcast after a gap: %v", err)
+	}
+}
+
+// governanceKey returns the signer of the governance key of f.
+func governanceKey(f *fixture.Fixture) (*signer.Key, error) {
+	return signer.NewKey(hex.EncodeToString(crypto.FromECDSA(f.Governance.Key)))
+}
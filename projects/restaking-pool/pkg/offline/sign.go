@@ -0,0 +1,38 @@
+package offline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/signer"
+)
+
+// Sign verifies the file and signs its transactions with s, which must sign
+// for the sender. Destinations off allow are refused.
+func Sign(ctx context.Context, f *File, s signer.Signer, allow signer.Allowlist) error {
+	if s.Address() != f.From {
+		return errors.Errorf("transactions from %s, signer of %s", f.From.Hex(), s.Address().Hex())
+	}
+	if err := f.Verify(false); err != nil {
+		return err
+	}
+	for i := range f.Transactions {
+		t := &f.Transactions[i]
+		tx := t.unsigned(f.ChainID)
+		if err := allow.Check(tx); err != nil {
+			return errors.Wrapf(err, "transaction %d", i+1)
+		}
+		signed, err := s.SignTx(ctx, tx, f.ChainID)
+		if err != nil {
+			return errors.Wrapf(err, "transaction %d", i+1)
+		}
+		if t.Signed, err = signed.MarshalBinary(); err != nil {
+			return errors.Wrapf(err, "transaction %d", i+1)
+		}
+		if _, err := t.signed(f.ChainID, f.From); err != nil {
+			return errors.Wrapf(err, "transaction %d", i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+// Package oracle computes the cToken ratio and submits it to RatioFeed.
+//
+// Each Tick first follows the submission in flight until it has enough
+// confirmations and its RatioUpdated event is verified, then, once the 12
+// hour cadence allows it, computes a ratio from the Source, checks it with
+// the RatioFeed rules and submits updateRatio. A proposal the rules reject
+// is never sent.
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/ratiorules"
+)
+
+// Backend is what the oracle needs from a node; ethclient.Client and the
+// simulated backend implement it.
+type Backend interface {
+	bind.ContractBackend
+	ratiorules.StorageReader
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// Config configures an Oracle.
+type Config struct {
+	// Source computes the proposed ratio, PoolSource by default.
+	Source Source
+	// StatePath is the file the state persists to. The state is only kept
+	// in memory when empty.
+	StatePath string
+	// DryRun computes and checks the ratio without submitting it.
+	DryRun bool
+	// Confirmations is the number of blocks, including the one it is mined
+	// in, a submission waits for. 1 by default.
+	Confirmations uint64
+	// PollInterval is the delay between two ticks of Run, 1 minute by
+	// default.
+	PollInterval time.Duration
+}
+
+// Action is what a Tick did.
+type Action string
+
+const (
+	// ActionWait is a submission waiting to be mined or confirmed.
+	ActionWait Action = "wait"
+	// ActionConfirm is a submission that got its confirmations and emitted
+	// the expected RatioUpdated.
+	ActionConfirm Action = "confirm"
+	// ActionSkip is a tick that sent nothing, because the cadence or the
+	// RatioFeed rules do not allow an update.
+	ActionSkip Action = "skip"
+	// ActionDryRun is an update that would have been sent.
+	ActionDryRun Action = "dry-run"
+	// ActionSubmit is a sent updateRatio.
+	ActionSubmit Action = "submit"
+)
+
+// Result describes a Tick.
+type Result struct {
+	Action Action
+	Ratio  *big.Int
+	Tx     common.Hash
+	// Reason explains a skip or a wait.
+	Reason string
+	// Next is the earliest time of the next update, when known.
+	Next time.Time
+}
+
+// Oracle submits ratio updates for the cToken.
+type Oracle struct {
+	client  *client.Client
+	backend Backend
+	opts    *bind.TransactOpts
+	cfg     Config
+	state   *State
+}
+
+// New returns an oracle that signs with opts, which may be nil in dry-run
+// mode. The state is loaded from cfg.StatePath.
+func New(c *client.Client, backend Backend, opts *bind.TransactOpts, cfg Config) (*Oracle, error) {
+	if opts == nil && !cfg.DryRun {
+		return nil, errors.New("transact options are required unless in dry-run mode")
+	}
+	if cfg.Source == nil {
+		cfg.Source = PoolSource(c)
+	}
+	if cfg.Confirmations == 0 {
+		cfg.Confirmations = 1
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = time.Minute
+	}
+	state, err := loadState(cfg.StatePath)
+	if err != nil {
+		return nil, err
+	}
+	return &Oracle{client: c, backend: backend, opts: opts, cfg: cfg, state: state}, nil
+}
+
+// State returns the persisted state.
+func (o *Oracle) State() State {
+	return *o.state
+}
+
+// Run ticks every PollInterval until ctx is done. Tick errors are passed to
+// report with the results and do not stop the oracle.
+func (o *Oracle) Run(ctx context.Context, report func(*Result, error)) error {
+	for {
+		report(o.Tick(ctx))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.cfg.PollInterval):
+		}
+	}
+}
+
+// Tick follows the submission in flight, or proposes a new ratio.
+func (o *Oracle) Tick(ctx context.Context) (*Result, error) {
+	head, err := o.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head")
+	}
+	if o.state.Pending != nil {
+		r, err := o.follow(ctx, head)
+		if r != nil || err != nil {
+			return r, err
+		}
+	}
+	return o.propose(ctx, head)
+}
+
+// follow checks the pending submission. It returns nil when the submission
+// was dropped and a new one can be proposed.
+func (o *Oracle) follow(ctx context.Context, head *types.Header) (*Result, error) {
+	p := o.state.Pending
+	receipt, err := o.backend.TransactionReceipt(ctx, p.Tx)
+	if errors.Is(err, ethereum.NotFound) {
+		if _, _, err := o.backend.TransactionByHash(ctx, p.Tx); errors.Is(err, ethereum.NotFound) {
+			o.state.Pending = nil
+			return nil, o.save()
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "could not get transaction %s", p.Tx.Hex())
+		}
+		return &Result{Action: ActionWait, Ratio: p.Ratio, Tx: p.Tx, Reason: "not mined yet"}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get receipt of %s", p.Tx.Hex())
+	}
+
+	mined := receipt.BlockNumber.Uint64()
+	var confirmations uint64
+	if n := head.Number.Uint64(); n >= mined {
+		confirmations = n + 1 - mined
+	}
+	if confirmations < o.cfg.Confirmations {
+		return &Result{Action: ActionWait, Ratio: p.Ratio, Tx: p.Tx,
+			Reason: fmt.Sprintf("%d of %d confirmations", confirmations, o.cfg.Confirmations)}, nil
+	}
+
+	o.state.Pending = nil
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		if err := o.save(); err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("updateRatio %s reverted in block %d", p.Tx.Hex(), mined)
+	}
+	if err := o.verify(receipt, p.Ratio); err != nil {
+		if saveErr := o.save(); saveErr != nil {
+			return nil, saveErr
+		}
+		return nil, err
+	}
+	p.Block = mined
+	o.state.Last = p
+	if err := o.save(); err != nil {
+		return nil, err
+	}
+	return &Result{Action: ActionConfirm, Ratio: p.Ratio, Tx: p.Tx}, nil
+}
+
+// verify checks that the receipt carries the RatioUpdated of the
+// submission.
+func (o *Oracle) verify(receipt *types.Receipt, ratio *big.Int) error {
+	feed := o.client.RatioFeed()
+	token := o.client.Addresses().CToken
+	for _, l := range receipt.Logs {
+		if l.Address != feed.Address() {
+			continue
+		}
+		ev, err := feed.ParseRatioUpdated(*l)
+		if err != nil {
+			continue
+		}
+		if ev.TokenAddress == token && ev.NewRatio.Cmp(ratio) == 0 {
+			return nil
+		}
+	}
+	return errors.Errorf("updateRatio %s mined without RatioUpdated(%s, %s)", receipt.TxHash.Hex(), token.Hex(), ratio)
+}
+
+// propose computes, checks and submits a ratio.
+func (o *Oracle) propose(ctx context.Context, head *types.Header) (*Result, error) {
+	feed := o.client.RatioFeed()
+	token := o.client.Addresses().CToken
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	rules, err := ratiorules.ReadState(ctx, o.backend, feed, token, head.Number)
+	if err != nil {
+		return nil, err
+	}
+	next := time.Unix(int64(rules.NextUpdate()), 0).UTC()
+	if head.Time < rules.NextUpdate() {
+		return &Result{Action: ActionSkip, Reason: "updated less than 12 hours ago", Next: next}, nil
+	}
+
+	ratio, err := o.cfg.Source.Ratio(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute ratio")
+	}
+	// the update is mined after head, which only makes the cadence looser
+	if e := rules.Check(head.Time, ratio); e != ratiorules.NoError {
+		return &Result{Action: ActionSkip, Ratio: ratio, Reason: e.Error(), Next: next}, nil
+	}
+	if o.cfg.DryRun {
+		return &Result{Action: ActionDryRun, Ratio: ratio}, nil
+	}
+
+	txOpts := *o.opts
+	txOpts.Context = ctx
+	tx, err := feed.UpdateRatio(&txOpts, token, ratio)
+	if err != nil {
+		if e, ok := ratiorules.ParseRevert(err); ok {
+			return nil, errors.Wrapf(e, "updateRatio to %s rejected", ratio)
+		}
+		return nil, errors.Wrap(err, "could not send updateRatio")
+	}
+	o.state.Pending = &Submission{Tx: tx.Hash(), Ratio: ratio, SentAt: time.Now().UTC()}
+	if err := o.save(); err != nil {
+		return nil, err
+	}
+	return &Result{Action: ActionSubmit, Ratio: ratio, Tx: tx.Hash()}, nil
+}
+
+func (o *Oracle) save() error {
+	return o.state.save(o.cfg.StatePath)
+}
@@ -0,0 +1,125 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// setup stakes and vests a day of rewards, so the pool source proposes a
+// ratio below 1.
+func setup(t *testing.T) (*fixture.Fixture, *client.Client) {
+	t.Helper()
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	for _, u := range f.Users {
+		if _, err := f.Send(f.RestakingPool.Stake(u.WithValue(new(big.Int).Mul(big.NewInt(5), fixture.Ether)))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.AddRewards(fixture.Ether); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.AdvanceRewardsDays(1); err != nil {
+		t.Fatal(err)
+	}
+	c, err := f.Client(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, c
+}
+
+func tick(t *testing.T, o *Oracle, want Action) *Result {
+	t.Helper()
+	r, err := o.Tick(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Action != want {
+		t.Fatalf("tick %s (%s), want %s", r.Action, r.Reason, want)
+	}
+	return r
+}
+
+func TestOracle(t *testing.T) {
+	f, c := setup(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+	want, err := PoolSource(c).Ratio(ctx, &bind.CallOpts{Context: ctx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want.Cmp(fixture.Ether) >= 0 {
+		t.Fatalf("pool source proposes %s, want below 1 after rewards", want)
+	}
+
+	dry, err := New(c, f.Backend, nil, Config{DryRun: true, StatePath: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := tick(t, dry, ActionDryRun); r.Ratio.Cmp(want) != 0 {
+		t.Fatalf("dry run proposes %s, want %s", r.Ratio, want)
+	}
+
+	cfg := Config{StatePath: path, Confirmations: 2}
+	o, err := New(c, f.Backend, f.Operator.TransactOpts(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	submitted := tick(t, o, ActionSubmit)
+	tick(t, o, ActionWait)
+
+	// a restart waits for the submission in flight instead of sending
+	// another one
+	f.Backend.Commit()
+	if o, err = New(c, f.Backend, f.Operator.TransactOpts(), cfg); err != nil {
+		t.Fatal(err)
+	}
+	if r := tick(t, o, ActionWait); r.Tx != submitted.Tx || r.Reason != "1 of 2 confirmations" {
+		t.Fatalf("restarted oracle %+v, want to wait for %s", r, submitted.Tx.Hex())
+	}
+	f.Backend.Commit()
+	tick(t, o, ActionConfirm)
+	if got, err := f.RatioFeed.GetRatio(nil, f.CToken.Address()); err != nil || got.Cmp(want) != 0 {
+		t.Fatalf("ratio %s, want %s: %v", got, want, err)
+	}
+	if s := o.State(); s.Pending != nil || s.Last == nil || s.Last.Tx != submitted.Tx || s.Last.Block == 0 {
+		t.Fatalf("state %+v", s)
+	}
+
+	// the cadence holds the next update back for 12 hours
+	if r := tick(t, o, ActionSkip); r.Next.IsZero() {
+		t.Fatal("skip without the time of the next update")
+	}
+	if err := f.AdvanceRatioInterval(); err != nil {
+		t.Fatal(err)
+	}
+	tick(t, o, ActionSubmit)
+}
+
+func TestOracleRejectsProposal(t *testing.T) {
+	f, c := setup(t)
+	above := SourceFunc(func(context.Context, *bind.CallOpts) (*big.Int, error) {
+		return new(big.Int).Add(fixture.Ether, big.NewInt(1)), nil
+	})
+	o, err := New(c, f.Backend, f.Operator.TransactOpts(), Config{Source: above})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := tick(t, o, ActionSkip); r.Reason != "RatioNotUpdated(GreaterThanPrevious)" {
+		t.Fatalf("skipped with %q", r.Reason)
+	}
+	if o.State().Pending != nil {
+		t.Fatal("rejected proposal was submitted")
+	}
+}
@@ -0,0 +1,48 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+)
+
+var e18 = big.NewInt(1e18)
+
+// Source computes the ratio to propose from the chain state at opts.
+type Source interface {
+	Ratio(ctx context.Context, opts *bind.CallOpts) (*big.Int, error)
+}
+
+// SourceFunc adapts a function to Source.
+type SourceFunc func(ctx context.Context, opts *bind.CallOpts) (*big.Int, error)
+
+// Ratio calls f.
+func (f SourceFunc) Ratio(ctx context.Context, opts *bind.CallOpts) (*big.Int, error) {
+	return f(ctx, opts)
+}
+
+// PoolSource values the cToken supply at the RestakingPool totalAssets:
+// the ratio is totalSupply * 1e18 / totalAssets, rounded up so the supply
+// is never valued above the assets.
+func PoolSource(c *client.Client) Source {
+	return SourceFunc(func(ctx context.Context, opts *bind.CallOpts) (*big.Int, error) {
+		assets, err := c.RestakingPool().TotalAssets(opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get totalAssets")
+		}
+		supply, err := c.CToken().TotalSupply(opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get totalSupply")
+		}
+		if assets.Sign() == 0 || supply.Sign() == 0 {
+			return nil, errors.New("nothing to value: no assets or no supply")
+		}
+		ratio := new(big.Int).Mul(supply, e18)
+		ratio.Add(ratio, assets).Sub(ratio, big.NewInt(1))
+		return ratio.Quo(ratio, assets), nil
+	})
+}
@@ -0,0 +1,74 @@
+package oracle
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Submission is one updateRatio transaction.
+type Submission struct {
+	Tx     common.Hash `json:"tx"`
+	Ratio  *big.Int    `json:"ratio"`
+	SentAt time.Time   `json:"sentAt"`
+	// Block is the block the transaction was mined in, 0 while pending.
+	Block uint64 `json:"block,omitempty"`
+}
+
+// State is what the oracle persists across restarts, so that a restart
+// waits for the transaction in flight instead of sending another one.
+type State struct {
+	// Pending is the submission waiting for its confirmations.
+	Pending *Submission `json:"pending,omitempty"`
+	// Last is the last confirmed submission.
+	Last *Submission `json:"last,omitempty"`
+}
+
+// loadState reads the state at path. A missing file is an empty state.
+func loadState(path string) (*State, error) {
+	s := new(State)
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state")
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Wrapf(err, "could not parse state %s", path)
+	}
+	return s, nil
+}
+
+// save writes the state to path through a temporary file, so a crash never
+// leaves a truncated state behind.
+func (s *State) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode state")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write state")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), path), "could not write state")
+}
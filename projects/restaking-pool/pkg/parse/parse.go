@@ -0,0 +1,262 @@
+// Package parse reads the values written in YAML files and flags: amounts,
+// durations, and the methods and arguments of contract calls.
+package parse
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+var units = map[string]*big.Rat{
+	"wei":   big.NewRat(1, 1),
+	"gwei":  big.NewRat(1e9, 1),
+	"ether": big.NewRat(1e18, 1),
+	"eth":   big.NewRat(1e18, 1),
+}
+
+var bigIntType = reflect.TypeOf(new(big.Int))
+
+// Amount parses an integer written as a YAML number, a decimal or hex
+// string, an exponent ("1e18") or a unit amount ("0.99 ether"). YAML
+// decodes integers beyond 64 bits and exponents as float64, rounded from
+// 2^53 on, so those are refused: larger amounts are written as strings.
+func Amount(v interface{}) (*big.Int, error) {
+	switch n := v.(type) {
+	case int:
+		return big.NewInt(int64(n)), nil
+	case int64:
+		return big.NewInt(n), nil
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	case float64:
+		if math.Abs(n) >= 1<<53 {
+			return nil, errors.Errorf("%v is too large for a YAML number, write it as a string", n)
+		}
+		r := new(big.Rat)
+		if r.SetFloat64(n) == nil || !r.IsInt() {
+			return nil, errors.Errorf("%v is not an integer", n)
+		}
+		return r.Num(), nil
+	case string:
+		return amount(n)
+	}
+	return nil, errors.Errorf("invalid amount %v", v)
+}
+
+func amount(s string) (*big.Int, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "_", "")
+	if strings.HasPrefix(s, "0x") {
+		n, ok := new(big.Int).SetString(s[2:], 16)
+		if !ok {
+			return nil, errors.Errorf("invalid amount %q", s)
+		}
+		return n, nil
+	}
+	unit := units["wei"]
+	if fields := strings.Fields(s); len(fields) == 2 {
+		u, ok := units[strings.ToLower(fields[1])]
+		if !ok {
+			return nil, errors.Errorf("unknown unit in %q", s)
+		}
+		s, unit = fields[0], u
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, errors.Errorf("invalid amount %q", s)
+	}
+	r.Mul(r, unit)
+	if !r.IsInt() {
+		return nil, errors.Errorf("%q is not a whole number of wei", s)
+	}
+	return r.Num(), nil
+}
+
+// Resolver maps names, such as the accounts of a scenario, to addresses.
+type Resolver func(name string) (common.Address, bool)
+
+// Arg turns a YAML value into the Go type the ABI packer expects for typ.
+// Addresses are hex or, with a resolve function, the names it knows.
+func Arg(v interface{}, typ abi.Type, resolve Resolver) (interface{}, error) {
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := Amount(v)
+		if err != nil {
+			return nil, err
+		}
+		if typ.T == abi.UintTy && (n.Sign() < 0 || n.BitLen() > typ.Size) ||
+			typ.T == abi.IntTy && !inIntRange(n, typ.Size) {
+			return nil, errors.Errorf("%s out of range for %s", n, typ)
+		}
+		if typ.GetType() == bigIntType {
+			return n, nil
+		}
+		if typ.T == abi.UintTy {
+			return reflect.ValueOf(n.Uint64()).Convert(typ.GetType()).Interface(), nil
+		}
+		return reflect.ValueOf(n.Int64()).Convert(typ.GetType()).Interface(), nil
+	case abi.BoolTy:
+		switch b := v.(type) {
+		case bool:
+			return b, nil
+		case string:
+			if b == "true" || b == "false" {
+				return b == "true", nil
+			}
+		}
+		return nil, errors.Errorf("invalid bool %v", v)
+	case abi.StringTy:
+		return fmt.Sprint(v), nil
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid address %v", v)
+		}
+		if resolve != nil {
+			if addr, ok := resolve(s); ok {
+				return addr, nil
+			}
+		}
+		if !common.IsHexAddress(s) {
+			return nil, errors.Errorf("unknown address %q", s)
+		}
+		return common.HexToAddress(s), nil
+	case abi.BytesTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid bytes %v", v)
+		}
+		return hexutil.Decode(s)
+	case abi.FixedBytesTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid %s %v", typ, v)
+		}
+		// Text is accepted for readability, e.g. referral codes.
+		b := []byte(s)
+		if strings.HasPrefix(s, "0x") {
+			var err error
+			if b, err = hexutil.Decode(s); err != nil {
+				return nil, err
+			}
+		}
+		if len(b) > typ.Size {
+			return nil, errors.Errorf("%q is longer than %s", s, typ)
+		}
+		out := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(out, reflect.ValueOf(b))
+		return out.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("expected a list for %s", typ)
+		}
+		var out reflect.Value
+		if typ.T == abi.SliceTy {
+			out = reflect.MakeSlice(typ.GetType(), len(list), len(list))
+		} else if len(list) != typ.Size {
+			return nil, errors.Errorf("expected %d elements for %s", typ.Size, typ)
+		} else {
+			out = reflect.New(typ.GetType()).Elem()
+		}
+		for i, elem := range list {
+			c, err := Arg(elem, *typ.Elem, resolve)
+			if err != nil {
+				return nil, errors.Wrapf(err, "[%d]", i)
+			}
+			out.Index(i).Set(reflect.ValueOf(c))
+		}
+		return out.Interface(), nil
+	case abi.TupleTy:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("expected a map for %s", typ)
+		}
+		out := reflect.New(typ.GetType()).Elem()
+		for i, name := range typ.TupleRawNames {
+			field, ok := m[name]
+			if !ok {
+				return nil, errors.Errorf("missing %s", name)
+			}
+			c, err := Arg(field, *typ.TupleElems[i], resolve)
+			if err != nil {
+				return nil, errors.Wrap(err, name)
+			}
+			out.Field(i).Set(reflect.ValueOf(c))
+		}
+		return out.Interface(), nil
+	}
+	return nil, errors.Errorf("unsupported type %s", typ)
+}
+
+// inIntRange reports whether n is in [-2^(size-1), 2^(size-1)-1], the range
+// of an intsize.
+func inIntRange(n *big.Int, size int) bool {
+	if n.Sign() < 0 {
+		// -n-1, in [0, 2^(size-1)-1] for a valid n
+		return new(big.Int).Not(n).BitLen() < size
+	}
+	return n.BitLen() < size
+}
+
+// Duration is time.ParseDuration with an additional "d" suffix for
+// whole days, e.g. "7d".
+func Duration(s string) (time.Duration, error) {
+	if days := strings.TrimSuffix(s, "d"); days != s {
+		n, err := strconv.ParseUint(days, 10, 32)
+		if err != nil {
+			return 0, errors.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, errors.Errorf("negative duration %q", s)
+	}
+	return d, nil
+}
+
+// Method finds a method by its signature, such as "transfer(address,uint256)",
+// by its Go name, such as "transfer0" for an overload, or by its Solidity
+// name when a single overload takes args arguments.
+func Method(parsed *abi.ABI, name string, args int) (*abi.Method, error) {
+	var matches []string
+	for key, m := range parsed.Methods {
+		if m.Sig == name || key == name && m.RawName != name {
+			if len(m.Inputs) != args {
+				return nil, errors.Errorf("%s takes %d arguments, not %d", m.Sig, len(m.Inputs), args)
+			}
+			m := m
+			return &m, nil
+		}
+		if m.RawName == name && len(m.Inputs) == args {
+			matches = append(matches, key)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, errors.Errorf("no method %s with %d arguments", name, args)
+	case 1:
+		m := parsed.Methods[matches[0]]
+		return &m, nil
+	}
+	sigs := make([]string, len(matches))
+	for i, key := range matches {
+		sigs[i] = parsed.Methods[key].Sig
+	}
+	sort.Strings(sigs)
+	return nil, errors.Errorf("%s is ambiguous, use one of %s", name, strings.Join(sigs, ", "))
+}
@@ -0,0 +1,155 @@
+package parse
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAmount(t *testing.T) {
+	for v, want := range map[interface{}]string{
+		1000:            "1000",
+		"1e18":          "1000000000000000000",
+		"0.99 ether":    "990000000000000000",
+		"10 gwei":       "10000000000",
+		"0xff":          "255",
+		"1_000":         "1000",
+		float64(2e7):    "20000000",
+		uint64(1 << 63): "9223372036854775808",
+	} {
+		if n, err := Amount(v); err != nil || n.String() != want {
+			t.Errorf("Amount(%v) = %v: %v, want %s", v, n, err, want)
+		}
+	}
+	for _, v := range []interface{}{"0.5 wei", "1 btc", 1.5, true} {
+		if n, err := Amount(v); err == nil {
+			t.Errorf("Amount(%v) = %s", v, n)
+		}
+	}
+
+	// YAML rounds plain integers beyond 64 bits to float64
+	var doc struct{ Plain, Quoted interface{} }
+	if err := yaml.Unmarshal([]byte("plain: 1234567890123456789012\nquoted: \"1234567890123456789012\""), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := Amount(doc.Plain); err == nil {
+		t.Errorf("rounded YAML number parsed as %s", n)
+	}
+	if n, err := Amount(doc.Quoted); err != nil || n.String() != "1234567890123456789012" {
+		t.Errorf("quoted amount %v: %v", n, err)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	for s, want := range map[string]time.Duration{"7d": 7 * 24 * time.Hour, "90m": 90 * time.Minute} {
+		if d, err := Duration(s); err != nil || d != want {
+			t.Errorf("Duration(%s) = %s: %v", s, d, err)
+		}
+	}
+	if d, err := Duration("-1h"); err == nil {
+		t.Errorf("negative duration parsed as %s", d)
+	}
+}
+
+func TestArg(t *testing.T) {
+	uint8Ty, _ := abi.NewType("uint8", "", nil)
+	uint256Ty, _ := abi.NewType("uint256", "", nil)
+	addressesTy, _ := abi.NewType("address[]", "", nil)
+	alice := common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	resolve := func(name string) (common.Address, bool) { return alice, name == "alice" }
+
+	if v, err := Arg("255", uint8Ty, nil); err != nil || v != uint8(255) {
+		t.Errorf("uint8 %v: %v", v, err)
+	}
+	if v, err := Arg(256, uint8Ty, nil); err == nil {
+		t.Errorf("256 converted to uint8 %v", v)
+	}
+	for _, c := range []struct {
+		typ      string
+		min, max string
+	}{
+		{"int8", "-128", "127"},
+		{"int64", "-9223372036854775808", "9223372036854775807"},
+		{"int256", "-0x8000000000000000000000000000000000000000000000000000000000000000", "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"},
+	} {
+		typ, _ := abi.NewType(c.typ, "", nil)
+		for _, v := range []string{c.min, c.max} {
+			if _, err := Arg(v, typ, nil); err != nil {
+				t.Errorf("%s %s: %v", c.typ, v, err)
+			}
+		}
+		below, _ := Amount(c.min)
+		above, _ := Amount(c.max)
+		for _, v := range []*big.Int{below.Sub(below, big.NewInt(1)), above.Add(above, big.NewInt(1))} {
+			if got, err := Arg(v.String(), typ, nil); err == nil {
+				t.Errorf("%s %s converted to %v", c.typ, v, got)
+			}
+		}
+	}
+	if v, err := Arg("1 ether", uint256Ty, nil); err != nil || v.(*big.Int).String() != "1000000000000000000" {
+		t.Errorf("uint256 %v: %v", v, err)
+	}
+	list := []interface{}{"alice", alice.Hex()}
+	if v, err := Arg(list, addressesTy, resolve); err != nil || !equalAddresses(v, alice, alice) {
+		t.Errorf("addresses %v: %v", v, err)
+	}
+	if v, err := Arg(list, addressesTy, nil); err == nil {
+		t.Errorf("name converted without a resolver to %v", v)
+	}
+}
+
+func equalAddresses(v interface{}, want ...common.Address) bool {
+	got, ok := v.([]common.Address)
+	if !ok || len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMethod(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(`[
+		{"type": "function", "name": "f", "inputs": [{"name": "a", "type": "uint256"}]},
+		{"type": "function", "name": "f", "inputs": [{"name": "a", "type": "address"}]},
+		{"type": "function", "name": "f", "inputs": [{"name": "a", "type": "uint256"}, {"name": "b", "type": "uint256"}]}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name string
+		args int
+		sig  string
+		err  string
+	}{
+		{name: "f", args: 2, sig: "f(uint256,uint256)"},
+		{name: "f(address)", args: 1, sig: "f(address)"},
+		{name: parsed.Methods["f0"].Name, args: 1, sig: parsed.Methods["f0"].Sig},
+		{name: "f", args: 1, err: "f is ambiguous, use one of f(address), f(uint256)"},
+		{name: "f(address)", args: 2, err: "f(address) takes 1 arguments, not 2"},
+		{name: "g", args: 0, err: "no method g with 0 arguments"},
+	} {
+		// map iteration order must not change the result
+		for i := 0; i < 20; i++ {
+			m, err := Method(&parsed, c.name, c.args)
+			if c.err != "" {
+				if err == nil || err.Error() != c.err {
+					t.Fatalf("Method(%s, %d): %v, want %s", c.name, c.args, err, c.err)
+				}
+				continue
+			}
+			if err != nil || m.Sig != c.sig {
+				t.Fatalf("Method(%s, %d) = %v: %v, want %s", c.name, c.args, m, err, c.sig)
+			}
+		}
+	}
+}
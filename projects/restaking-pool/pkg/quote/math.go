@@ -0,0 +1,156 @@
+// Package quote reimplements the RestakingPool stake bonus and flash
+// unstake fee math in Go, so quotes can be computed offline from a single
+// state snapshot. Results are bit-exact with the contracts, including the
+// cases where the contracts revert.
+package quote
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// MaxPercent is the 100% value used by rates in the contracts.
+const MaxPercent = 100e8
+
+var (
+	// ErrUnderflow mirrors a checked subtraction revert.
+	ErrUnderflow = errors.New("arithmetic underflow")
+	// ErrOverflow mirrors a checked uint256 overflow revert.
+	ErrOverflow = errors.New("arithmetic overflow")
+	// ErrDivisionByZero mirrors a division by zero revert.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+var (
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	maxPercent = big.NewInt(MaxPercent)
+	e18        = big.NewInt(1e18)
+)
+
+// checked evaluates uint256 arithmetic the way solidity >=0.8 does and
+// keeps the first error.
+type checked struct {
+	err error
+}
+
+func (c *checked) result(v *big.Int) *big.Int {
+	if c.err == nil && v.Cmp(maxUint256) > 0 {
+		c.err = ErrOverflow
+	}
+	return v
+}
+
+func (c *checked) add(a, b *big.Int) *big.Int {
+	return c.result(new(big.Int).Add(a, b))
+}
+
+func (c *checked) sub(a, b *big.Int) *big.Int {
+	if c.err == nil && a.Cmp(b) < 0 {
+		c.err = ErrUnderflow
+		return new(big.Int)
+	}
+	return new(big.Int).Sub(a, b)
+}
+
+func (c *checked) mul(a, b *big.Int) *big.Int {
+	return c.result(new(big.Int).Mul(a, b))
+}
+
+func (c *checked) div(a, b *big.Int) *big.Int {
+	if b.Sign() == 0 {
+		if c.err == nil {
+			c.err = ErrDivisionByZero
+		}
+		return new(big.Int)
+	}
+	return new(big.Int).Quo(a, b)
+}
+
+func newUint(v uint64) *big.Int {
+	return new(big.Int).SetUint64(v)
+}
+
+// DepositBonus is InceptionLibrary.calculateDepositBonus.
+func DepositBonus(amount, capacity, optimalCapacity *big.Int, optimalBonusRate, maxDepositBonusRate uint64, targetCapacity *big.Int) (*big.Int, error) {
+	c := &checked{}
+	amount, capacity = new(big.Int).Set(amount), new(big.Int).Set(capacity)
+	optimalRate, maxRate := newUint(optimalBonusRate), newUint(maxDepositBonusRate)
+	bonus := new(big.Int)
+
+	// the utilization rate is in the range [0:25] %
+	if amount.Sign() > 0 && capacity.Cmp(optimalCapacity) < 0 {
+		replenished := amount
+		if optimalCapacity.Cmp(c.add(capacity, amount)) < 0 {
+			replenished = c.sub(optimalCapacity, capacity)
+		}
+		bonusSlope := c.div(
+			c.mul(c.sub(maxRate, optimalRate), e18),
+			c.div(c.mul(optimalCapacity, e18), targetCapacity),
+		)
+		bonusPercent := c.sub(maxRate, c.div(
+			c.mul(bonusSlope, c.add(capacity, c.div(replenished, big.NewInt(2)))),
+			targetCapacity,
+		))
+		capacity = c.add(capacity, replenished)
+		bonus = c.add(bonus, c.div(c.mul(replenished, bonusPercent), maxPercent))
+		amount = c.sub(amount, replenished)
+	}
+	// the utilization rate is in the range [25: ] %
+	if amount.Sign() > 0 && capacity.Cmp(targetCapacity) <= 0 {
+		replenished := amount
+		if targetCapacity.Cmp(c.add(capacity, amount)) <= 0 {
+			replenished = c.sub(targetCapacity, capacity)
+		}
+		bonus = c.add(bonus, c.div(c.mul(replenished, optimalRate), maxPercent))
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return bonus, nil
+}
+
+// WithdrawalFee is InceptionLibrary.calculateWithdrawalFee.
+func WithdrawalFee(amount, capacity, optimalCapacity *big.Int, optimalFeeRate, maxFlashWithdrawalFeeRate uint64, targetCapacity *big.Int) (*big.Int, error) {
+	c := &checked{}
+	amount, capacity = new(big.Int).Set(amount), new(big.Int).Set(capacity)
+	optimalRate, maxRate := newUint(optimalFeeRate), newUint(maxFlashWithdrawalFeeRate)
+	fee := new(big.Int)
+	one := big.NewInt(1)
+
+	// the utilization rate is in the range [100:25] %
+	if amount.Sign() > 0 && capacity.Cmp(optimalCapacity) > 0 {
+		replenished := amount
+		if c.sub(capacity, amount).Cmp(optimalCapacity) < 0 {
+			replenished = c.sub(capacity, optimalCapacity)
+		}
+		fee = c.add(fee, c.div(c.mul(replenished, optimalRate), maxPercent))
+		amount = c.sub(amount, replenished)
+		capacity = c.sub(capacity, replenished)
+		if fee.Sign() == 0 {
+			fee = c.add(fee, one)
+		}
+	}
+	// the utilization rate is in the range [25:0] %
+	if amount.Sign() > 0 {
+		feeSlope := c.div(
+			c.mul(c.sub(maxRate, optimalRate), e18),
+			c.div(c.mul(optimalCapacity, e18), targetCapacity),
+		)
+		bonusPercent := c.sub(maxRate, c.div(
+			c.mul(feeSlope, c.sub(capacity, c.div(amount, big.NewInt(2)))),
+			targetCapacity,
+		))
+		fee = c.add(fee, c.div(c.mul(amount, bonusPercent), maxPercent))
+		if fee.Sign() == 0 {
+			fee = c.add(fee, one)
+		}
+	}
+	if fee.Sign() == 0 {
+		fee = c.add(fee, one)
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return fee, nil
+}
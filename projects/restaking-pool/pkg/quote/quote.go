@@ -0,0 +1,182 @@
+package quote
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// InsufficientCapacityError mirrors the InsufficientCapacity(capacity)
+// revert of calculateFlashUnstakeFee and flashUnstake.
+type InsufficientCapacityError struct {
+	Capacity *big.Int
+}
+
+func (e *InsufficientCapacityError) Error() string {
+	return "insufficient flash capacity " + e.Capacity.String()
+}
+
+// Params are the pool rates set by setTargetFlashCapacity,
+// setStakeBonusParams, setFlashUnstakeFeeParams and setProtocolFee.
+type Params struct {
+	TargetCapacity         uint64
+	MaxBonusRate           uint64
+	OptimalBonusRate       uint64
+	StakeUtilizationKink   uint64
+	MaxFlashFeeRate        uint64
+	OptimalUnstakeRate     uint64
+	UnstakeUtilizationKink uint64
+	ProtocolFee            uint64
+}
+
+// Snapshot is the pool state a quote depends on.
+type Snapshot struct {
+	Params
+	// FlashCapacity is getFlashCapacity.
+	FlashCapacity *big.Int
+	// TokenTotalAssets is cToken.totalAssets, the base of the target
+	// capacity.
+	TokenTotalAssets *big.Int
+	// StakeBonusAmount caps the bonus paid by stake.
+	StakeBonusAmount *big.Int
+	// Ratio is the cToken ratio from RatioFeed.
+	Ratio *big.Int
+}
+
+// ReadSnapshot reads the views a quote depends on. Pass a block number in
+// opts to make all reads consistent.
+func ReadSnapshot(opts *bind.CallOpts, pool *restakingpool.Contract, token *ctoken.Contract) (*Snapshot, error) {
+	s := &Snapshot{}
+	var err error
+	readRate := func(v *uint64, name string, get func(*bind.CallOpts) (uint64, error)) {
+		if err == nil {
+			*v, err = get(opts)
+			err = errors.Wrap(err, name)
+		}
+	}
+	readAmount := func(v **big.Int, name string, get func(*bind.CallOpts) (*big.Int, error)) {
+		if err == nil {
+			*v, err = get(opts)
+			err = errors.Wrap(err, name)
+		}
+	}
+	readRate(&s.TargetCapacity, "targetCapacity", pool.TargetCapacity)
+	readRate(&s.MaxBonusRate, "maxBonusRate", pool.MaxBonusRate)
+	readRate(&s.OptimalBonusRate, "optimalBonusRate", pool.OptimalBonusRate)
+	readRate(&s.StakeUtilizationKink, "stakeUtilizationKink", pool.StakeUtilizationKink)
+	readRate(&s.MaxFlashFeeRate, "maxFlashFeeRate", pool.MaxFlashFeeRate)
+	readRate(&s.OptimalUnstakeRate, "optimalUnstakeRate", pool.OptimalUnstakeRate)
+	readRate(&s.UnstakeUtilizationKink, "unstakeUtilizationKink", pool.UnstakeUtilizationKink)
+	readRate(&s.ProtocolFee, "protocolFee", pool.ProtocolFee)
+	readAmount(&s.FlashCapacity, "getFlashCapacity", pool.GetFlashCapacity)
+	readAmount(&s.TokenTotalAssets, "cToken.totalAssets", token.TotalAssets)
+	readAmount(&s.StakeBonusAmount, "stakeBonusAmount", pool.StakeBonusAmount)
+	readAmount(&s.Ratio, "ratio", token.Ratio)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// TargetCapacityAmount is the pool's _getTargetCapacity in wei.
+func (s *Snapshot) TargetCapacityAmount() *big.Int {
+	c := new(big.Int).Mul(newUint(s.TargetCapacity), s.TokenTotalAssets)
+	return c.Quo(c, maxPercent)
+}
+
+func kinked(target *big.Int, kink uint64) *big.Int {
+	c := new(big.Int).Mul(target, newUint(kink))
+	return c.Quo(c, maxPercent)
+}
+
+// StakeBonus is calculateStakeBonus(amount).
+func (s *Snapshot) StakeBonus(amount *big.Int) (*big.Int, error) {
+	return s.stakeBonus(s.FlashCapacity, amount)
+}
+
+func (s *Snapshot) stakeBonus(capacity, amount *big.Int) (*big.Int, error) {
+	target := s.TargetCapacityAmount()
+	return DepositBonus(amount, capacity, kinked(target, s.StakeUtilizationKink), s.OptimalBonusRate, s.MaxBonusRate, target)
+}
+
+// FlashUnstakeFee is calculateFlashUnstakeFee(amount).
+func (s *Snapshot) FlashUnstakeFee(amount *big.Int) (*big.Int, error) {
+	if amount.Cmp(s.FlashCapacity) > 0 {
+		return nil, &InsufficientCapacityError{Capacity: new(big.Int).Set(s.FlashCapacity)}
+	}
+	target := s.TargetCapacityAmount()
+	return WithdrawalFee(amount, s.FlashCapacity, kinked(target, s.UnstakeUtilizationKink), s.OptimalUnstakeRate, s.MaxFlashFeeRate, target)
+}
+
+// StakeQuote is the outcome of stake(amount).
+type StakeQuote struct {
+	Bonus  *big.Int
+	Shares *big.Int
+}
+
+// Stake quotes stake with msg.value amount: the bonus actually paid, capped
+// by the remaining stake bonus, and the minted shares. Only the math is
+// modelled, not the min stake and max TVL checks.
+func (s *Snapshot) Stake(amount *big.Int) (*StakeQuote, error) {
+	bonus := new(big.Int)
+	if s.StakeBonusAmount.Sign() > 0 {
+		// stake sees msg.value in the pool balance and subtracts it again,
+		// which leaves the capacity before the stake
+		var err error
+		bonus, err = s.stakeBonus(s.FlashCapacity, amount)
+		if err != nil {
+			return nil, err
+		}
+		if bonus.Cmp(s.StakeBonusAmount) > 0 {
+			bonus.Set(s.StakeBonusAmount)
+		}
+	}
+	total := new(big.Int).Add(amount, bonus)
+	return &StakeQuote{Bonus: bonus, Shares: s.ConvertToShares(total)}, nil
+}
+
+// FlashUnstakeQuote is the outcome of flashUnstake(shares).
+type FlashUnstakeQuote struct {
+	// Amount is the value of the shares before the fee.
+	Amount *big.Int
+	Fee    *big.Int
+	// ProtocolFee is the part of Fee sent to the treasury, the rest funds
+	// the stake bonus.
+	ProtocolFee *big.Int
+	// Received is what the receiver gets.
+	Received *big.Int
+}
+
+// FlashUnstake quotes flashUnstake of shares.
+func (s *Snapshot) FlashUnstake(shares *big.Int) (*FlashUnstakeQuote, error) {
+	amount := s.ConvertToAmount(shares)
+	fee, err := s.FlashUnstakeFee(amount)
+	if err != nil {
+		return nil, err
+	}
+	protocolFee := new(big.Int).Mul(fee, newUint(s.ProtocolFee))
+	protocolFee.Quo(protocolFee, maxPercent)
+	return &FlashUnstakeQuote{
+		Amount:      amount,
+		Fee:         fee,
+		ProtocolFee: protocolFee,
+		Received:    new(big.Int).Sub(amount, fee),
+	}, nil
+}
+
+// ConvertToShares is cToken.convertToShares, rounding down.
+func (s *Snapshot) ConvertToShares(amount *big.Int) *big.Int {
+	shares := new(big.Int).Mul(amount, s.Ratio)
+	return shares.Quo(shares, e18)
+}
+
+// ConvertToAmount is cToken.convertToAmount, rounding up.
+func (s *Snapshot) ConvertToAmount(shares *big.Int) *big.Int {
+	amount := new(big.Int).Mul(shares, e18)
+	amount.Add(amount, s.Ratio).Sub(amount, big.NewInt(1))
+	return amount.Quo(amount, s.Ratio)
+}
@@ -0,0 +1,213 @@
+package quote
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	inceptionlibrary "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/InceptionLibrary"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+func newFixture(t *testing.T) *fixture.Fixture {
+	t.Helper()
+	opts := fixture.DefaultOptions()
+	opts.Users, opts.MaxTVL = 3, new(big.Int).Mul(big.NewInt(1000), fixture.Ether)
+	f, err := fixture.New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+// randUint returns a value of up to bits random bits.
+func randUint(rnd *rand.Rand, bits int) *big.Int {
+	return new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), uint(rnd.Intn(bits+1))))
+}
+
+func randRate(rnd *rand.Rand) uint64 {
+	switch rnd.Intn(4) {
+	case 0:
+		return 0
+	case 1:
+		return uint64(rnd.Int63n(MaxPercent))
+	default:
+		return uint64(rnd.Int63n(MaxPercent / 10))
+	}
+}
+
+func sameResult(t *testing.T, name string, want *big.Int, wantErr error, got *big.Int, gotErr error) {
+	t.Helper()
+	switch {
+	case wantErr != nil && gotErr == nil:
+		t.Fatalf("%s: contract reverted (%v) but Go returned %s", name, wantErr, got)
+	case wantErr == nil && gotErr != nil:
+		t.Fatalf("%s: contract returned %s but Go failed: %v", name, want, gotErr)
+	case wantErr == nil && want.Cmp(got) != 0:
+		t.Fatalf("%s: contract returned %s, Go returned %s", name, want, got)
+	}
+}
+
+func TestLibraryDifferential(t *testing.T) {
+	f := newFixture(t)
+	lib, err := inceptionlibrary.NewContract(f.InceptionLibrary, f.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rnd := rand.New(rand.NewSource(31))
+	for i := 0; i < 300; i++ {
+		// mostly realistic ETH amounts, sometimes values large enough to
+		// overflow the intermediate products
+		bits := 80
+		if i%10 == 0 {
+			bits = 256
+		}
+		amount, capacity := randUint(rnd, bits), randUint(rnd, bits)
+		optimal, target := randUint(rnd, bits), randUint(rnd, bits)
+		optimalRate, maxRate := randRate(rnd), randRate(rnd)
+		args := []*big.Int{amount, capacity, optimal, newUint(optimalRate), newUint(maxRate), target}
+
+		want, wantErr := lib.CalculateDepositBonus(nil, args[0], args[1], args[2], args[3], args[4], args[5])
+		got, gotErr := DepositBonus(amount, capacity, optimal, optimalRate, maxRate, target)
+		sameResult(t, "calculateDepositBonus", want, wantErr, got, gotErr)
+
+		want, wantErr = lib.CalculateWithdrawalFee(nil, args[0], args[1], args[2], args[3], args[4], args[5])
+		got, gotErr = WithdrawalFee(amount, capacity, optimal, optimalRate, maxRate, target)
+		sameResult(t, "calculateWithdrawalFee", want, wantErr, got, gotErr)
+	}
+}
+
+// checkViews compares the Go quotes with calculateStakeBonus and
+// calculateFlashUnstakeFee over amounts around the interesting capacities.
+func checkViews(t *testing.T, f *fixture.Fixture, rnd *rand.Rand) *Snapshot {
+	t.Helper()
+	s, err := ReadSnapshot(nil, f.RestakingPool, f.CToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := s.TargetCapacityAmount()
+	amounts := []*big.Int{
+		big.NewInt(0), big.NewInt(1), big.NewInt(2),
+		s.FlashCapacity, target,
+		kinked(target, s.StakeUtilizationKink), kinked(target, s.UnstakeUtilizationKink),
+		new(big.Int).Add(s.FlashCapacity, big.NewInt(1)),
+	}
+	for i := 0; i < 40; i++ {
+		amounts = append(amounts, randUint(rnd, 70))
+	}
+	for _, amount := range amounts {
+		want, wantErr := f.RestakingPool.CalculateStakeBonus(nil, amount)
+		got, gotErr := s.StakeBonus(amount)
+		sameResult(t, "calculateStakeBonus("+amount.String()+")", want, wantErr, got, gotErr)
+
+		want, wantErr = f.RestakingPool.CalculateFlashUnstakeFee(nil, amount)
+		got, gotErr = s.FlashUnstakeFee(amount)
+		sameResult(t, "calculateFlashUnstakeFee("+amount.String()+")", want, wantErr, got, gotErr)
+	}
+	return s
+}
+
+func TestPoolDifferential(t *testing.T) {
+	f := newFixture(t)
+	pool := f.RestakingPool
+	rnd := rand.New(rand.NewSource(31))
+	ether := fixture.Ether
+	eth := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), ether) }
+
+	checkViews(t, f, rnd)
+
+	for i, u := range f.Users {
+		if _, err := f.Send(pool.Stake(u.WithValue(eth(int64(5 * (i + 1)))))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkViews(t, f, rnd)
+
+	// flash unstakes fund the stake bonus, then check the quotes against
+	// the amounts the transactions actually produced
+	for _, shares := range []*big.Int{ether, eth(3), big.NewInt(1e17)} {
+		user := f.Users[0]
+		s := checkViews(t, f, rnd)
+		q, err := s.FlashUnstake(shares)
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := f.Send(pool.FlashUnstake(user.TransactOpts(), shares, user.Address))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev := findEvent[*restakingpool.ContractFlashUnstaked](t, pool, receipt)
+		if ev.Fee.Cmp(q.Fee) != 0 || ev.Amount.Cmp(q.Received) != 0 {
+			t.Fatalf("flashUnstake(%s): quoted fee %s received %s, got fee %s received %s", shares, q.Fee, q.Received, ev.Fee, ev.Amount)
+		}
+	}
+	for _, amount := range []*big.Int{big.NewInt(1e17), ether, eth(4)} {
+		s := checkViews(t, f, rnd)
+		if s.StakeBonusAmount.Sign() == 0 {
+			t.Fatal("flash unstakes did not fund the stake bonus")
+		}
+		q, err := s.Stake(amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := f.Send(pool.Stake(f.Users[1].WithValue(amount)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev := findEvent[*restakingpool.ContractStaked](t, pool, receipt)
+		if ev.Shares.Cmp(q.Shares) != 0 {
+			t.Fatalf("stake(%s): quoted %s shares with bonus %s, minted %s", amount, q.Shares, q.Bonus, ev.Shares)
+		}
+	}
+
+	// other rates and a ratio below 1
+	gov := f.Governance.TransactOpts
+	for _, send := range []func() (*types.Transaction, error){
+		func() (*types.Transaction, error) { return pool.SetStakeBonusParams(gov(), 40e7, 1e7, 50e8) },
+		func() (*types.Transaction, error) { return pool.SetFlashUnstakeFeeParams(gov(), 80e7, 2e7, 10e8) },
+		func() (*types.Transaction, error) { return pool.SetTargetFlashCapacity(gov(), 30e8) },
+		func() (*types.Transaction, error) { return pool.SetProtocolFee(gov(), 20e8) },
+	} {
+		if _, err := f.Send(send()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.UpdateRatio(new(big.Int).Sub(ether, big.NewInt(5e15))); err != nil {
+		t.Fatal(err)
+	}
+	s := checkViews(t, f, rnd)
+	shares := new(big.Int).Div(ether, big.NewInt(3))
+	q, err := s.FlashUnstake(shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := f.Send(pool.FlashUnstake(f.Users[2].TransactOpts(), shares, f.Users[2].Address))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := findEvent[*restakingpool.ContractFlashUnstaked](t, pool, receipt)
+	if ev.Fee.Cmp(q.Fee) != 0 || ev.Amount.Cmp(q.Received) != 0 {
+		t.Fatalf("flashUnstake(%s): quoted fee %s received %s, got fee %s received %s", shares, q.Fee, q.Received, ev.Fee, ev.Amount)
+	}
+}
+
+func findEvent[T any](t *testing.T, pool *restakingpool.Contract, receipt *types.Receipt) T {
+	t.Helper()
+	for _, l := range receipt.Logs {
+		if l.Address != pool.Address() {
+			continue
+		}
+		if ev, err := pool.ParseLog(*l); err == nil {
+			if e, ok := ev.(T); ok {
+				return e
+			}
+		}
+	}
+	var zero T
+	t.Fatalf("no %T event in %s", zero, receipt.TxHash)
+	return zero
+}
@@ -0,0 +1,181 @@
+// Package ratiorules predicts whether RatioFeed.updateRatio accepts a ratio,
+// so the oracle operator does not pay for a transaction that reverts with
+// RatioNotUpdated.
+package ratiorules
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+)
+
+// UpdateInterval is the minimum delay between two updates of a token.
+const UpdateInterval = 12 * time.Hour
+
+// RatioError mirrors IRatioFeed.RatioError.
+type RatioError uint8
+
+const (
+	// NoError is an accepted update.
+	NoError RatioError = iota
+	// TooOften is an update less than UpdateInterval after the previous one.
+	TooOften
+	// GreaterThanPrevious is a ratio above the current one.
+	GreaterThanPrevious
+	// NotInThreshold is a ratio below the current one by more than
+	// ratioThreshold.
+	NotInThreshold
+	// GreaterThanInitial is a first ratio above INITIAL_RATIO.
+	GreaterThanInitial
+)
+
+var names = [...]string{"NoError", "TooOften", "GreaterThanPrevious", "NotInThreshold", "GreaterThanInitial"}
+
+func (e RatioError) String() string {
+	if int(e) < len(names) {
+		return names[e]
+	}
+	return fmt.Sprintf("RatioError(%d)", uint8(e))
+}
+
+// Error formats e like the revert, RatioNotUpdated(TooOften).
+func (e RatioError) Error() string {
+	return "RatioNotUpdated(" + e.String() + ")"
+}
+
+// ratioUpdatesSlot is the storage slot of the private _ratioUpdates
+// mapping: Configurable takes slots 0 to 49, then come _ratios,
+// historicalRatios and ratioThreshold.
+const ratioUpdatesSlot = 53
+
+// StorageReader reads contract storage, which ethclient.Client and the
+// simulated backend implement.
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// State is the RatioFeed state _checkRatioRules reads for one token.
+type State struct {
+	// Ratio is getRatio, 0 before the first update.
+	Ratio *big.Int
+	// LastUpdate is the timestamp of the last updateRatio. repairRatio
+	// does not move it.
+	LastUpdate uint64
+	// Threshold is ratioThreshold out of MaxThreshold.
+	Threshold    *big.Int
+	MaxThreshold *big.Int
+	InitialRatio *big.Int
+}
+
+// ReadState reads the state of token at block, nil for the latest.
+func ReadState(ctx context.Context, backend StorageReader, feed *ratiofeed.Contract, token common.Address, block *big.Int) (*State, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+	s := new(State)
+	var err error
+	if s.Ratio, err = feed.GetRatio(opts, token); err != nil {
+		return nil, errors.Wrap(err, "could not get ratio")
+	}
+	if s.Threshold, err = feed.RatioThreshold(opts); err != nil {
+		return nil, errors.Wrap(err, "could not get ratioThreshold")
+	}
+	max, err := feed.MAXTHRESHOLD(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get MAX_THRESHOLD")
+	}
+	s.MaxThreshold = new(big.Int).SetUint64(uint64(max))
+	if s.InitialRatio, err = feed.INITIALRATIO(opts); err != nil {
+		return nil, errors.Wrap(err, "could not get INITIAL_RATIO")
+	}
+	value, err := backend.StorageAt(ctx, feed.Address(), mappingSlot(token, ratioUpdatesSlot), block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read last update")
+	}
+	last := new(big.Int).SetBytes(value)
+	if !last.IsUint64() {
+		return nil, errors.Errorf("last update %s out of range", last)
+	}
+	s.LastUpdate = last.Uint64()
+	return s, nil
+}
+
+// mappingSlot is the slot of key in the mapping at slot.
+func mappingSlot(key common.Address, slot int64) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(key.Bytes(), 32),
+		common.LeftPadBytes(big.NewInt(slot).Bytes(), 32),
+	)
+}
+
+// Check is _checkRatioRules for an update of the token to ratio in a block
+// with timestamp now.
+func (s *State) Check(now uint64, ratio *big.Int) RatioError {
+	if s.Ratio.Sign() == 0 {
+		if ratio.Cmp(s.InitialRatio) > 0 {
+			return GreaterThanInitial
+		}
+		return NoError
+	}
+	// the contract would underflow on a timestamp before the last update,
+	// which cannot happen on chain
+	if now < s.LastUpdate || now-s.LastUpdate < uint64(UpdateInterval/time.Second) {
+		return TooOften
+	}
+	if ratio.Cmp(s.Ratio) > 0 {
+		return GreaterThanPrevious
+	}
+	if ratio.Cmp(s.Min()) < 0 {
+		return NotInThreshold
+	}
+	return NoError
+}
+
+// Min is the lowest ratio an update accepts once the interval has passed,
+// Ratio minus the threshold share of it. Ratio itself is the highest.
+func (s *State) Min() *big.Int {
+	threshold := new(big.Int).Mul(s.Ratio, s.Threshold)
+	threshold.Quo(threshold, s.MaxThreshold)
+	return threshold.Sub(s.Ratio, threshold)
+}
+
+// NextUpdate is the earliest timestamp an update is accepted at.
+func (s *State) NextUpdate() uint64 {
+	if s.Ratio.Sign() == 0 {
+		return 0
+	}
+	return s.LastUpdate + uint64(UpdateInterval/time.Second)
+}
+
+// ParseRevert extracts the RatioError from a failed updateRatio call or
+// gas estimation.
+func ParseRevert(err error) (RatioError, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return 0, false
+	}
+	hex, _ := dataErr.ErrorData().(string)
+	data := common.FromHex(hex)
+	parsed, abiErr := ratiofeed.ContractMetaData.GetAbi()
+	if abiErr != nil || len(data) < 4 {
+		return 0, false
+	}
+	e, ok := parsed.Errors["RatioNotUpdated"]
+	if !ok || !bytes.Equal(e.ID[:4], data[:4]) {
+		return 0, false
+	}
+	args, unpackErr := e.Inputs.Unpack(data[4:])
+	if unpackErr != nil || len(args) != 1 {
+		return 0, false
+	}
+	code, ok := args[0].(uint8)
+	return RatioError(code), ok
+}
@@ -0,0 +1,167 @@
+package ratiorules
+
+import (
+	"context"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// blockTime is the timestamp of the latest block.
+func blockTime(t *testing.T, f *fixture.Fixture) uint64 {
+	t.Helper()
+	header, err := f.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return header.Time
+}
+
+// advanceTo moves the chain so that the next transaction is mined at
+// target, or as close after it as the chain allows. The simulated backend
+// mines every block 10 seconds after its parent, plus the adjustment.
+func advanceTo(t *testing.T, f *fixture.Fixture, target uint64) {
+	t.Helper()
+	if latest := blockTime(t, f); target > latest+20 {
+		if err := f.AdvanceTime(time.Duration(target-latest-20) * time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLastUpdateSlot(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	token := f.CToken.Address()
+	ctx := context.Background()
+	s, err := ReadState(ctx, f.Backend, f.RatioFeed, token, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the fixture sets the first ratio with repairRatio
+	if s.LastUpdate != 0 || s.Ratio.Cmp(fixture.Ether) != 0 {
+		t.Fatalf("initial state %+v", s)
+	}
+	if err := f.UpdateRatio(big.NewInt(999e15)); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = ReadState(ctx, f.Backend, f.RatioFeed, token, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := blockTime(t, f); s.LastUpdate != want || s.NextUpdate() != want+12*3600 {
+		t.Fatalf("last update %d, want %d", s.LastUpdate, want)
+	}
+	if _, err := f.Send(f.RatioFeed.RepairRatio(f.Governance.TransactOpts(), token, fixture.Ether)); err != nil {
+		t.Fatal(err)
+	}
+	last := s.LastUpdate
+	if s, err = ReadState(ctx, f.Backend, f.RatioFeed, token, nil); err != nil {
+		t.Fatal(err)
+	}
+	if s.LastUpdate != last {
+		t.Fatalf("repairRatio moved the last update from %d to %d", last, s.LastUpdate)
+	}
+}
+
+// TestDifferential proposes random ratios around the rule boundaries and
+// checks every prediction against updateRatio on the simulated backend.
+func TestDifferential(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	rnd := rand.New(rand.NewSource(35))
+	governance, operator := f.Governance.TransactOpts, f.Operator.TransactOpts
+	tokens := []common.Address{f.CToken.Address()}
+	seen := make(map[RatioError]int)
+
+	for i := 0; i < 200; i++ {
+		switch rnd.Intn(10) {
+		case 0:
+			threshold := big.NewInt(1 + rnd.Int63n(1e8))
+			if _, err := f.Send(f.RatioFeed.SetRatioThreshold(governance(), threshold)); err != nil {
+				t.Fatal(err)
+			}
+		case 1:
+			// a token without a ratio yet
+			tokens = append(tokens, common.BigToAddress(big.NewInt(int64(1000+i))))
+		case 2:
+			ratio := new(big.Int).Sub(fixture.Ether, big.NewInt(rnd.Int63n(1e17)))
+			token := tokens[rnd.Intn(len(tokens))]
+			if _, err := f.Send(f.RatioFeed.RepairRatio(governance(), token, ratio)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		token := tokens[rnd.Intn(len(tokens))]
+		s, err := ReadState(ctx, f.Backend, f.RatioFeed, token, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		interval := uint64(UpdateInterval / time.Second)
+		switch rnd.Intn(4) {
+		case 0:
+			advanceTo(t, f, s.LastUpdate+interval-10)
+		case 1:
+			advanceTo(t, f, s.LastUpdate+interval)
+		case 2:
+			advanceTo(t, f, s.LastUpdate+interval+uint64(rnd.Int63n(int64(interval))))
+		}
+		now := blockTime(t, f) + 10
+
+		var ratio *big.Int
+		min := s.Min()
+		switch rnd.Intn(7) {
+		case 0:
+			ratio = new(big.Int).Add(s.Ratio, big.NewInt(1))
+		case 1:
+			ratio = new(big.Int).Set(s.Ratio)
+		case 2:
+			ratio = min
+		case 3:
+			ratio = new(big.Int).Sub(min, big.NewInt(1))
+		case 4:
+			ratio = new(big.Int).Add(s.InitialRatio, big.NewInt(rnd.Int63n(2)))
+		default:
+			ratio = new(big.Int).Rand(rnd, s.InitialRatio)
+		}
+		if ratio.Sign() < 0 {
+			ratio.SetInt64(0)
+		}
+
+		want := s.Check(now, ratio)
+		seen[want]++
+		receipt, err := f.Send(f.RatioFeed.UpdateRatio(operator(), token, ratio))
+		if err != nil {
+			got, ok := ParseRevert(err)
+			if !ok {
+				t.Fatalf("step %d: update of %s to %s failed: %v", i, token.Hex(), ratio, err)
+			}
+			if got != want {
+				t.Fatalf("step %d: update of %s to %s at %d reverted with %s, predicted %s (state %+v)", i, token.Hex(), ratio, now, got, want, s)
+			}
+			continue
+		}
+		if want != NoError {
+			t.Fatalf("step %d: update of %s to %s at %d succeeded, predicted %s (state %+v)", i, token.Hex(), ratio, now, want, s)
+		}
+		if header, err := f.Backend.HeaderByNumber(ctx, receipt.BlockNumber); err != nil || header.Time != now {
+			t.Fatalf("step %d: mined at another time than %d: %v", i, now, err)
+		}
+	}
+	for e := NoError; e <= GreaterThanInitial; e++ {
+		if seen[e] == 0 {
+			t.Errorf("no update predicted %s", e)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package safe
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// Operation is how a Safe transaction runs its call.
+type Operation uint8
+
+const (
+	// Call is a plain call.
+	Call Operation = 0
+	// DelegateCall runs the code of the destination in the Safe, as for
+	// MultiSend.
+	DelegateCall Operation = 1
+)
+
+// MultiSendCallOnly is the canonical deployment of the MultiSendCallOnly
+// contract of Safe 1.3.0, through which the Safe web app executes batches.
+var MultiSendCallOnly = common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+
+var (
+	domainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+	multiSendID    = crypto.Keccak256([]byte("multiSend(bytes)"))[:4]
+)
+
+// Tx is a Safe transaction. Gas refunds are not used: SafeTxGas, BaseGas,
+// GasPrice, GasToken and RefundReceiver are zero, as the Safe web app sets
+// them.
+type Tx struct {
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+	Operation Operation
+	Nonce     uint64
+}
+
+// Tx returns the Safe transaction executing the batch with the Safe nonce
+// nonce: the call itself for a single call, and a delegate call of
+// multiSend of multiSend, a MultiSendCallOnly, otherwise. Inputs are
+// encoded as in Decode.
+func (b *Batch) Tx(contracts map[common.Address]string, nonce uint64, multiSend common.Address) (*Tx, error) {
+	if len(b.Transactions) == 0 {
+		return nil, errors.New("empty batch")
+	}
+	var packed []byte
+	for i, t := range b.Transactions {
+		contract, ok := contracts[t.To]
+		if !ok {
+			return nil, errors.Errorf("transaction %d: unknown contract at %s", i+1, t.To.Hex())
+		}
+		data, err := t.data(contract)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i+1)
+		}
+		value, ok := new(big.Int).SetString(t.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, errors.Errorf("transaction %d: invalid value %q", i+1, t.Value)
+		}
+		if len(b.Transactions) == 1 {
+			return &Tx{To: t.To, Value: value, Data: data, Operation: Call, Nonce: nonce}, nil
+		}
+		packed = append(packed, byte(Call))
+		packed = append(packed, t.To.Bytes()...)
+		packed = append(packed, math.U256Bytes(value)...)
+		packed = append(packed, math.U256Bytes(big.NewInt(int64(len(data))))...)
+		packed = append(packed, data...)
+	}
+	bytesTy, _ := abi.NewType("bytes", "", nil)
+	args, err := abi.Arguments{{Type: bytesTy}}.Pack(packed)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode multiSend")
+	}
+	data := append(append([]byte{}, multiSendID...), args...)
+	return &Tx{To: multiSend, Value: new(big.Int), Data: data, Operation: DelegateCall, Nonce: nonce}, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator of safe, a Safe
+// 1.3.0 or later, on chainID.
+func DomainSeparator(chainID *big.Int, safe common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		domainTypeHash.Bytes(),
+		math.U256Bytes(new(big.Int).Set(chainID)),
+		common.LeftPadBytes(safe.Bytes(), 32),
+	)
+}
+
+// StructHash returns the EIP-712 hash of the SafeTx message of t.
+func (t *Tx) StructHash() common.Hash {
+	zero := make([]byte, 32)
+	return crypto.Keccak256Hash(
+		safeTxTypeHash.Bytes(),
+		common.LeftPadBytes(t.To.Bytes(), 32),
+		math.U256Bytes(new(big.Int).Set(t.Value)),
+		crypto.Keccak256(t.Data),
+		common.LeftPadBytes([]byte{byte(t.Operation)}, 32),
+		zero, // safeTxGas
+		zero, // baseGas
+		zero, // gasPrice
+		zero, // gasToken
+		zero, // refundReceiver
+		math.U256Bytes(new(big.Int).SetUint64(t.Nonce)),
+	)
+}
+
+// Hash returns the Safe transaction hash of t for safe on chainID, the
+// EIP-712 hash the owners sign.
+func (t *Tx) Hash(chainID *big.Int, safe common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, DomainSeparator(chainID, safe).Bytes(), t.StructHash().Bytes())
+}
@@ -0,0 +1,550 @@
+// Package safe composes the admin calls made through the protocol multisig
+// into batches for the Safe Transaction Builder, and reads such batches
+// back.
+//
+// A Batch is the JSON file the Transaction Builder exports and imports. Add
+// makes its transactions from calls of the generated Transactors, so the
+// call data comes from the generated packers; each transaction also carries
+// the method and its inputs as the Transaction Builder shows them. Decode
+// turns a batch, built here or in the Transaction Builder, back into typed
+// calls, checking that the call data and the inputs agree. Batch.Tx returns
+// the Safe transaction executing a batch, whose Hash is the EIP-712 hash the
+// signers compare with the one their wallet shows.
+package safe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/offline"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+// TxBuilderVersion is the Transaction Builder version batches are written
+// for.
+const TxBuilderVersion = "1.16.5"
+
+// Batch is a Transaction Builder batch file.
+type Batch struct {
+	Version      string        `json:"version"`
+	ChainID      string        `json:"chainId"`
+	CreatedAt    int64         `json:"createdAt"`
+	Meta         Meta          `json:"meta"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+// Meta describes a batch. Checksum is the Transaction Builder checksum of
+// the file, set by Save and checked by Load.
+type Meta struct {
+	Name                    string `json:"name"`
+	Description             string `json:"description"`
+	TxBuilderVersion        string `json:"txBuilderVersion"`
+	CreatedFromSafeAddress  string `json:"createdFromSafeAddress"`
+	CreatedFromOwnerAddress string `json:"createdFromOwnerAddress"`
+	Checksum                string `json:"checksum,omitempty"`
+}
+
+// Transaction is a call of a batch. The Transaction Builder leaves Data
+// empty for calls entered by method, and encodes the inputs instead.
+type Transaction struct {
+	To                   common.Address    `json:"to"`
+	Value                string            `json:"value"`
+	Data                 *hexutil.Bytes    `json:"data"`
+	ContractMethod       *Method           `json:"contractMethod"`
+	ContractInputsValues map[string]string `json:"contractInputsValues"`
+}
+
+// Method is a method as the Transaction Builder describes it.
+type Method struct {
+	Inputs  []Input `json:"inputs"`
+	Name    string  `json:"name"`
+	Payable bool    `json:"payable"`
+}
+
+// Input is an input of a Method.
+type Input struct {
+	InternalType string `json:"internalType"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+}
+
+// New returns an empty batch for safe on chainID.
+func New(chainID *big.Int, safe common.Address, name string) *Batch {
+	return &Batch{
+		Version:   "1.0",
+		ChainID:   chainID.String(),
+		CreatedAt: time.Now().UnixMilli(),
+		Meta: Meta{
+			Name:                   name,
+			TxBuilderVersion:       TxBuilderVersion,
+			CreatedFromSafeAddress: safe.Hex(),
+		},
+	}
+}
+
+// Safe returns the address of the Safe the batch is for.
+func (b *Batch) Safe() (common.Address, error) {
+	if !common.IsHexAddress(b.Meta.CreatedFromSafeAddress) {
+		return common.Address{}, errors.Errorf("invalid Safe address %q", b.Meta.CreatedFromSafeAddress)
+	}
+	return common.HexToAddress(b.Meta.CreatedFromSafeAddress), nil
+}
+
+// Add appends the call a generated Transactor method makes to contract, one
+// of offline.ABIs, at to. call runs with TransactOpts that only pack the
+// transaction; it may set their Value.
+func (b *Batch) Add(contract string, to common.Address, call func(*bind.TransactOpts) (*types.Transaction, error)) error {
+	safe, err := b.Safe()
+	if err != nil {
+		return err
+	}
+	opts := &bind.TransactOpts{
+		From: safe,
+		// Nonce, gas and price are those of the Safe transaction, not
+		// set here: the placeholders keep the Transactor from asking a
+		// node.
+		Nonce:    new(big.Int),
+		GasLimit: 1,
+		GasPrice: new(big.Int),
+		NoSend:   true,
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+	}
+	tx, err := call(opts)
+	if err != nil {
+		return errors.Wrapf(err, "could not pack %s call", contract)
+	}
+	return b.AddData(contract, to, tx.Value(), tx.Data())
+}
+
+// AddData appends a call to contract, one of offline.ABIs, at to.
+func (b *Batch) AddData(contract string, to common.Address, value *big.Int, data []byte) error {
+	method, values, err := unpack(contract, data)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+	if value.Sign() != 0 && !method.IsPayable() {
+		return errors.Errorf("%s.%s is not payable", contract, method.RawName)
+	}
+	t := Transaction{
+		To:                   to,
+		Value:                value.String(),
+		Data:                 (*hexutil.Bytes)(&data),
+		ContractMethod:       &Method{Name: method.RawName, Payable: method.IsPayable()},
+		ContractInputsValues: make(map[string]string, len(values)),
+	}
+	for i, input := range method.Inputs {
+		t.ContractMethod.Inputs = append(t.ContractMethod.Inputs, Input{InternalType: input.Type.String(), Name: input.Name, Type: input.Type.String()})
+		t.ContractInputsValues[input.Name] = inputValue(values[i], input.Type)
+	}
+	b.Transactions = append(b.Transactions, t)
+	return nil
+}
+
+// Decode returns the calls of the batch, contracts naming the contract, one
+// of offline.ABIs, at each destination. The call data of a transaction is
+// its inputs encoded when the Transaction Builder left it empty, and must
+// match them otherwise.
+func (b *Batch) Decode(contracts map[common.Address]string) ([]offline.Call, error) {
+	calls := make([]offline.Call, len(b.Transactions))
+	for i, t := range b.Transactions {
+		contract, ok := contracts[t.To]
+		if !ok {
+			return nil, errors.Errorf("transaction %d: unknown contract at %s", i+1, t.To.Hex())
+		}
+		data, err := t.data(contract)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i+1)
+		}
+		call, err := offline.Describe(contract, data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction %d", i+1)
+		}
+		calls[i] = *call
+	}
+	return calls, nil
+}
+
+// data returns the call data of t, a call to contract.
+func (t *Transaction) data(contract string) ([]byte, error) {
+	if t.ContractMethod == nil {
+		if t.Data == nil {
+			return nil, errors.New("no call data or method")
+		}
+		return *t.Data, nil
+	}
+	meta, ok := offline.ABIs[contract]
+	if !ok {
+		return nil, errors.Errorf("unknown contract %s", contract)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, errors.Wrap(err, contract)
+	}
+	method, err := t.ContractMethod.find(parsed)
+	if err != nil {
+		return nil, errors.Wrap(err, contract)
+	}
+	args := make([]interface{}, len(method.Inputs))
+	for i, input := range method.Inputs {
+		s, ok := t.ContractInputsValues[input.Name]
+		if !ok {
+			return nil, errors.Errorf("%s.%s: no value for %s", contract, method.RawName, input.Name)
+		}
+		if args[i], err = parseInput(s, input.Type); err != nil {
+			return nil, errors.Wrapf(err, "%s.%s argument %s", contract, method.RawName, input.Name)
+		}
+	}
+	data, err := parsed.Pack(method.Name, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not encode %s.%s", contract, method.RawName)
+	}
+	if t.Data != nil && len(*t.Data) > 0 && !bytes.Equal(*t.Data, data) {
+		return nil, errors.Errorf("call data does not match the inputs of %s.%s", contract, method.RawName)
+	}
+	return data, nil
+}
+
+// find returns the ABI method m describes, matched by name and input types.
+func (m *Method) find(parsed *abi.ABI) (*abi.Method, error) {
+	for _, method := range parsed.Methods {
+		if method.RawName != m.Name || len(method.Inputs) != len(m.Inputs) {
+			continue
+		}
+		match := true
+		for i, input := range method.Inputs {
+			match = match && input.Type.String() == m.Inputs[i].Type
+		}
+		if match {
+			method := method
+			return &method, nil
+		}
+	}
+	return nil, errors.Errorf("no method %s with these inputs", m.Name)
+}
+
+// unpack decodes data, a call to contract.
+func unpack(contract string, data []byte) (*abi.Method, []interface{}, error) {
+	meta, ok := offline.ABIs[contract]
+	if !ok {
+		return nil, nil, errors.Errorf("unknown contract %s", contract)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, contract)
+	}
+	if len(data) < 4 {
+		return nil, nil, errors.Errorf("no %s method in %s", contract, hexutil.Bytes(data))
+	}
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, contract)
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not decode %s.%s", contract, method.RawName)
+	}
+	return method, values, nil
+}
+
+// inputValue writes a decoded value as the Transaction Builder takes it:
+// scalars as strings, lists and tuples as JSON arrays of strings.
+func inputValue(v interface{}, typ abi.Type) string {
+	switch typ.T {
+	case abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		data, _ := json.Marshal(jsonValue(v, typ))
+		return string(data)
+	}
+	return jsonValue(v, typ).(string)
+}
+
+func jsonValue(v interface{}, typ abi.Type) interface{} {
+	rv := reflect.ValueOf(v)
+	switch typ.T {
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]interface{}, rv.Len())
+		for i := range elems {
+			elems[i] = jsonValue(rv.Index(i).Interface(), *typ.Elem)
+		}
+		return elems
+	case abi.TupleTy:
+		fields := make([]interface{}, len(typ.TupleElems))
+		for i, elem := range typ.TupleElems {
+			fields[i] = jsonValue(rv.Field(i).Interface(), *elem)
+		}
+		return fields
+	case abi.AddressTy:
+		return v.(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(rv.Bytes())
+	case abi.FixedBytesTy:
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	}
+	return fmt.Sprint(v)
+}
+
+// parseInput parses an input value written by the Transaction Builder.
+func parseInput(s string, typ abi.Type) (interface{}, error) {
+	var v interface{} = s
+	switch typ.T {
+	case abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		d := json.NewDecoder(strings.NewReader(s))
+		d.UseNumber()
+		var list []interface{}
+		if err := d.Decode(&list); err != nil {
+			return nil, errors.Errorf("expected a JSON array for %s, got %q", typ, s)
+		}
+		v = list
+	}
+	return parse.Arg(builderValue(v, typ), typ, nil)
+}
+
+// builderValue turns a value parsed from the Transaction Builder into the
+// form parse.Arg takes: numbers as strings and tuples as maps.
+func builderValue(v interface{}, typ abi.Type) interface{} {
+	switch x := v.(type) {
+	case json.Number:
+		return x.String()
+	case []interface{}:
+		switch typ.T {
+		case abi.SliceTy, abi.ArrayTy:
+			out := make([]interface{}, len(x))
+			for i, elem := range x {
+				out[i] = builderValue(elem, *typ.Elem)
+			}
+			return out
+		case abi.TupleTy:
+			if len(x) != len(typ.TupleElems) {
+				return x
+			}
+			out := make(map[string]interface{}, len(x))
+			for i, name := range typ.TupleRawNames {
+				out[name] = builderValue(x[i], *typ.TupleElems[i])
+			}
+			return out
+		}
+	}
+	return v
+}
+
+// checksum returns the Transaction Builder checksum of a batch file: the
+// keccak256 hash of the file serialized as serializeJSONObject of the
+// Transaction Builder does, without the checksum and with a null name.
+func checksum(data []byte) (common.Hash, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var doc map[string]interface{}
+	if err := d.Decode(&doc); err != nil {
+		return common.Hash{}, err
+	}
+	meta, ok := doc["meta"].(map[string]interface{})
+	if !ok {
+		return common.Hash{}, errors.New("no meta")
+	}
+	delete(meta, "checksum")
+	meta["name"] = nil
+	var buf bytes.Buffer
+	if err := serialize(&buf, doc); err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(buf.Bytes()), nil
+}
+
+// serialize writes v as serializeJSONObject of the Transaction Builder: an
+// object is its sorted keys as a JSON array followed by each value and a
+// comma, in braces, and an array its elements separated by commas, in
+// brackets. Other values are written as JSON.stringify writes them.
+func serialize(buf *bytes.Buffer, v interface{}) error {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(x))
+		for k := range x {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		buf.WriteString("{[")
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			quote(buf, k)
+		}
+		buf.WriteByte(']')
+		for _, k := range keys {
+			if err := serialize(buf, x[k]); err != nil {
+				return err
+			}
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('}')
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, elem := range x {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := serialize(buf, elem); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(x))
+	case string:
+		quote(buf, x)
+	case json.Number:
+		f, err := x.Float64()
+		if err != nil {
+			return errors.Wrapf(err, "invalid number %s", x)
+		}
+		buf.WriteString(jsNumber(f))
+	default:
+		return errors.Errorf("unexpected JSON value %v", v)
+	}
+	return nil
+}
+
+// quote writes s as a JSON string the way JSON.stringify does: unlike
+// encoding/json it keeps <, >, & and U+2028 as they are and writes the
+// short escapes of backspace and form feed.
+func quote(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// jsNumber formats f as JavaScript does: the shortest digits that round
+// trip, in fixed notation from 1e-6 up to 1e21 and in exponent notation,
+// such as 1e+21, out of it.
+func jsNumber(f float64) string {
+	if f == 0 {
+		return "0"
+	}
+	sign := ""
+	if f < 0 {
+		sign, f = "-", -f
+	}
+	// d.ddde±x: the digits and the exponent of the shortest form
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(e, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	x, _ := strconv.Atoi(exp)
+	k, n := len(digits), x+1
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits
+	}
+	s := sign + digits[:1]
+	if k > 1 {
+		s += "." + digits[1:]
+	}
+	if x >= 0 {
+		return s + "e+" + strconv.Itoa(x)
+	}
+	return s + "e" + strconv.Itoa(x)
+}
+
+// Load reads a batch file. A file with a checksum must match it.
+func Load(path string) (*Batch, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read batch")
+	}
+	b := new(Batch)
+	if err := json.Unmarshal(data, b); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %s", path)
+	}
+	if b.Meta.Checksum != "" {
+		sum, err := checksum(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse %s", path)
+		}
+		if !strings.EqualFold(sum.Hex(), b.Meta.Checksum) {
+			return nil, errors.Errorf("%s: checksum %s, batch hashes to %s", path, b.Meta.Checksum, sum.Hex())
+		}
+	}
+	return b, nil
+}
+
+// Save writes the batch with its checksum to path through a temporary file,
+// so a crash never leaves a truncated file behind.
+func (b *Batch) Save(path string) error {
+	b.Meta.Checksum = ""
+	data, err := json.Marshal(b)
+	if err != nil {
+		return errors.Wrap(err, "could not encode batch")
+	}
+	sum, err := checksum(data)
+	if err != nil {
+		return errors.Wrap(err, "could not encode batch")
+	}
+	b.Meta.Checksum = sum.Hex()
+	if data, err = json.MarshalIndent(b, "", "  "); err != nil {
+		return errors.Wrap(err, "could not encode batch")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "could not write batch")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write batch")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write batch")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), path), "could not write batch")
+}
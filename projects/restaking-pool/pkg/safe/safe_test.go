@@ -0,0 +1,253 @@
+package safe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+func TestBatch(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	// the governance account stands for the Safe
+	safe := f.Governance.Address
+	contracts := map[common.Address]string{
+		f.RestakingPool.Address(): "RestakingPool",
+		f.RatioFeed.Address():     "RatioFeed",
+	}
+
+	b := New(fixture.ChainID, safe, "operations")
+	if err := b.Add("RestakingPool", f.RestakingPool.Address(), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return f.RestakingPool.AddRestaker(opts, "safe-provider")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Add("RestakingPool", f.RestakingPool.Address(), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return f.RestakingPool.SetFlashUnstakeFeeParams(opts, 80e7, 2e7, 10e8)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Add("RatioFeed", f.RatioFeed.Address(), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return f.RatioFeed.SetRatioThreshold(opts, big.NewInt(5e6))
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.Transactions[1].ContractInputsValues["newMaxFlashFeeRate"]; got != "800000000" {
+		t.Errorf("newMaxFlashFeeRate input %q", got)
+	}
+
+	path := filepath.Join(t.TempDir(), "batch.json")
+	if err := b.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls, err := loaded.Decode(contracts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"RestakingPool.addRestaker(provider: safe-provider)",
+		"RestakingPool.setFlashUnstakeFeeParams(newMaxFlashFeeRate: 800000000, newOptimalUnstakeRate: 20000000, newUnstakeUtilizationKink: 1000000000)",
+		"RatioFeed.setRatioThreshold(newValue: 5000000)",
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("calls %v", calls)
+	}
+	for i, c := range calls {
+		if c.String() != want[i] {
+			t.Errorf("call %d: %s, want %s", i+1, c, want[i])
+		}
+	}
+
+	// a file edited after its checksum is refused
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited := strings.Replace(string(data), `"operations"`, `"renamed"`, 1)
+	if err := os.WriteFile(path, []byte(edited), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err != nil {
+		t.Errorf("name is not part of the checksum: %v", err)
+	}
+	edited = strings.Replace(edited, `"safe-provider"`, `"other-provider"`, 1)
+	if err := os.WriteFile(path, []byte(edited), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("loaded a batch that does not match its checksum")
+	}
+
+	// inputs are encoded when the data is left out, and must match it
+	// otherwise
+	byMethod := *loaded
+	byMethod.Transactions = append([]Transaction(nil), loaded.Transactions...)
+	for i := range byMethod.Transactions {
+		byMethod.Transactions[i].Data = nil
+	}
+	if again, err := byMethod.Decode(contracts); err != nil || again[1].String() != want[1] {
+		t.Fatalf("decode by method %v: %v", again, err)
+	}
+	byMethod.Transactions[0].Data = loaded.Transactions[0].Data
+	byMethod.Transactions[0].ContractInputsValues = map[string]string{"provider": "other-provider"}
+	if _, err := byMethod.Decode(contracts); err == nil {
+		t.Error("decoded inputs that do not match the data")
+	}
+	if _, err := loaded.Decode(map[common.Address]string{f.RatioFeed.Address(): "RatioFeed"}); err == nil {
+		t.Error("decoded a call to an unknown contract")
+	}
+
+	// the batch runs from the Safe: each call goes through
+	ctx := context.Background()
+	tx, err := loaded.Tx(contracts, 7, MultiSendCallOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.To != MultiSendCallOnly || tx.Operation != DelegateCall || hexutil.Encode(tx.Data[:4]) != "0x8d80ff0a" {
+		t.Fatalf("multiSend transaction %+v", tx)
+	}
+	for i, bt := range loaded.Transactions {
+		to := bt.To
+		if _, err := f.Backend.CallContract(ctx, ethereum.CallMsg{From: safe, To: &to, Data: *bt.Data}, nil); err != nil {
+			t.Errorf("call %d reverts from the Safe: %v", i+1, err)
+		}
+	}
+
+	single, err := (&Batch{Transactions: loaded.Transactions[2:]}).Tx(contracts, 7, MultiSendCallOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.To != f.RatioFeed.Address() || single.Operation != Call {
+		t.Errorf("single call transaction %+v", single)
+	}
+
+	// the hash is the EIP-712 hash of the SafeTx, as wallets compute it
+	for _, tx := range []*Tx{tx, single} {
+		if got, want := tx.Hash(fixture.ChainID, safe), typedDataHash(t, tx, safe); got != want {
+			t.Errorf("hash %s, EIP-712 hash %s", got.Hex(), want.Hex())
+		}
+	}
+	if other := tx.Hash(big.NewInt(1), safe); other == tx.Hash(fixture.ChainID, safe) {
+		t.Error("hash does not depend on the chain")
+	}
+}
+
+func TestLoadExport(t *testing.T) {
+	// a batch as the Transaction Builder exports it, with the checksum its
+	// calculateChecksum gives in node
+	const path = "testdata/txbuilder.json"
+	b, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Meta.Checksum != "0xe291da7730d80f4976327ad28a3554ebffee60eb0b329d002c1a853a7863dd2b" {
+		t.Fatalf("checksum %s", b.Meta.Checksum)
+	}
+	pool := common.HexToAddress("0x1e5F3Ab0c77F51d3f6B9C7a6FC0d3A1a8b2C4d6e")
+	calls, err := b.Decode(map[common.Address]string{pool: "RestakingPool"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"RestakingPool.addRestaker(provider: p2p)",
+		"RestakingPool.setFlashUnstakeFeeParams(newMaxFlashFeeRate: 800000000, newOptimalUnstakeRate: 20000000, newUnstakeUtilizationKink: 1000000000)",
+	}
+	if len(calls) != len(want) || calls[0].String() != want[0] || calls[1].String() != want[1] {
+		t.Fatalf("calls %v", calls)
+	}
+
+	// the description is part of the checksum
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited := filepath.Join(t.TempDir(), "batch.json")
+	if err := os.WriteFile(edited, []byte(strings.Replace(string(data), "0.8%", "0.9%", 1)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(edited); err == nil {
+		t.Error("loaded an export whose description was edited")
+	}
+}
+
+func TestSerialize(t *testing.T) {
+	// the output of serializeJSONObject in node
+	for in, want := range map[string]string{
+		`{"b": [1, 1.0, 0.1, -0, 1e21, 1e20], "a": {"y": null, "x": true}, "c": {}}`: `{["a","b","c"]{["x","y"]true,null,},[1,1,0.1,0,1e+21,100000000000000000000],{[]},}`,
+		`{"s": ["<&> é\b\u0001\"", 1e-7, 0.000001, 123456789012345678901234]}`:       `{["s"]["<&> é\b\u0001\"",1e-7,0.000001,1.2345678901234569e+23],}`,
+	} {
+		d := json.NewDecoder(strings.NewReader(in))
+		d.UseNumber()
+		var v interface{}
+		if err := d.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := serialize(&buf, v); err != nil || buf.String() != want {
+			t.Errorf("serialize(%s) = %s: %v, want %s", in, buf.String(), err, want)
+		}
+	}
+}
+
+// typedDataHash hashes tx with the EIP-712 implementation of go-ethereum.
+func typedDataHash(t *testing.T, tx *Tx, safe common.Address) common.Hash {
+	t.Helper()
+	typed := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "chainId", Type: "uint256"}, {Name: "verifyingContract", Type: "address"}},
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain:      apitypes.TypedDataDomain{ChainId: (*math.HexOrDecimal256)(fixture.ChainID), VerifyingContract: safe.Hex()},
+		Message: apitypes.TypedDataMessage{
+			"to":             tx.To.Hex(),
+			"value":          tx.Value.String(),
+			"data":           hexutil.Encode(tx.Data),
+			"operation":      big.NewInt(int64(tx.Operation)).String(),
+			"safeTxGas":      "0",
+			"baseGas":        "0",
+			"gasPrice":       "0",
+			"gasToken":       common.Address{}.Hex(),
+			"refundReceiver": common.Address{}.Hex(),
+			"nonce":          new(big.Int).SetUint64(tx.Nonce).String(),
+		},
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return common.BytesToHash(hash)
+}
@@ -0,0 +1,90 @@
+package scenario
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Diff is a mismatch between an expectation and the chain.
+type Diff struct {
+	Path string
+	Want interface{}
+	Got  interface{}
+}
+
+func (d Diff) String() string {
+	return fmt.Sprintf("%s: want %v, got %v", d.Path, d.Want, d.Got)
+}
+
+// StepResult is the outcome of one step. Err is set when the step could not
+// be executed at all, Diffs when it ran but did not match expectations.
+type StepResult struct {
+	Index   int
+	Name    string
+	GasUsed uint64
+	Err     error
+	Diffs   []Diff
+}
+
+// Failed reports whether the step did not pass.
+func (r StepResult) Failed() bool {
+	return r.Err != nil || len(r.Diffs) > 0
+}
+
+// Report is the outcome of a scenario. Execution stops at the first failed
+// step, so Steps may be shorter than the scenario.
+type Report struct {
+	Scenario string
+	Steps    []StepResult
+	Total    int
+}
+
+// Failed reports whether any step did not pass.
+func (r *Report) Failed() bool {
+	for _, s := range r.Steps {
+		if s.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	status := "PASS"
+	if r.Failed() {
+		status = "FAIL"
+	}
+	fmt.Fprintf(&b, "%s %s\n", status, r.Scenario)
+	for _, s := range r.Steps {
+		status := "ok  "
+		if s.Failed() {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "  %s %d. %s", status, s.Index+1, s.Name)
+		if s.GasUsed > 0 {
+			fmt.Fprintf(&b, " (gas %d)", s.GasUsed)
+		}
+		b.WriteString("\n")
+		if s.Err != nil {
+			fmt.Fprintf(&b, "       error: %v\n", s.Err)
+		}
+		for _, d := range s.Diffs {
+			fmt.Fprintf(&b, "       %s\n", d)
+		}
+	}
+	if skipped := r.Total - len(r.Steps); skipped > 0 {
+		fmt.Fprintf(&b, "  %d step(s) skipped\n", skipped)
+	}
+	return b.String()
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,421 @@
+package scenario
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+	delegationmanagermock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/DelegationManagerMock"
+	eigenpodmanagermock "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/EigenPodManagerMock"
+	feecollector "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/FeeCollector"
+	protocolconfig "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/ProtocolConfig"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakerdeployer "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerDeployer"
+	restakerfacets "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakerFacets"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+	rewardscoordinator "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RewardsCoordinator"
+	ctoken "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/cToken"
+)
+
+// DefaultContract receives calls and views that do not name a contract.
+const DefaultContract = "RestakingPool"
+
+// DefaultFrom sends calls that do not name a sender.
+const DefaultFrom = "user0"
+
+type wrapper interface {
+	Address() common.Address
+}
+
+// logParser is implemented by wrappers of contracts that declare events.
+type logParser interface {
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+}
+
+type rawWrapper interface {
+	Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error
+	Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error)
+}
+
+type target struct {
+	name    string
+	abi     *abi.ABI
+	wrapper wrapper
+	raw     rawWrapper
+}
+
+// Runner executes scenarios against a fixture.
+type Runner struct {
+	fixture  *fixture.Fixture
+	targets  []*target
+	accounts map[string]*fixture.Account
+}
+
+// NewRunner prepares a runner for the contracts deployed by f.
+func NewRunner(f *fixture.Fixture) (*Runner, error) {
+	r := &Runner{fixture: f, accounts: map[string]*fixture.Account{
+		"deployer":   f.Deployer,
+		"governance": f.Governance,
+		"operator":   f.Operator,
+		"treasury":   f.Treasury,
+	}}
+	for i, u := range f.Users {
+		r.accounts[fmt.Sprintf("user%d", i)] = u
+	}
+
+	contracts := []struct {
+		name    string
+		wrapper wrapper
+		raw     rawWrapper
+		meta    *bind.MetaData
+	}{
+		{"RestakingPool", f.RestakingPool, &restakingpool.ContractRaw{Contract: f.RestakingPool}, restakingpool.ContractMetaData},
+		{"cToken", f.CToken, &ctoken.ContractRaw{Contract: f.CToken}, ctoken.ContractMetaData},
+		{"RatioFeed", f.RatioFeed, &ratiofeed.ContractRaw{Contract: f.RatioFeed}, ratiofeed.ContractMetaData},
+		{"ProtocolConfig", f.ProtocolConfig, &protocolconfig.ContractRaw{Contract: f.ProtocolConfig}, protocolconfig.ContractMetaData},
+		{"FeeCollector", f.FeeCollector, &feecollector.ContractRaw{Contract: f.FeeCollector}, feecollector.ContractMetaData},
+		{"RestakerDeployer", f.RestakerDeployer, &restakerdeployer.ContractRaw{Contract: f.RestakerDeployer}, restakerdeployer.ContractMetaData},
+		{"RestakerFacets", f.RestakerFacets, &restakerfacets.ContractRaw{Contract: f.RestakerFacets}, restakerfacets.ContractMetaData},
+		{"EigenPodManager", f.EigenPodManager, &eigenpodmanagermock.ContractRaw{Contract: f.EigenPodManager}, eigenpodmanagermock.ContractMetaData},
+		{"DelegationManager", f.DelegationManager, &delegationmanagermock.ContractRaw{Contract: f.DelegationManager}, delegationmanagermock.ContractMetaData},
+		{"RewardsCoordinator", f.RewardsCoordinator, &rewardscoordinator.ContractRaw{Contract: f.RewardsCoordinator}, rewardscoordinator.ContractMetaData},
+	}
+	for _, c := range contracts {
+		parsed, err := c.meta.GetAbi()
+		if err != nil {
+			return nil, errors.Wrap(err, c.name)
+		}
+		r.targets = append(r.targets, &target{name: c.name, abi: parsed, wrapper: c.wrapper, raw: c.raw})
+	}
+	return r, nil
+}
+
+// Run executes the scenario step by step and stops at the first failure.
+func (r *Runner) Run(s *Scenario) *Report {
+	report := &Report{Scenario: s.Name, Total: len(s.Steps)}
+	for i, step := range s.Steps {
+		res := r.runStep(step)
+		res.Index = i
+		res.Name = step.Name
+		if res.Name == "" {
+			res.Name = describe(step)
+		}
+		report.Steps = append(report.Steps, res)
+		if res.Failed() {
+			break
+		}
+	}
+	return report
+}
+
+func describe(step Step) string {
+	var parts []string
+	if step.Advance != "" {
+		parts = append(parts, "advance "+step.Advance)
+	}
+	if step.Call != "" {
+		parts = append(parts, fmt.Sprintf("%s.%s", orDefault(step.Contract, DefaultContract), step.Call))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "views")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (r *Runner) runStep(step Step) (res StepResult) {
+	if step.Advance != "" {
+		d, err := parse.Duration(step.Advance)
+		if err == nil {
+			err = r.fixture.AdvanceTime(d)
+		}
+		if err != nil {
+			res.Err = errors.Wrap(err, "advance")
+			return res
+		}
+	}
+	if step.Call != "" {
+		receipt, diffs, err := r.transact(step)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		res.Diffs = append(res.Diffs, diffs...)
+		if receipt != nil {
+			res.GasUsed = receipt.GasUsed
+			eventDiffs, err := r.checkEvents(step.Events, receipt.Logs)
+			if err != nil {
+				res.Err = err
+				return res
+			}
+			res.Diffs = append(res.Diffs, eventDiffs...)
+		}
+	}
+	for i, view := range step.Views {
+		diffs, err := r.checkView(view)
+		if err != nil {
+			res.Err = errors.Wrapf(err, "views[%d]", i)
+			return res
+		}
+		res.Diffs = append(res.Diffs, diffs...)
+	}
+	return res
+}
+
+// transact sends the step's call. A receipt is returned only when the call
+// was mined; an expected revert yields neither a receipt nor diffs.
+func (r *Runner) transact(step Step) (*types.Receipt, []Diff, error) {
+	t, err := r.target(orDefault(step.Contract, DefaultContract))
+	if err != nil {
+		return nil, nil, err
+	}
+	method, args, err := r.pack(t, step.Call, step.Args)
+	if err != nil {
+		return nil, nil, err
+	}
+	from := orDefault(step.From, DefaultFrom)
+	account, ok := r.accounts[strings.ToLower(from)]
+	if !ok {
+		return nil, nil, errors.Errorf("unknown sender %q", from)
+	}
+	opts := account.TransactOpts()
+	opts.GasLimit = step.Gas
+	if step.Value != nil {
+		if opts.Value, err = parse.Amount(step.Value); err != nil {
+			return nil, nil, errors.Wrap(err, "value")
+		}
+	}
+
+	path := fmt.Sprintf("%s.%s", t.name, step.Call)
+	tx, err := t.raw.Transact(opts, method.Name, args...)
+	if err != nil {
+		reason, ok := r.revertReason(err)
+		if !ok {
+			return nil, nil, errors.Wrap(err, path)
+		}
+		if step.Revert == "" {
+			return nil, []Diff{{Path: path + " revert", Want: "none", Got: reason}}, nil
+		}
+		if step.Revert != "*" && step.Revert != reason {
+			return nil, []Diff{{Path: path + " revert", Want: step.Revert, Got: reason}}, nil
+		}
+		return nil, nil, nil
+	}
+	receipt, err := r.fixture.Send(tx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, path)
+	}
+	if step.Revert != "" {
+		return receipt, []Diff{{Path: path + " revert", Want: step.Revert, Got: "none"}}, nil
+	}
+	return receipt, nil, nil
+}
+
+// checkEvents matches expected events in order against the decoded logs.
+func (r *Runner) checkEvents(events []Event, logs []*types.Log) ([]Diff, error) {
+	var diffs []Diff
+	next := 0
+	for i, want := range events {
+		path := fmt.Sprintf("events[%d] %s", i, want.Name)
+		found := false
+		for ; next < len(logs) && !found; next++ {
+			t, event, decoded, err := r.decodeLog(*logs[next])
+			if err != nil {
+				return nil, err
+			}
+			if event == nil || event.RawName != want.Name || want.Contract != "" && !strings.EqualFold(want.Contract, t.name) {
+				continue
+			}
+			found = true
+			for _, name := range sortedKeys(want.Args) {
+				arg, value, ok := eventArg(event, decoded, name)
+				if !ok {
+					return nil, errors.Errorf("%s has no argument %s", want.Name, name)
+				}
+				exp, err := expected(want.Args[name], arg, r.resolve)
+				if err != nil {
+					return nil, errors.Wrapf(err, "%s.%s", path, name)
+				}
+				diffs = append(diffs, compare(path+"."+name, exp, normalize(value, arg))...)
+			}
+		}
+		if !found {
+			diffs = append(diffs, Diff{Path: path, Want: "emitted", Got: "not emitted"})
+		}
+	}
+	return diffs, nil
+}
+
+// decodeLog decodes log with the wrapper of its emitter. Logs of unknown
+// contracts or events yield a nil event.
+func (r *Runner) decodeLog(log types.Log) (*target, *abi.Event, generated.AbigenLog, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil, nil, nil
+	}
+	for _, t := range r.targets {
+		parser, ok := t.wrapper.(logParser)
+		if !ok || t.wrapper.Address() != log.Address {
+			continue
+		}
+		for _, event := range t.abi.Events {
+			if event.ID != log.Topics[0] {
+				continue
+			}
+			decoded, err := parser.ParseLog(log)
+			if err != nil {
+				return nil, nil, nil, errors.Wrapf(err, "could not decode %s.%s", t.name, event.Name)
+			}
+			event := event
+			return t, &event, decoded, nil
+		}
+	}
+	return nil, nil, nil, nil
+}
+
+// eventArg returns the ABI type and the value of the named argument of a
+// decoded event. Indexed dynamic arguments are only available as hashes.
+func eventArg(event *abi.Event, decoded generated.AbigenLog, name string) (abi.Type, interface{}, bool) {
+	for _, input := range event.Inputs {
+		if input.Name != name {
+			continue
+		}
+		field := reflectField(decoded, abi.ToCamelCase(name))
+		if !field.IsValid() {
+			return abi.Type{}, nil, false
+		}
+		typ := input.Type
+		if input.Indexed && field.Type() == reflectHashType {
+			typ, _ = abi.NewType("bytes32", "", nil)
+		}
+		return typ, field.Interface(), true
+	}
+	return abi.Type{}, nil, false
+}
+
+func (r *Runner) checkView(view View) ([]Diff, error) {
+	t, err := r.target(orDefault(view.Contract, DefaultContract))
+	if err != nil {
+		return nil, err
+	}
+	method, args, err := r.pack(t, view.Call, view.Args)
+	if err != nil {
+		return nil, err
+	}
+	var out []interface{}
+	if err := t.raw.Call(nil, &out, method.Name, args...); err != nil {
+		return nil, errors.Wrapf(err, "%s.%s", t.name, view.Call)
+	}
+
+	path := fmt.Sprintf("%s.%s(%s)", t.name, view.Call, formatArgs(view.Args))
+	if len(method.Outputs) == 1 {
+		exp, err := expected(view.Want, method.Outputs[0].Type, r.resolve)
+		if err != nil {
+			return nil, errors.Wrap(err, path)
+		}
+		return compare(path, exp, normalize(out[0], method.Outputs[0].Type)), nil
+	}
+	wants, ok := view.Want.([]interface{})
+	if !ok || len(wants) != len(method.Outputs) {
+		return nil, errors.Errorf("%s: want must list %d outputs", path, len(method.Outputs))
+	}
+	var diffs []Diff
+	for i, output := range method.Outputs {
+		exp, err := expected(wants[i], output.Type, r.resolve)
+		if err != nil {
+			return nil, errors.Wrap(err, path)
+		}
+		diffs = append(diffs, compare(fmt.Sprintf("%s[%d]", path, i), exp, normalize(out[i], output.Type))...)
+	}
+	return diffs, nil
+}
+
+// pack resolves the method of a call with parse.Method and converts the
+// arguments.
+func (r *Runner) pack(t *target, name string, rawArgs []interface{}) (*abi.Method, []interface{}, error) {
+	method, err := parse.Method(t.abi, name, len(rawArgs))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, t.name)
+	}
+	args := make([]interface{}, len(rawArgs))
+	for i, input := range method.Inputs {
+		c, err := parse.Arg(rawArgs[i], input.Type, r.resolve)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "%s.%s argument %s", t.name, name, input.Name)
+		}
+		args[i] = c
+	}
+	return method, args, nil
+}
+
+func (r *Runner) target(name string) (*target, error) {
+	for _, t := range r.targets {
+		if strings.EqualFold(t.name, name) {
+			return t, nil
+		}
+	}
+	return nil, errors.Errorf("unknown contract %q", name)
+}
+
+// resolve maps account and contract names to addresses.
+func (r *Runner) resolve(name string) (common.Address, bool) {
+	if strings.EqualFold(name, "zero") {
+		return common.Address{}, true
+	}
+	if a, ok := r.accounts[strings.ToLower(name)]; ok {
+		return a.Address, true
+	}
+	if t, err := r.target(name); err == nil {
+		return t.wrapper.Address(), true
+	}
+	return common.Address{}, false
+}
+
+// revertReason extracts the custom error name or revert string from a
+// failed gas estimation.
+func (r *Runner) revertReason(err error) (string, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return "", false
+	}
+	hex, _ := dataErr.ErrorData().(string)
+	data, decodeErr := hexutil.Decode(hex)
+	if decodeErr != nil || len(data) < 4 {
+		return hex, true
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+	for _, t := range r.targets {
+		for _, e := range t.abi.Errors {
+			if bytes.Equal(e.ID[:4], data[:4]) {
+				return e.Name, true
+			}
+		}
+	}
+	return hex, true
+}
+
+func formatArgs(args []interface{}) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		s[i] = fmt.Sprint(a)
+	}
+	return strings.Join(s, ", ")
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,125 @@
+// Package scenario runs declarative multi-step flows against a protocol
+// deployed by pkg/fixture. Scenarios are written in YAML or JSON:
+//
+//	name: stake and claim
+//	steps:
+//	  - name: alice stakes with a referral code
+//	    from: user0
+//	    call: stake
+//	    args: [referral-1]
+//	    value: 1 ether
+//	    events:
+//	      - name: Staked
+//	        args: {staker: user0, amount: 1 ether}
+//	    views:
+//	      - call: getTotalPendingUnstakes
+//	        want: 0
+//	  - advance: 12h
+//
+// Calls and views go to RestakingPool unless contract is set. Addresses may
+// be given as hex or by name: deployer, governance, operator, treasury,
+// user0..userN, zero, or any contract name known to the runner. Amounts
+// accept integers, hex, exponents ("1e18") and units ("0.5 ether", "10 gwei").
+// Fixed-size bytes accept hex or short text.
+package scenario
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+// Scenario is a named sequence of steps.
+type Scenario struct {
+	Name  string `yaml:"name"`
+	Steps []Step `yaml:"steps"`
+}
+
+// Step optionally moves time forward, sends a transaction and checks its
+// outcome, then checks views. Each part is skipped when empty.
+type Step struct {
+	Name string `yaml:"name"`
+	// Advance is a duration such as "12h" or "7d" to move the chain by
+	// before the call.
+	Advance string `yaml:"advance"`
+
+	From     string `yaml:"from"`
+	Contract string `yaml:"contract"`
+	// Call is a method name, or its signature such as
+	// "transfer(address,uint256)" when overloads take as many arguments.
+	Call  string        `yaml:"call"`
+	Args  []interface{} `yaml:"args"`
+	Value interface{}   `yaml:"value"`
+	// Gas sets an explicit gas limit. Calls whose work depends on gasleft(),
+	// such as distributeUnstakes, need it since estimation finds the
+	// smallest limit that does not revert.
+	Gas uint64 `yaml:"gas"`
+	// Revert expects the call to fail with the given custom error name or
+	// revert reason. "*" accepts any revert.
+	Revert string `yaml:"revert"`
+
+	// Events must be emitted by the transaction in this order, other logs
+	// may come in between. Only the listed args are compared.
+	Events []Event `yaml:"events"`
+	Views  []View  `yaml:"views"`
+}
+
+// Event is an expected log.
+type Event struct {
+	Contract string                 `yaml:"contract"`
+	Name     string                 `yaml:"name"`
+	Args     map[string]interface{} `yaml:"args"`
+}
+
+// View is an expected result of a constant call. Want is a single value
+// for methods with one output and a list otherwise. Tuples are maps keyed
+// by component name and may list only some of the components.
+type View struct {
+	Contract string        `yaml:"contract"`
+	Call     string        `yaml:"call"`
+	Args     []interface{} `yaml:"args"`
+	Want     interface{}   `yaml:"want"`
+}
+
+// Parse decodes a scenario. JSON is accepted as well since it is a subset
+// of YAML.
+func Parse(data []byte) (*Scenario, error) {
+	var s Scenario
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, errors.Wrap(err, "could not parse scenario")
+	}
+	for i, step := range s.Steps {
+		if step.Advance == "" && step.Call == "" && len(step.Views) == 0 {
+			return nil, errors.Errorf("step %d is empty", i)
+		}
+		if step.Call == "" && (len(step.Events) > 0 || step.Revert != "") {
+			return nil, errors.Errorf("step %d expects a transaction outcome without a call", i)
+		}
+		if step.Advance != "" {
+			if _, err := parse.Duration(step.Advance); err != nil {
+				return nil, errors.Wrapf(err, "step %d", i)
+			}
+		}
+	}
+	return &s, nil
+}
+
+// Load reads and parses a scenario file. The file name is used when the
+// scenario has no name.
+func Load(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := Parse(data)
+	if err != nil {
+		return nil, errors.Wrap(err, path)
+	}
+	if s.Name == "" {
+		s.Name = path
+	}
+	return s, nil
+}
@@ -0,0 +1,76 @@
+package scenario
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+func newRunner(t *testing.T) *Runner {
+	t.Helper()
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	r, err := NewRunner(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestScenarios(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			s, err := Load(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report := newRunner(t).Run(s); report.Failed() {
+				t.Fatal(report)
+			}
+		})
+	}
+}
+
+func TestReportDiffs(t *testing.T) {
+	s, err := Parse([]byte(`{
+		"steps": [
+			{"call": "stake", "value": "1 ether",
+			 "events": [{"name": "Staked", "args": {"amount": "2 ether"}}, {"name": "StakeBonus"}],
+			 "views": [{"call": "getTotalPendingUnstakes", "want": 1}]},
+			{"call": "claimUnstake", "args": ["user0"]}
+		]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := newRunner(t).Run(s)
+	if !report.Failed() || len(report.Steps) != 1 {
+		t.Fatalf("expected the first step to fail:\n%s", report)
+	}
+	want := []string{
+		"events[0] Staked.amount: want 2000000000000000000, got 1000000000000000000",
+		"events[1] StakeBonus: want emitted, got not emitted",
+		"RestakingPool.getTotalPendingUnstakes(): want 1, got 0",
+	}
+	diffs := report.Steps[0].Diffs
+	if len(diffs) != len(want) {
+		t.Fatalf("got diffs %v", diffs)
+	}
+	for i, d := range diffs {
+		if d.String() != want[i] {
+			t.Errorf("diff %d: %q, want %q", i, d, want[i])
+		}
+	}
+	if !strings.Contains(report.String(), "1 step(s) skipped") {
+		t.Errorf("report does not mention skipped steps:\n%s", report)
+	}
+}
@@ -0,0 +1,137 @@
+package scenario
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+)
+
+var reflectHashType = reflect.TypeOf(common.Hash{})
+
+// normalize turns a decoded ABI value into strings, lists and maps so that
+// expected and actual values can be compared and printed uniformly.
+func normalize(v interface{}, typ abi.Type) interface{} {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	switch typ.T {
+	case abi.AddressTy:
+		return rv.Interface().(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(rv.Bytes())
+	case abi.FixedBytesTy, abi.HashTy:
+		b := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(b), rv)
+		return hexutil.Encode(b)
+	case abi.SliceTy, abi.ArrayTy:
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			out[i] = normalize(rv.Index(i).Interface(), *typ.Elem)
+		}
+		return out
+	case abi.TupleTy:
+		out := make(map[string]interface{}, len(typ.TupleRawNames))
+		for i, name := range typ.TupleRawNames {
+			out[name] = normalize(rv.Field(i).Interface(), *typ.TupleElems[i])
+		}
+		return out
+	}
+	return fmt.Sprint(v)
+}
+
+// expected normalizes a YAML expectation for typ. Unlike parse.Arg, tuples
+// may list only some of their components.
+func expected(v interface{}, typ abi.Type, resolve parse.Resolver) (interface{}, error) {
+	switch typ.T {
+	case abi.SliceTy, abi.ArrayTy:
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("expected a list for %s", typ)
+		}
+		out := make([]interface{}, len(list))
+		for i, elem := range list {
+			e, err := expected(elem, *typ.Elem, resolve)
+			if err != nil {
+				return nil, errors.Wrapf(err, "[%d]", i)
+			}
+			out[i] = e
+		}
+		return out, nil
+	case abi.TupleTy:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, errors.Errorf("expected a map for %s", typ)
+		}
+		out := make(map[string]interface{}, len(m))
+		for name, field := range m {
+			i := indexOf(typ.TupleRawNames, name)
+			if i < 0 {
+				return nil, errors.Errorf("%s has no component %s", typ, name)
+			}
+			e, err := expected(field, *typ.TupleElems[i], resolve)
+			if err != nil {
+				return nil, errors.Wrap(err, name)
+			}
+			out[name] = e
+		}
+		return out, nil
+	}
+	c, err := parse.Arg(v, typ, resolve)
+	if err != nil {
+		return nil, err
+	}
+	return normalize(c, typ), nil
+}
+
+// compare reports the differences between a normalized expectation and a
+// normalized actual value. Maps in want are compared on their keys only.
+func compare(path string, want, got interface{}) []Diff {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return []Diff{{Path: path, Want: want, Got: got}}
+		}
+		var diffs []Diff
+		for _, k := range sortedKeys(w) {
+			diffs = append(diffs, compare(path+"."+k, w[k], g[k])...)
+		}
+		return diffs
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return []Diff{{Path: path, Want: want, Got: got}}
+		}
+		var diffs []Diff
+		for i := range w {
+			diffs = append(diffs, compare(fmt.Sprintf("%s[%d]", path, i), w[i], g[i])...)
+		}
+		return diffs
+	}
+	if want != got {
+		return []Diff{{Path: path, Want: want, Got: got}}
+	}
+	return nil
+}
+
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// reflectField returns the named field of a decoded event struct.
+func reflectField(v interface{}, name string) reflect.Value {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return rv.FieldByName(name)
+}
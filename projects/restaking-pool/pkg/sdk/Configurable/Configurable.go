@@ -0,0 +1,377 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Configurable
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyGovernanceAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyOperatorAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyRestakingPoolAllowed\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) Config(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "config")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+type ContractInitializedIterator struct {
+	Event *ContractInitialized
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractInitializedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractInitialized struct {
+	Version uint64
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractInitializedIterator{contract: _Contract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractInitialized)
+				if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseInitialized(log types.Log) (*ContractInitialized, error) {
+	event := new(ContractInitialized)
+	if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["Initialized"].ID:
+		return _Contract.ParseInitialized(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractInitialized) Topic() common.Hash {
+	return common.HexToHash("0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractInvalidInitializationError struct{}
+
+func (e *ContractInvalidInitializationError) Error() string {
+	return "InvalidInitialization()"
+}
+
+type ContractNotInitializingError struct{}
+
+func (e *ContractNotInitializingError) Error() string {
+	return "NotInitializing()"
+}
+
+type ContractOnlyGovernanceAllowedError struct{}
+
+func (e *ContractOnlyGovernanceAllowedError) Error() string {
+	return "OnlyGovernanceAllowed()"
+}
+
+type ContractOnlyOperatorAllowedError struct{}
+
+func (e *ContractOnlyOperatorAllowedError) Error() string {
+	return "OnlyOperatorAllowed()"
+}
+
+type ContractOnlyRestakingPoolAllowedError struct{}
+
+func (e *ContractOnlyRestakingPoolAllowedError) Error() string {
+	return "OnlyRestakingPoolAllowed()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "InvalidInitialization":
+		return &ContractInvalidInitializationError{}, true
+	case "NotInitializing":
+		return &ContractNotInitializingError{}, true
+	case "OnlyGovernanceAllowed":
+		return &ContractOnlyGovernanceAllowedError{}, true
+	case "OnlyOperatorAllowed":
+		return &ContractOnlyOperatorAllowedError{}, true
+	case "OnlyRestakingPoolAllowed":
+		return &ContractOnlyRestakingPoolAllowedError{}, true
+	default:
+		return e, true
+	}
+}
+
+type ContractInterface interface {
+	Config(opts *bind.CallOpts) (common.Address, error)
+
+	FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error)
+
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error)
+
+	ParseInitialized(log types.Log) (*ContractInitialized, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+}
@@ -0,0 +1,1023 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package FeeCollector
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"CommissionNotInRange\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"}],\"name\":\"FeeCollectorTransferFailed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyGovernanceAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyOperatorAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyRestakingPoolAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"prevValue\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"newValue\",\"type\":\"uint16\"}],\"name\":\"CommissionChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Received\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"pool\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rewards\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"}],\"name\":\"Withdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_COMMISSION\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"commission\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"rewards\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"config\",\"type\":\"address\"},{\"internalType\":\"uint16\",\"name\":\"commission_\",\"type\":\"uint16\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint16\",\"name\":\"newValue\",\"type\":\"uint16\"}],\"name\":\"setCommission\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5061001961001e565b6100d0565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff161561006e5760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146100cd5780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b61090c806100df6000396000f3fe6080604052600436106100745760003560e01c8063ae97dde81161004e578063ae97dde81461011c578063c594cc6514610145578063e148919114610165578063f39e69a41461018057600080fd5b80630572b0cc146100b55780633ccfd60b146100dd57806379502c55146100f457600080fd5b366100b05760405134815233907f88a5966d370b9919b20f3e2c13ff65706f196a4e32cc2c12bf57088f885258749060200160405180910390a2005b600080fd5b3480156100c157600080fd5b506100ca6101a0565b6040519081526020015b60405180910390f35b3480156100e957600080fd5b506100f26101b1565b005b34801561010057600080fd5b506000546040516001600160a01b0390911681526020016100d4565b34801561012857600080fd5b5061013261271081565b60405161ffff90911681526020016100d4565b34801561015157600080fd5b506100f26101603660046107eb565b61044a565b34801561017157600080fd5b506032546101329061ffff1681565b34801561018c57600080fd5b506100f261019b366004610822565b6104fc565b60006101ab4761061e565b92915050565b6101b9610655565b47612710811061041e576000806101cf8361061e565b9150915060006101e76000546001600160a01b031690565b6001600160a01b0316637745165b6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610224573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906102489190610857565b9050600061025e6000546001600160a01b031690565b6001600160a01b0316633b19e84a6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561029b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906102bf9190610857565b90506000826001600160a01b03168460405160006040518083038185875af1925050503d806000811461030e576040519150601f19603f3d011682016040523d82523d6000602084013e610313565b606091505b50509050806103455760405163035106a760e31b81526001600160a01b03841660048201526024015b60405180910390fd5b6040516001600160a01b038316908690600081818185875af1925050503d806000811461038e576040519150601f19603f3d011682016040523d82523d6000602084013e610393565b606091505b505080915050806103c25760405163035106a760e31b81526001600160a01b038316600482015260240161033c565b816001600160a01b0316836001600160a01b03167f91fb9d98b786c57d74c099ccd2beca1739e9f6a81fb49001ca465c4b7591bbe28688604051610410929190918252602082015260400190565b60405180910390a350505050505b5061044860017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561049b573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104bf9190610857565b6001600160a01b0316336001600160a01b0316146104f05760405163e2d4f15f60e01b815260040160405180910390fd5b6104f9816106c5565b50565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff16159067ffffffffffffffff166000811580156105425750825b905060008267ffffffffffffffff16600114801561055f5750303b155b90508115801561056d575080155b1561058b5760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff1916600117855583156105b557845460ff60401b1916600160401b1785555b6105bd610745565b6105c687610755565b6105cf8661077f565b831561061557845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50505050505050565b6032546000908190612710906106389061ffff168561088a565b61064291906108a1565b915061064e82846108c3565b9050915091565b7f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0080546001190161069957604051633ee5aeb560e01b815260040160405180910390fd5b60029055565b60017f9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f0055565b61271061ffff8216106106eb57604051631994455560e11b815260040160405180910390fd5b6032546040805161ffff928316815291831660208301527f07d1ecb0b6fd1c556347c57a4035549a933093e3e5a0a63418dd3589c82749de910160405180910390a16032805461ffff191661ffff92909216919091179055565b61074d610783565b6104486107cc565b61075d610783565b600080546001600160a01b0319166001600160a01b0392909216919091179055565b6104f05b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff1661044857604051631afcd79f60e31b815260040160405180910390fd5b61069f610783565b803561ffff811681146107e657600080fd5b919050565b6000602082840312156107fd57600080fd5b610806826107d4565b9392505050565b6001600160a01b03811681146104f957600080fd5b6000806040838503121561083557600080fd5b82356108408161080d565b915061084e602084016107d4565b90509250929050565b60006020828403121561086957600080fd5b81516108068161080d565b634e487b7160e01b600052601160045260246000fd5b80820281158282048414176101ab576101ab610874565b6000826108be57634e487b7160e01b600052601260045260246000fd5b500490565b818103818111156101ab576101ab61087456fea26469706673582212202b7893fe929b232c214b242ffd3775fef369824d4dc187b9daaf03bc6cebb61364736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) MAXCOMMISSION(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "MAX_COMMISSION")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MAXCOMMISSION() (uint16, error) {
+	return _Contract.Contract.MAXCOMMISSION(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MAXCOMMISSION() (uint16, error) {
+	return _Contract.Contract.MAXCOMMISSION(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Commission(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "commission")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Commission() (uint16, error) {
+	return _Contract.Contract.Commission(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Commission() (uint16, error) {
+	return _Contract.Contract.Commission(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Config(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "config")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetRewards(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getRewards")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetRewards() (*big.Int, error) {
+	return _Contract.Contract.GetRewards(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetRewards() (*big.Int, error) {
+	return _Contract.Contract.GetRewards(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, config common.Address, commission_ uint16) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "initialize", config, commission_)
+}
+
+func (_Contract *ContractSession) Initialize(config common.Address, commission_ uint16) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, config, commission_)
+}
+
+func (_Contract *ContractTransactorSession) Initialize(config common.Address, commission_ uint16) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, config, commission_)
+}
+
+func (_Contract *ContractTransactor) SetCommission(opts *bind.TransactOpts, newValue uint16) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setCommission", newValue)
+}
+
+func (_Contract *ContractSession) SetCommission(newValue uint16) (*types.Transaction, error) {
+	return _Contract.Contract.SetCommission(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactorSession) SetCommission(newValue uint16) (*types.Transaction, error) {
+	return _Contract.Contract.SetCommission(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactor) Withdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "withdraw")
+}
+
+func (_Contract *ContractSession) Withdraw() (*types.Transaction, error) {
+	return _Contract.Contract.Withdraw(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Withdraw() (*types.Transaction, error) {
+	return _Contract.Contract.Withdraw(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.RawTransact(opts, nil)
+}
+
+func (_Contract *ContractSession) Receive() (*types.Transaction, error) {
+	return _Contract.Contract.Receive(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Receive() (*types.Transaction, error) {
+	return _Contract.Contract.Receive(&_Contract.TransactOpts)
+}
+
+type ContractCommissionChangedIterator struct {
+	Event *ContractCommissionChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractCommissionChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractCommissionChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractCommissionChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractCommissionChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractCommissionChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractCommissionChanged struct {
+	PrevValue uint16
+	NewValue  uint16
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterCommissionChanged(opts *bind.FilterOpts) (*ContractCommissionChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "CommissionChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCommissionChangedIterator{contract: _Contract.contract, event: "CommissionChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchCommissionChanged(opts *bind.WatchOpts, sink chan<- *ContractCommissionChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "CommissionChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractCommissionChanged)
+				if err := _Contract.contract.UnpackLog(event, "CommissionChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseCommissionChanged(log types.Log) (*ContractCommissionChanged, error) {
+	event := new(ContractCommissionChanged)
+	if err := _Contract.contract.UnpackLog(event, "CommissionChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractInitializedIterator struct {
+	Event *ContractInitialized
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractInitializedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractInitialized struct {
+	Version uint64
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractInitializedIterator{contract: _Contract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractInitialized)
+				if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseInitialized(log types.Log) (*ContractInitialized, error) {
+	event := new(ContractInitialized)
+	if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractReceivedIterator struct {
+	Event *ContractReceived
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractReceivedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractReceivedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractReceived struct {
+	Sender common.Address
+	Amount *big.Int
+	Raw    types.Log
+}
+
+func (_Contract *ContractFilterer) FilterReceived(opts *bind.FilterOpts, sender []common.Address) (*ContractReceivedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Received", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractReceivedIterator{contract: _Contract.contract, event: "Received", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchReceived(opts *bind.WatchOpts, sink chan<- *ContractReceived, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Received", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractReceived)
+				if err := _Contract.contract.UnpackLog(event, "Received", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseReceived(log types.Log) (*ContractReceived, error) {
+	event := new(ContractReceived)
+	if err := _Contract.contract.UnpackLog(event, "Received", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractWithdrawnIterator struct {
+	Event *ContractWithdrawn
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractWithdrawnIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractWithdrawn struct {
+	Pool     common.Address
+	Treasury common.Address
+	Rewards  *big.Int
+	Fee      *big.Int
+	Raw      types.Log
+}
+
+func (_Contract *ContractFilterer) FilterWithdrawn(opts *bind.FilterOpts, pool []common.Address, treasury []common.Address) (*ContractWithdrawnIterator, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+	var treasuryRule []interface{}
+	for _, treasuryItem := range treasury {
+		treasuryRule = append(treasuryRule, treasuryItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Withdrawn", poolRule, treasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractWithdrawnIterator{contract: _Contract.contract, event: "Withdrawn", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchWithdrawn(opts *bind.WatchOpts, sink chan<- *ContractWithdrawn, pool []common.Address, treasury []common.Address) (event.Subscription, error) {
+
+	var poolRule []interface{}
+	for _, poolItem := range pool {
+		poolRule = append(poolRule, poolItem)
+	}
+	var treasuryRule []interface{}
+	for _, treasuryItem := range treasury {
+		treasuryRule = append(treasuryRule, treasuryItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Withdrawn", poolRule, treasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractWithdrawn)
+				if err := _Contract.contract.UnpackLog(event, "Withdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseWithdrawn(log types.Log) (*ContractWithdrawn, error) {
+	event := new(ContractWithdrawn)
+	if err := _Contract.contract.UnpackLog(event, "Withdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["CommissionChanged"].ID:
+		return _Contract.ParseCommissionChanged(log)
+	case _Contract.abi.Events["Initialized"].ID:
+		return _Contract.ParseInitialized(log)
+	case _Contract.abi.Events["Received"].ID:
+		return _Contract.ParseReceived(log)
+	case _Contract.abi.Events["Withdrawn"].ID:
+		return _Contract.ParseWithdrawn(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractCommissionChanged) Topic() common.Hash {
+	return common.HexToHash("0x07d1ecb0b6fd1c556347c57a4035549a933093e3e5a0a63418dd3589c82749de")
+}
+
+func (ContractInitialized) Topic() common.Hash {
+	return common.HexToHash("0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2")
+}
+
+func (ContractReceived) Topic() common.Hash {
+	return common.HexToHash("0x88a5966d370b9919b20f3e2c13ff65706f196a4e32cc2c12bf57088f88525874")
+}
+
+func (ContractWithdrawn) Topic() common.Hash {
+	return common.HexToHash("0x91fb9d98b786c57d74c099ccd2beca1739e9f6a81fb49001ca465c4b7591bbe2")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractCommissionNotInRangeError struct{}
+
+func (e *ContractCommissionNotInRangeError) Error() string {
+	return "CommissionNotInRange()"
+}
+
+type ContractFeeCollectorTransferFailedError struct {
+	To common.Address
+}
+
+func (e *ContractFeeCollectorTransferFailedError) Error() string {
+	return fmt.Sprintf("FeeCollectorTransferFailed(%v)", e.To)
+}
+
+type ContractInvalidInitializationError struct{}
+
+func (e *ContractInvalidInitializationError) Error() string {
+	return "InvalidInitialization()"
+}
+
+type ContractNotInitializingError struct{}
+
+func (e *ContractNotInitializingError) Error() string {
+	return "NotInitializing()"
+}
+
+type ContractOnlyGovernanceAllowedError struct{}
+
+func (e *ContractOnlyGovernanceAllowedError) Error() string {
+	return "OnlyGovernanceAllowed()"
+}
+
+type ContractOnlyOperatorAllowedError struct{}
+
+func (e *ContractOnlyOperatorAllowedError) Error() string {
+	return "OnlyOperatorAllowed()"
+}
+
+type ContractOnlyRestakingPoolAllowedError struct{}
+
+func (e *ContractOnlyRestakingPoolAllowedError) Error() string {
+	return "OnlyRestakingPoolAllowed()"
+}
+
+type ContractReentrancyGuardReentrantCallError struct{}
+
+func (e *ContractReentrancyGuardReentrantCallError) Error() string {
+	return "ReentrancyGuardReentrantCall()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "CommissionNotInRange":
+		return &ContractCommissionNotInRangeError{}, true
+	case "FeeCollectorTransferFailed":
+		return &ContractFeeCollectorTransferFailedError{To: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "InvalidInitialization":
+		return &ContractInvalidInitializationError{}, true
+	case "NotInitializing":
+		return &ContractNotInitializingError{}, true
+	case "OnlyGovernanceAllowed":
+		return &ContractOnlyGovernanceAllowedError{}, true
+	case "OnlyOperatorAllowed":
+		return &ContractOnlyOperatorAllowedError{}, true
+	case "OnlyRestakingPoolAllowed":
+		return &ContractOnlyRestakingPoolAllowedError{}, true
+	case "ReentrancyGuardReentrantCall":
+		return &ContractReentrancyGuardReentrantCallError{}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, config common.Address, commission_ uint16) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Initialize(opts, config, commission_)
+	})
+}
+
+func (_Contract *Contract) SetCommissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue uint16) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetCommission(opts, newValue)
+	})
+}
+
+func (_Contract *Contract) WithdrawAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Withdraw(opts)
+	})
+}
+
+func (_Contract *Contract) ReceiveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Receive(opts)
+	})
+}
+
+func (_Contract *Contract) SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, config common.Address, commission_ uint16) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "initialize", config, commission_)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetCommission(sim *generated.Simulator, opts *generated.SimulateOpts, newValue uint16) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setCommission", newValue)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateWithdraw(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "withdraw")
+	return gas, err
+}
+
+type ContractInterface interface {
+	MAXCOMMISSION(opts *bind.CallOpts) (uint16, error)
+
+	Commission(opts *bind.CallOpts) (uint16, error)
+
+	Config(opts *bind.CallOpts) (common.Address, error)
+
+	GetRewards(opts *bind.CallOpts) (*big.Int, error)
+
+	Initialize(opts *bind.TransactOpts, config common.Address, commission_ uint16) (*types.Transaction, error)
+
+	SetCommission(opts *bind.TransactOpts, newValue uint16) (*types.Transaction, error)
+
+	Withdraw(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	Receive(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	FilterCommissionChanged(opts *bind.FilterOpts) (*ContractCommissionChangedIterator, error)
+
+	WatchCommissionChanged(opts *bind.WatchOpts, sink chan<- *ContractCommissionChanged) (event.Subscription, error)
+
+	ParseCommissionChanged(log types.Log) (*ContractCommissionChanged, error)
+
+	FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error)
+
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error)
+
+	ParseInitialized(log types.Log) (*ContractInitialized, error)
+
+	FilterReceived(opts *bind.FilterOpts, sender []common.Address) (*ContractReceivedIterator, error)
+
+	WatchReceived(opts *bind.WatchOpts, sink chan<- *ContractReceived, sender []common.Address) (event.Subscription, error)
+
+	ParseReceived(log types.Log) (*ContractReceived, error)
+
+	FilterWithdrawn(opts *bind.FilterOpts, pool []common.Address, treasury []common.Address) (*ContractWithdrawnIterator, error)
+
+	WatchWithdrawn(opts *bind.WatchOpts, sink chan<- *ContractWithdrawn, pool []common.Address, treasury []common.Address) (event.Subscription, error)
+
+	ParseWithdrawn(log types.Log) (*ContractWithdrawn, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, config common.Address, commission_ uint16) (*generated.Result, error)
+
+	SetCommissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue uint16) (*generated.Result, error)
+
+	WithdrawAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	ReceiveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, config common.Address, commission_ uint16) (uint64, error)
+
+	SimulateSetCommission(sim *generated.Simulator, opts *generated.SimulateOpts, newValue uint16) (uint64, error)
+
+	SimulateWithdraw(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+}
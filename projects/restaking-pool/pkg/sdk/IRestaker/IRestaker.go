@@ -0,0 +1,567 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package IRestaker
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"RestakerCannotClaim\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Claimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"rewardCoordinator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newRewardCoordinator\",\"type\":\"address\"}],\"name\":\"RewardsCoordinatorChanged\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"__claim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newRewardsCoordinator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"__setRewardsCoordinator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"contractIRestakerFacets\",\"name\":\"facets\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractTransactor) Claim(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "__claim")
+}
+
+func (_Contract *ContractSession) Claim() (*types.Transaction, error) {
+	return _Contract.Contract.Claim(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Claim() (*types.Transaction, error) {
+	return _Contract.Contract.Claim(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) SetRewardsCoordinator(opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "__setRewardsCoordinator", newRewardsCoordinator, claimer)
+}
+
+func (_Contract *ContractSession) SetRewardsCoordinator(newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsCoordinator(&_Contract.TransactOpts, newRewardsCoordinator, claimer)
+}
+
+func (_Contract *ContractTransactorSession) SetRewardsCoordinator(newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsCoordinator(&_Contract.TransactOpts, newRewardsCoordinator, claimer)
+}
+
+func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, owner common.Address, facets common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "initialize", owner, facets)
+}
+
+func (_Contract *ContractSession) Initialize(owner common.Address, facets common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, owner, facets)
+}
+
+func (_Contract *ContractTransactorSession) Initialize(owner common.Address, facets common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, owner, facets)
+}
+
+type ContractClaimedIterator struct {
+	Event *ContractClaimed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractClaimedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractClaimedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractClaimed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterClaimed(opts *bind.FilterOpts, recipient []common.Address) (*ContractClaimedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Claimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractClaimedIterator{contract: _Contract.contract, event: "Claimed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *ContractClaimed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Claimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractClaimed)
+				if err := _Contract.contract.UnpackLog(event, "Claimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseClaimed(log types.Log) (*ContractClaimed, error) {
+	event := new(ContractClaimed)
+	if err := _Contract.contract.UnpackLog(event, "Claimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsCoordinatorChangedIterator struct {
+	Event *ContractRewardsCoordinatorChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsCoordinatorChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsCoordinatorChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsCoordinatorChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsCoordinatorChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsCoordinatorChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsCoordinatorChanged struct {
+	RewardCoordinator    common.Address
+	NewRewardCoordinator common.Address
+	Raw                  types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsCoordinatorChanged(opts *bind.FilterOpts, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (*ContractRewardsCoordinatorChangedIterator, error) {
+
+	var rewardCoordinatorRule []interface{}
+	for _, rewardCoordinatorItem := range rewardCoordinator {
+		rewardCoordinatorRule = append(rewardCoordinatorRule, rewardCoordinatorItem)
+	}
+	var newRewardCoordinatorRule []interface{}
+	for _, newRewardCoordinatorItem := range newRewardCoordinator {
+		newRewardCoordinatorRule = append(newRewardCoordinatorRule, newRewardCoordinatorItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsCoordinatorChanged", rewardCoordinatorRule, newRewardCoordinatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsCoordinatorChangedIterator{contract: _Contract.contract, event: "RewardsCoordinatorChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsCoordinatorChanged(opts *bind.WatchOpts, sink chan<- *ContractRewardsCoordinatorChanged, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (event.Subscription, error) {
+
+	var rewardCoordinatorRule []interface{}
+	for _, rewardCoordinatorItem := range rewardCoordinator {
+		rewardCoordinatorRule = append(rewardCoordinatorRule, rewardCoordinatorItem)
+	}
+	var newRewardCoordinatorRule []interface{}
+	for _, newRewardCoordinatorItem := range newRewardCoordinator {
+		newRewardCoordinatorRule = append(newRewardCoordinatorRule, newRewardCoordinatorItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsCoordinatorChanged", rewardCoordinatorRule, newRewardCoordinatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsCoordinatorChanged)
+				if err := _Contract.contract.UnpackLog(event, "RewardsCoordinatorChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsCoordinatorChanged(log types.Log) (*ContractRewardsCoordinatorChanged, error) {
+	event := new(ContractRewardsCoordinatorChanged)
+	if err := _Contract.contract.UnpackLog(event, "RewardsCoordinatorChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["Claimed"].ID:
+		return _Contract.ParseClaimed(log)
+	case _Contract.abi.Events["RewardsCoordinatorChanged"].ID:
+		return _Contract.ParseRewardsCoordinatorChanged(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractClaimed) Topic() common.Hash {
+	return common.HexToHash("0xd8138f8a3f377c5259ca548e70e4c2de94f129f5a11036a15b69513cba2b426a")
+}
+
+func (ContractRewardsCoordinatorChanged) Topic() common.Hash {
+	return common.HexToHash("0x27384265e97ccc2d74f200040aec40808816c693dda1e0e9e1a932f415716fcd")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractRestakerCannotClaimError struct{}
+
+func (e *ContractRestakerCannotClaimError) Error() string {
+	return "RestakerCannotClaim()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "RestakerCannotClaim":
+		return &ContractRestakerCannotClaimError{}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) ClaimAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Claim(opts)
+	})
+}
+
+func (_Contract *Contract) SetRewardsCoordinatorAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetRewardsCoordinator(opts, newRewardsCoordinator, claimer)
+	})
+}
+
+func (_Contract *Contract) InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, owner common.Address, facets common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Initialize(opts, owner, facets)
+	})
+}
+
+func (_Contract *Contract) SimulateClaim(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "__claim")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetRewardsCoordinator(sim *generated.Simulator, opts *generated.SimulateOpts, newRewardsCoordinator common.Address, claimer common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "__setRewardsCoordinator", newRewardsCoordinator, claimer)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, owner common.Address, facets common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "initialize", owner, facets)
+	return gas, err
+}
+
+type ContractInterface interface {
+	Claim(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	SetRewardsCoordinator(opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error)
+
+	Initialize(opts *bind.TransactOpts, owner common.Address, facets common.Address) (*types.Transaction, error)
+
+	FilterClaimed(opts *bind.FilterOpts, recipient []common.Address) (*ContractClaimedIterator, error)
+
+	WatchClaimed(opts *bind.WatchOpts, sink chan<- *ContractClaimed, recipient []common.Address) (event.Subscription, error)
+
+	ParseClaimed(log types.Log) (*ContractClaimed, error)
+
+	FilterRewardsCoordinatorChanged(opts *bind.FilterOpts, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (*ContractRewardsCoordinatorChangedIterator, error)
+
+	WatchRewardsCoordinatorChanged(opts *bind.WatchOpts, sink chan<- *ContractRewardsCoordinatorChanged, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (event.Subscription, error)
+
+	ParseRewardsCoordinatorChanged(log types.Log) (*ContractRewardsCoordinatorChanged, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	ClaimAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SetRewardsCoordinatorAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*generated.Result, error)
+
+	InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, owner common.Address, facets common.Address) (*generated.Result, error)
+
+	SimulateClaim(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateSetRewardsCoordinator(sim *generated.Simulator, opts *generated.SimulateOpts, newRewardsCoordinator common.Address, claimer common.Address) (uint64, error)
+
+	SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, owner common.Address, facets common.Address) (uint64, error)
+}
@@ -0,0 +1,2482 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package IRewardsCoordinator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+type IRewardsCoordinatorDistributionRoot struct {
+	Root                           [32]byte
+	RewardsCalculationEndTimestamp uint32
+	ActivatedAt                    uint32
+	Disabled                       bool
+}
+
+type IRewardsCoordinatorEarnerTreeMerkleLeaf struct {
+	Earner          common.Address
+	EarnerTokenRoot [32]byte
+}
+
+type IRewardsCoordinatorRewardsMerkleClaim struct {
+	RootIndex       uint32
+	EarnerIndex     uint32
+	EarnerTreeProof []byte
+	EarnerLeaf      IRewardsCoordinatorEarnerTreeMerkleLeaf
+	TokenIndices    []uint32
+	TokenTreeProofs [][]byte
+	TokenLeaves     []IRewardsCoordinatorTokenTreeMerkleLeaf
+}
+
+type IRewardsCoordinatorRewardsSubmission struct {
+	StrategiesAndMultipliers []IRewardsCoordinatorStrategyAndMultiplier
+	Token                    common.Address
+	Amount                   *big.Int
+	StartTimestamp           uint32
+	Duration                 uint32
+}
+
+type IRewardsCoordinatorStrategyAndMultiplier struct {
+	Strategy   common.Address
+	Multiplier *big.Int
+}
+
+type IRewardsCoordinatorTokenTreeMerkleLeaf struct {
+	Token              common.Address
+	CumulativeEarnings *big.Int
+}
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"avs\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"submissionNonce\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"rewardsSubmissionHash\",\"type\":\"bytes32\"},{\"components\":[{\"components\":[{\"internalType\":\"contractIStrategy\",\"name\":\"strategy\",\"type\":\"address\"},{\"internalType\":\"uint96\",\"name\":\"multiplier\",\"type\":\"uint96\"}],\"internalType\":\"structIRewardsCoordinator.StrategyAndMultiplier[]\",\"name\":\"strategiesAndMultipliers\",\"type\":\"tuple[]\"},{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"startTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"duration\",\"type\":\"uint32\"}],\"indexed\":false,\"internalType\":\"structIRewardsCoordinator.RewardsSubmission\",\"name\":\"rewardsSubmission\",\"type\":\"tuple\"}],\"name\":\"AVSRewardsSubmissionCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"oldActivationDelay\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"newActivationDelay\",\"type\":\"uint32\"}],\"name\":\"ActivationDelaySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"earner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldClaimer\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"ClaimerForSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint32\",\"name\":\"rootIndex\",\"type\":\"uint32\"}],\"name\":\"DistributionRootDisabled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint32\",\"name\":\"rootIndex\",\"type\":\"uint32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"uint32\",\"name\":\"rewardsCalculationEndTimestamp\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"activatedAt\",\"type\":\"uint32\"}],\"name\":\"DistributionRootSubmitted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"oldGlobalCommissionBips\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"newGlobalCommissionBips\",\"type\":\"uint16\"}],\"name\":\"GlobalCommissionBipsSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"earner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"claimedAmount\",\"type\":\"uint256\"}],\"name\":\"RewardsClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"rewardsForAllSubmitter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bool\",\"name\":\"oldValue\",\"type\":\"bool\"},{\"indexed\":true,\"internalType\":\"bool\",\"name\":\"newValue\",\"type\":\"bool\"}],\"name\":\"RewardsForAllSubmitterSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"submitter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"submissionNonce\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"rewardsSubmissionHash\",\"type\":\"bytes32\"},{\"components\":[{\"components\":[{\"internalType\":\"contractIStrategy\",\"name\":\"strategy\",\"type\":\"address\"},{\"internalType\":\"uint96\",\"name\":\"multiplier\",\"type\":\"uint96\"}],\"internalType\":\"structIRewardsCoordinator.StrategyAndMultiplier[]\",\"name\":\"strategiesAndMultipliers\",\"type\":\"tuple[]\"},{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"startTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"duration\",\"type\":\"uint32\"}],\"indexed\":false,\"internalType\":\"structIRewardsCoordinator.RewardsSubmission\",\"name\":\"rewardsSubmission\",\"type\":\"tuple\"}],\"name\":\"RewardsSubmissionForAllCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldRewardsUpdater\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newRewardsUpdater\",\"type\":\"address\"}],\"name\":\"RewardsUpdaterSet\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"CALCULATION_INTERVAL_SECONDS\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GENESIS_REWARDS_TIMESTAMP\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_FUTURE_LENGTH\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_RETROACTIVE_LENGTH\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_REWARDS_DURATION\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"activationDelay\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"earner\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"earnerTokenRoot\",\"type\":\"bytes32\"}],\"internalType\":\"structIRewardsCoordinator.EarnerTreeMerkleLeaf\",\"name\":\"leaf\",\"type\":\"tuple\"}],\"name\":\"calculateEarnerLeafHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"cumulativeEarnings\",\"type\":\"uint256\"}],\"internalType\":\"structIRewardsCoordinator.TokenTreeMerkleLeaf\",\"name\":\"leaf\",\"type\":\"tuple\"}],\"name\":\"calculateTokenLeafHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"rootIndex\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"earnerIndex\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"earnerTreeProof\",\"type\":\"bytes\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"earner\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"earnerTokenRoot\",\"type\":\"bytes32\"}],\"internalType\":\"structIRewardsCoordinator.EarnerTreeMerkleLeaf\",\"name\":\"earnerLeaf\",\"type\":\"tuple\"},{\"internalType\":\"uint32[]\",\"name\":\"tokenIndices\",\"type\":\"uint32[]\"},{\"internalType\":\"bytes[]\",\"name\":\"tokenTreeProofs\",\"type\":\"bytes[]\"},{\"components\":[{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"cumulativeEarnings\",\"type\":\"uint256\"}],\"internalType\":\"structIRewardsCoordinator.TokenTreeMerkleLeaf[]\",\"name\":\"tokenLeaves\",\"type\":\"tuple[]\"}],\"internalType\":\"structIRewardsCoordinator.RewardsMerkleClaim\",\"name\":\"claim\",\"type\":\"tuple\"}],\"name\":\"checkClaim\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"earner\",\"type\":\"address\"}],\"name\":\"claimerFor\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"components\":[{\"internalType\":\"contractIStrategy\",\"name\":\"strategy\",\"type\":\"address\"},{\"internalType\":\"uint96\",\"name\":\"multiplier\",\"type\":\"uint96\"}],\"internalType\":\"structIRewardsCoordinator.StrategyAndMultiplier[]\",\"name\":\"strategiesAndMultipliers\",\"type\":\"tuple[]\"},{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"startTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"duration\",\"type\":\"uint32\"}],\"internalType\":\"structIRewardsCoordinator.RewardsSubmission[]\",\"name\":\"rewardsSubmissions\",\"type\":\"tuple[]\"}],\"name\":\"createAVSRewardsSubmission\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"components\":[{\"internalType\":\"contractIStrategy\",\"name\":\"strategy\",\"type\":\"address\"},{\"internalType\":\"uint96\",\"name\":\"multiplier\",\"type\":\"uint96\"}],\"internalType\":\"structIRewardsCoordinator.StrategyAndMultiplier[]\",\"name\":\"strategiesAndMultipliers\",\"type\":\"tuple[]\"},{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"startTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"duration\",\"type\":\"uint32\"}],\"internalType\":\"structIRewardsCoordinator.RewardsSubmission[]\",\"name\":\"rewardsSubmission\",\"type\":\"tuple[]\"}],\"name\":\"createRewardsForAllSubmission\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"},{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"cumulativeClaimed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currRewardsCalculationEndTimestamp\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"rootIndex\",\"type\":\"uint32\"}],\"name\":\"disableRoot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentClaimableDistributionRoot\",\"outputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"},{\"internalType\":\"uint32\",\"name\":\"rewardsCalculationEndTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"activatedAt\",\"type\":\"uint32\"},{\"internalType\":\"bool\",\"name\":\"disabled\",\"type\":\"bool\"}],\"internalType\":\"structIRewardsCoordinator.DistributionRoot\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentDistributionRoot\",\"outputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"},{\"internalType\":\"uint32\",\"name\":\"rewardsCalculationEndTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"activatedAt\",\"type\":\"uint32\"},{\"internalType\":\"bool\",\"name\":\"disabled\",\"type\":\"bool\"}],\"internalType\":\"structIRewardsCoordinator.DistributionRoot\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"getDistributionRootAtIndex\",\"outputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"},{\"internalType\":\"uint32\",\"name\":\"rewardsCalculationEndTimestamp\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"activatedAt\",\"type\":\"uint32\"},{\"internalType\":\"bool\",\"name\":\"disabled\",\"type\":\"bool\"}],\"internalType\":\"structIRewardsCoordinator.DistributionRoot\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getDistributionRootsLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rootHash\",\"type\":\"bytes32\"}],\"name\":\"getRootIndexFromHash\",\"outputs\":[{\"internalType\":\"uint32\",\"name\":\"\",\"type\":\"uint32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"globalOperatorCommissionBips\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"avs\",\"type\":\"address\"}],\"name\":\"operatorCommissionBips\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint32\",\"name\":\"rootIndex\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"earnerIndex\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"earnerTreeProof\",\"type\":\"bytes\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"earner\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"earnerTokenRoot\",\"type\":\"bytes32\"}],\"internalType\":\"structIRewardsCoordinator.EarnerTreeMerkleLeaf\",\"name\":\"earnerLeaf\",\"type\":\"tuple\"},{\"internalType\":\"uint32[]\",\"name\":\"tokenIndices\",\"type\":\"uint32[]\"},{\"internalType\":\"bytes[]\",\"name\":\"tokenTreeProofs\",\"type\":\"bytes[]\"},{\"components\":[{\"internalType\":\"contractIERC20\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"cumulativeEarnings\",\"type\":\"uint256\"}],\"internalType\":\"structIRewardsCoordinator.TokenTreeMerkleLeaf[]\",\"name\":\"tokenLeaves\",\"type\":\"tuple[]\"}],\"internalType\":\"structIRewardsCoordinator.RewardsMerkleClaim\",\"name\":\"claim\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"processClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"rewardsUpdater\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"_activationDelay\",\"type\":\"uint32\"}],\"name\":\"setActivationDelay\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"setClaimerFor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint16\",\"name\":\"_globalCommissionBips\",\"type\":\"uint16\"}],\"name\":\"setGlobalOperatorCommission\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_submitter\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"_newValue\",\"type\":\"bool\"}],\"name\":\"setRewardsForAllSubmitter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_rewardsUpdater\",\"type\":\"address\"}],\"name\":\"setRewardsUpdater\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"},{\"internalType\":\"uint32\",\"name\":\"rewardsCalculationEndTimestamp\",\"type\":\"uint32\"}],\"name\":\"submitRoot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "CALCULATION_INTERVAL_SECONDS")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CALCULATIONINTERVALSECONDS() (uint32, error) {
+	return _Contract.Contract.CALCULATIONINTERVALSECONDS(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) CALCULATIONINTERVALSECONDS() (uint32, error) {
+	return _Contract.Contract.CALCULATIONINTERVALSECONDS(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "GENESIS_REWARDS_TIMESTAMP")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GENESISREWARDSTIMESTAMP() (uint32, error) {
+	return _Contract.Contract.GENESISREWARDSTIMESTAMP(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GENESISREWARDSTIMESTAMP() (uint32, error) {
+	return _Contract.Contract.GENESISREWARDSTIMESTAMP(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "MAX_FUTURE_LENGTH")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MAXFUTURELENGTH() (uint32, error) {
+	return _Contract.Contract.MAXFUTURELENGTH(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MAXFUTURELENGTH() (uint32, error) {
+	return _Contract.Contract.MAXFUTURELENGTH(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "MAX_RETROACTIVE_LENGTH")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MAXRETROACTIVELENGTH() (uint32, error) {
+	return _Contract.Contract.MAXRETROACTIVELENGTH(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MAXRETROACTIVELENGTH() (uint32, error) {
+	return _Contract.Contract.MAXRETROACTIVELENGTH(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "MAX_REWARDS_DURATION")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MAXREWARDSDURATION() (uint32, error) {
+	return _Contract.Contract.MAXREWARDSDURATION(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MAXREWARDSDURATION() (uint32, error) {
+	return _Contract.Contract.MAXREWARDSDURATION(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) ActivationDelay(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "activationDelay")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) ActivationDelay() (uint32, error) {
+	return _Contract.Contract.ActivationDelay(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) ActivationDelay() (uint32, error) {
+	return _Contract.Contract.ActivationDelay(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "calculateEarnerLeafHash", leaf)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CalculateEarnerLeafHash(leaf IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error) {
+	return _Contract.Contract.CalculateEarnerLeafHash(&_Contract.CallOpts, leaf)
+}
+
+func (_Contract *ContractCallerSession) CalculateEarnerLeafHash(leaf IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error) {
+	return _Contract.Contract.CalculateEarnerLeafHash(&_Contract.CallOpts, leaf)
+}
+
+func (_Contract *ContractCaller) CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "calculateTokenLeafHash", leaf)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CalculateTokenLeafHash(leaf IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error) {
+	return _Contract.Contract.CalculateTokenLeafHash(&_Contract.CallOpts, leaf)
+}
+
+func (_Contract *ContractCallerSession) CalculateTokenLeafHash(leaf IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error) {
+	return _Contract.Contract.CalculateTokenLeafHash(&_Contract.CallOpts, leaf)
+}
+
+func (_Contract *ContractCaller) CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorRewardsMerkleClaim) (bool, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "checkClaim", claim)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CheckClaim(claim IRewardsCoordinatorRewardsMerkleClaim) (bool, error) {
+	return _Contract.Contract.CheckClaim(&_Contract.CallOpts, claim)
+}
+
+func (_Contract *ContractCallerSession) CheckClaim(claim IRewardsCoordinatorRewardsMerkleClaim) (bool, error) {
+	return _Contract.Contract.CheckClaim(&_Contract.CallOpts, claim)
+}
+
+func (_Contract *ContractCaller) ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "claimerFor", earner)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) ClaimerFor(earner common.Address) (common.Address, error) {
+	return _Contract.Contract.ClaimerFor(&_Contract.CallOpts, earner)
+}
+
+func (_Contract *ContractCallerSession) ClaimerFor(earner common.Address) (common.Address, error) {
+	return _Contract.Contract.ClaimerFor(&_Contract.CallOpts, earner)
+}
+
+func (_Contract *ContractCaller) CumulativeClaimed(opts *bind.CallOpts, claimer common.Address, token common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "cumulativeClaimed", claimer, token)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CumulativeClaimed(claimer common.Address, token common.Address) (*big.Int, error) {
+	return _Contract.Contract.CumulativeClaimed(&_Contract.CallOpts, claimer, token)
+}
+
+func (_Contract *ContractCallerSession) CumulativeClaimed(claimer common.Address, token common.Address) (*big.Int, error) {
+	return _Contract.Contract.CumulativeClaimed(&_Contract.CallOpts, claimer, token)
+}
+
+func (_Contract *ContractCaller) CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "currRewardsCalculationEndTimestamp")
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CurrRewardsCalculationEndTimestamp() (uint32, error) {
+	return _Contract.Contract.CurrRewardsCalculationEndTimestamp(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) CurrRewardsCalculationEndTimestamp() (uint32, error) {
+	return _Contract.Contract.CurrRewardsCalculationEndTimestamp(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorDistributionRoot, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getCurrentClaimableDistributionRoot")
+
+	if err != nil {
+		return *new(IRewardsCoordinatorDistributionRoot), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IRewardsCoordinatorDistributionRoot)).(*IRewardsCoordinatorDistributionRoot)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetCurrentClaimableDistributionRoot() (IRewardsCoordinatorDistributionRoot, error) {
+	return _Contract.Contract.GetCurrentClaimableDistributionRoot(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetCurrentClaimableDistributionRoot() (IRewardsCoordinatorDistributionRoot, error) {
+	return _Contract.Contract.GetCurrentClaimableDistributionRoot(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorDistributionRoot, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getCurrentDistributionRoot")
+
+	if err != nil {
+		return *new(IRewardsCoordinatorDistributionRoot), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IRewardsCoordinatorDistributionRoot)).(*IRewardsCoordinatorDistributionRoot)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetCurrentDistributionRoot() (IRewardsCoordinatorDistributionRoot, error) {
+	return _Contract.Contract.GetCurrentDistributionRoot(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetCurrentDistributionRoot() (IRewardsCoordinatorDistributionRoot, error) {
+	return _Contract.Contract.GetCurrentDistributionRoot(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorDistributionRoot, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getDistributionRootAtIndex", index)
+
+	if err != nil {
+		return *new(IRewardsCoordinatorDistributionRoot), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IRewardsCoordinatorDistributionRoot)).(*IRewardsCoordinatorDistributionRoot)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetDistributionRootAtIndex(index *big.Int) (IRewardsCoordinatorDistributionRoot, error) {
+	return _Contract.Contract.GetDistributionRootAtIndex(&_Contract.CallOpts, index)
+}
+
+func (_Contract *ContractCallerSession) GetDistributionRootAtIndex(index *big.Int) (IRewardsCoordinatorDistributionRoot, error) {
+	return _Contract.Contract.GetDistributionRootAtIndex(&_Contract.CallOpts, index)
+}
+
+func (_Contract *ContractCaller) GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getDistributionRootsLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetDistributionRootsLength() (*big.Int, error) {
+	return _Contract.Contract.GetDistributionRootsLength(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetDistributionRootsLength() (*big.Int, error) {
+	return _Contract.Contract.GetDistributionRootsLength(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getRootIndexFromHash", rootHash)
+
+	if err != nil {
+		return *new(uint32), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint32)).(*uint32)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetRootIndexFromHash(rootHash [32]byte) (uint32, error) {
+	return _Contract.Contract.GetRootIndexFromHash(&_Contract.CallOpts, rootHash)
+}
+
+func (_Contract *ContractCallerSession) GetRootIndexFromHash(rootHash [32]byte) (uint32, error) {
+	return _Contract.Contract.GetRootIndexFromHash(&_Contract.CallOpts, rootHash)
+}
+
+func (_Contract *ContractCaller) GlobalOperatorCommissionBips(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "globalOperatorCommissionBips")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GlobalOperatorCommissionBips() (uint16, error) {
+	return _Contract.Contract.GlobalOperatorCommissionBips(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GlobalOperatorCommissionBips() (uint16, error) {
+	return _Contract.Contract.GlobalOperatorCommissionBips(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) OperatorCommissionBips(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "operatorCommissionBips", operator, avs)
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) OperatorCommissionBips(operator common.Address, avs common.Address) (uint16, error) {
+	return _Contract.Contract.OperatorCommissionBips(&_Contract.CallOpts, operator, avs)
+}
+
+func (_Contract *ContractCallerSession) OperatorCommissionBips(operator common.Address, avs common.Address) (uint16, error) {
+	return _Contract.Contract.OperatorCommissionBips(&_Contract.CallOpts, operator, avs)
+}
+
+func (_Contract *ContractCaller) RewardsUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "rewardsUpdater")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) RewardsUpdater() (common.Address, error) {
+	return _Contract.Contract.RewardsUpdater(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) RewardsUpdater() (common.Address, error) {
+	return _Contract.Contract.RewardsUpdater(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractTransactor) CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "createAVSRewardsSubmission", rewardsSubmissions)
+}
+
+func (_Contract *ContractSession) CreateAVSRewardsSubmission(rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return _Contract.Contract.CreateAVSRewardsSubmission(&_Contract.TransactOpts, rewardsSubmissions)
+}
+
+func (_Contract *ContractTransactorSession) CreateAVSRewardsSubmission(rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return _Contract.Contract.CreateAVSRewardsSubmission(&_Contract.TransactOpts, rewardsSubmissions)
+}
+
+func (_Contract *ContractTransactor) CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "createRewardsForAllSubmission", rewardsSubmission)
+}
+
+func (_Contract *ContractSession) CreateRewardsForAllSubmission(rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return _Contract.Contract.CreateRewardsForAllSubmission(&_Contract.TransactOpts, rewardsSubmission)
+}
+
+func (_Contract *ContractTransactorSession) CreateRewardsForAllSubmission(rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return _Contract.Contract.CreateRewardsForAllSubmission(&_Contract.TransactOpts, rewardsSubmission)
+}
+
+func (_Contract *ContractTransactor) DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "disableRoot", rootIndex)
+}
+
+func (_Contract *ContractSession) DisableRoot(rootIndex uint32) (*types.Transaction, error) {
+	return _Contract.Contract.DisableRoot(&_Contract.TransactOpts, rootIndex)
+}
+
+func (_Contract *ContractTransactorSession) DisableRoot(rootIndex uint32) (*types.Transaction, error) {
+	return _Contract.Contract.DisableRoot(&_Contract.TransactOpts, rootIndex)
+}
+
+func (_Contract *ContractTransactor) ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "processClaim", claim, recipient)
+}
+
+func (_Contract *ContractSession) ProcessClaim(claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.ProcessClaim(&_Contract.TransactOpts, claim, recipient)
+}
+
+func (_Contract *ContractTransactorSession) ProcessClaim(claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.ProcessClaim(&_Contract.TransactOpts, claim, recipient)
+}
+
+func (_Contract *ContractTransactor) SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setActivationDelay", _activationDelay)
+}
+
+func (_Contract *ContractSession) SetActivationDelay(_activationDelay uint32) (*types.Transaction, error) {
+	return _Contract.Contract.SetActivationDelay(&_Contract.TransactOpts, _activationDelay)
+}
+
+func (_Contract *ContractTransactorSession) SetActivationDelay(_activationDelay uint32) (*types.Transaction, error) {
+	return _Contract.Contract.SetActivationDelay(&_Contract.TransactOpts, _activationDelay)
+}
+
+func (_Contract *ContractTransactor) SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setClaimerFor", claimer)
+}
+
+func (_Contract *ContractSession) SetClaimerFor(claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetClaimerFor(&_Contract.TransactOpts, claimer)
+}
+
+func (_Contract *ContractTransactorSession) SetClaimerFor(claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetClaimerFor(&_Contract.TransactOpts, claimer)
+}
+
+func (_Contract *ContractTransactor) SetGlobalOperatorCommission(opts *bind.TransactOpts, _globalCommissionBips uint16) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setGlobalOperatorCommission", _globalCommissionBips)
+}
+
+func (_Contract *ContractSession) SetGlobalOperatorCommission(_globalCommissionBips uint16) (*types.Transaction, error) {
+	return _Contract.Contract.SetGlobalOperatorCommission(&_Contract.TransactOpts, _globalCommissionBips)
+}
+
+func (_Contract *ContractTransactorSession) SetGlobalOperatorCommission(_globalCommissionBips uint16) (*types.Transaction, error) {
+	return _Contract.Contract.SetGlobalOperatorCommission(&_Contract.TransactOpts, _globalCommissionBips)
+}
+
+func (_Contract *ContractTransactor) SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setRewardsForAllSubmitter", _submitter, _newValue)
+}
+
+func (_Contract *ContractSession) SetRewardsForAllSubmitter(_submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsForAllSubmitter(&_Contract.TransactOpts, _submitter, _newValue)
+}
+
+func (_Contract *ContractTransactorSession) SetRewardsForAllSubmitter(_submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsForAllSubmitter(&_Contract.TransactOpts, _submitter, _newValue)
+}
+
+func (_Contract *ContractTransactor) SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setRewardsUpdater", _rewardsUpdater)
+}
+
+func (_Contract *ContractSession) SetRewardsUpdater(_rewardsUpdater common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsUpdater(&_Contract.TransactOpts, _rewardsUpdater)
+}
+
+func (_Contract *ContractTransactorSession) SetRewardsUpdater(_rewardsUpdater common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsUpdater(&_Contract.TransactOpts, _rewardsUpdater)
+}
+
+func (_Contract *ContractTransactor) SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "submitRoot", root, rewardsCalculationEndTimestamp)
+}
+
+func (_Contract *ContractSession) SubmitRoot(root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return _Contract.Contract.SubmitRoot(&_Contract.TransactOpts, root, rewardsCalculationEndTimestamp)
+}
+
+func (_Contract *ContractTransactorSession) SubmitRoot(root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return _Contract.Contract.SubmitRoot(&_Contract.TransactOpts, root, rewardsCalculationEndTimestamp)
+}
+
+type ContractAVSRewardsSubmissionCreatedIterator struct {
+	Event *ContractAVSRewardsSubmissionCreated
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractAVSRewardsSubmissionCreatedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractAVSRewardsSubmissionCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractAVSRewardsSubmissionCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractAVSRewardsSubmissionCreatedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractAVSRewardsSubmissionCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractAVSRewardsSubmissionCreated struct {
+	Avs                   common.Address
+	SubmissionNonce       *big.Int
+	RewardsSubmissionHash [32]byte
+	RewardsSubmission     IRewardsCoordinatorRewardsSubmission
+	Raw                   types.Log
+}
+
+func (_Contract *ContractFilterer) FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*ContractAVSRewardsSubmissionCreatedIterator, error) {
+
+	var avsRule []interface{}
+	for _, avsItem := range avs {
+		avsRule = append(avsRule, avsItem)
+	}
+	var submissionNonceRule []interface{}
+	for _, submissionNonceItem := range submissionNonce {
+		submissionNonceRule = append(submissionNonceRule, submissionNonceItem)
+	}
+	var rewardsSubmissionHashRule []interface{}
+	for _, rewardsSubmissionHashItem := range rewardsSubmissionHash {
+		rewardsSubmissionHashRule = append(rewardsSubmissionHashRule, rewardsSubmissionHashItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "AVSRewardsSubmissionCreated", avsRule, submissionNonceRule, rewardsSubmissionHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractAVSRewardsSubmissionCreatedIterator{contract: _Contract.contract, event: "AVSRewardsSubmissionCreated", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *ContractAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error) {
+
+	var avsRule []interface{}
+	for _, avsItem := range avs {
+		avsRule = append(avsRule, avsItem)
+	}
+	var submissionNonceRule []interface{}
+	for _, submissionNonceItem := range submissionNonce {
+		submissionNonceRule = append(submissionNonceRule, submissionNonceItem)
+	}
+	var rewardsSubmissionHashRule []interface{}
+	for _, rewardsSubmissionHashItem := range rewardsSubmissionHash {
+		rewardsSubmissionHashRule = append(rewardsSubmissionHashRule, rewardsSubmissionHashItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "AVSRewardsSubmissionCreated", avsRule, submissionNonceRule, rewardsSubmissionHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractAVSRewardsSubmissionCreated)
+				if err := _Contract.contract.UnpackLog(event, "AVSRewardsSubmissionCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseAVSRewardsSubmissionCreated(log types.Log) (*ContractAVSRewardsSubmissionCreated, error) {
+	event := new(ContractAVSRewardsSubmissionCreated)
+	if err := _Contract.contract.UnpackLog(event, "AVSRewardsSubmissionCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractActivationDelaySetIterator struct {
+	Event *ContractActivationDelaySet
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractActivationDelaySetIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractActivationDelaySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractActivationDelaySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractActivationDelaySetIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractActivationDelaySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractActivationDelaySet struct {
+	OldActivationDelay uint32
+	NewActivationDelay uint32
+	Raw                types.Log
+}
+
+func (_Contract *ContractFilterer) FilterActivationDelaySet(opts *bind.FilterOpts) (*ContractActivationDelaySetIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "ActivationDelaySet")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractActivationDelaySetIterator{contract: _Contract.contract, event: "ActivationDelaySet", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *ContractActivationDelaySet) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "ActivationDelaySet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractActivationDelaySet)
+				if err := _Contract.contract.UnpackLog(event, "ActivationDelaySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseActivationDelaySet(log types.Log) (*ContractActivationDelaySet, error) {
+	event := new(ContractActivationDelaySet)
+	if err := _Contract.contract.UnpackLog(event, "ActivationDelaySet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractClaimerForSetIterator struct {
+	Event *ContractClaimerForSet
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractClaimerForSetIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractClaimerForSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractClaimerForSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractClaimerForSetIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractClaimerForSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractClaimerForSet struct {
+	Earner     common.Address
+	OldClaimer common.Address
+	Claimer    common.Address
+	Raw        types.Log
+}
+
+func (_Contract *ContractFilterer) FilterClaimerForSet(opts *bind.FilterOpts, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (*ContractClaimerForSetIterator, error) {
+
+	var earnerRule []interface{}
+	for _, earnerItem := range earner {
+		earnerRule = append(earnerRule, earnerItem)
+	}
+	var oldClaimerRule []interface{}
+	for _, oldClaimerItem := range oldClaimer {
+		oldClaimerRule = append(oldClaimerRule, oldClaimerItem)
+	}
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "ClaimerForSet", earnerRule, oldClaimerRule, claimerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractClaimerForSetIterator{contract: _Contract.contract, event: "ClaimerForSet", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *ContractClaimerForSet, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (event.Subscription, error) {
+
+	var earnerRule []interface{}
+	for _, earnerItem := range earner {
+		earnerRule = append(earnerRule, earnerItem)
+	}
+	var oldClaimerRule []interface{}
+	for _, oldClaimerItem := range oldClaimer {
+		oldClaimerRule = append(oldClaimerRule, oldClaimerItem)
+	}
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "ClaimerForSet", earnerRule, oldClaimerRule, claimerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractClaimerForSet)
+				if err := _Contract.contract.UnpackLog(event, "ClaimerForSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseClaimerForSet(log types.Log) (*ContractClaimerForSet, error) {
+	event := new(ContractClaimerForSet)
+	if err := _Contract.contract.UnpackLog(event, "ClaimerForSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractDistributionRootDisabledIterator struct {
+	Event *ContractDistributionRootDisabled
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractDistributionRootDisabledIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractDistributionRootDisabled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractDistributionRootDisabled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractDistributionRootDisabledIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractDistributionRootDisabledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractDistributionRootDisabled struct {
+	RootIndex uint32
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*ContractDistributionRootDisabledIterator, error) {
+
+	var rootIndexRule []interface{}
+	for _, rootIndexItem := range rootIndex {
+		rootIndexRule = append(rootIndexRule, rootIndexItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "DistributionRootDisabled", rootIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractDistributionRootDisabledIterator{contract: _Contract.contract, event: "DistributionRootDisabled", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *ContractDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error) {
+
+	var rootIndexRule []interface{}
+	for _, rootIndexItem := range rootIndex {
+		rootIndexRule = append(rootIndexRule, rootIndexItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "DistributionRootDisabled", rootIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractDistributionRootDisabled)
+				if err := _Contract.contract.UnpackLog(event, "DistributionRootDisabled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseDistributionRootDisabled(log types.Log) (*ContractDistributionRootDisabled, error) {
+	event := new(ContractDistributionRootDisabled)
+	if err := _Contract.contract.UnpackLog(event, "DistributionRootDisabled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractDistributionRootSubmittedIterator struct {
+	Event *ContractDistributionRootSubmitted
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractDistributionRootSubmittedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractDistributionRootSubmitted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractDistributionRootSubmitted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractDistributionRootSubmittedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractDistributionRootSubmittedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractDistributionRootSubmitted struct {
+	RootIndex                      uint32
+	Root                           [32]byte
+	RewardsCalculationEndTimestamp uint32
+	ActivatedAt                    uint32
+	Raw                            types.Log
+}
+
+func (_Contract *ContractFilterer) FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*ContractDistributionRootSubmittedIterator, error) {
+
+	var rootIndexRule []interface{}
+	for _, rootIndexItem := range rootIndex {
+		rootIndexRule = append(rootIndexRule, rootIndexItem)
+	}
+	var rootRule []interface{}
+	for _, rootItem := range root {
+		rootRule = append(rootRule, rootItem)
+	}
+	var rewardsCalculationEndTimestampRule []interface{}
+	for _, rewardsCalculationEndTimestampItem := range rewardsCalculationEndTimestamp {
+		rewardsCalculationEndTimestampRule = append(rewardsCalculationEndTimestampRule, rewardsCalculationEndTimestampItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "DistributionRootSubmitted", rootIndexRule, rootRule, rewardsCalculationEndTimestampRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractDistributionRootSubmittedIterator{contract: _Contract.contract, event: "DistributionRootSubmitted", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *ContractDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error) {
+
+	var rootIndexRule []interface{}
+	for _, rootIndexItem := range rootIndex {
+		rootIndexRule = append(rootIndexRule, rootIndexItem)
+	}
+	var rootRule []interface{}
+	for _, rootItem := range root {
+		rootRule = append(rootRule, rootItem)
+	}
+	var rewardsCalculationEndTimestampRule []interface{}
+	for _, rewardsCalculationEndTimestampItem := range rewardsCalculationEndTimestamp {
+		rewardsCalculationEndTimestampRule = append(rewardsCalculationEndTimestampRule, rewardsCalculationEndTimestampItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "DistributionRootSubmitted", rootIndexRule, rootRule, rewardsCalculationEndTimestampRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractDistributionRootSubmitted)
+				if err := _Contract.contract.UnpackLog(event, "DistributionRootSubmitted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseDistributionRootSubmitted(log types.Log) (*ContractDistributionRootSubmitted, error) {
+	event := new(ContractDistributionRootSubmitted)
+	if err := _Contract.contract.UnpackLog(event, "DistributionRootSubmitted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractGlobalCommissionBipsSetIterator struct {
+	Event *ContractGlobalCommissionBipsSet
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractGlobalCommissionBipsSetIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractGlobalCommissionBipsSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractGlobalCommissionBipsSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractGlobalCommissionBipsSetIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractGlobalCommissionBipsSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractGlobalCommissionBipsSet struct {
+	OldGlobalCommissionBips uint16
+	NewGlobalCommissionBips uint16
+	Raw                     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterGlobalCommissionBipsSet(opts *bind.FilterOpts) (*ContractGlobalCommissionBipsSetIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "GlobalCommissionBipsSet")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractGlobalCommissionBipsSetIterator{contract: _Contract.contract, event: "GlobalCommissionBipsSet", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchGlobalCommissionBipsSet(opts *bind.WatchOpts, sink chan<- *ContractGlobalCommissionBipsSet) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "GlobalCommissionBipsSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractGlobalCommissionBipsSet)
+				if err := _Contract.contract.UnpackLog(event, "GlobalCommissionBipsSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseGlobalCommissionBipsSet(log types.Log) (*ContractGlobalCommissionBipsSet, error) {
+	event := new(ContractGlobalCommissionBipsSet)
+	if err := _Contract.contract.UnpackLog(event, "GlobalCommissionBipsSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsClaimedIterator struct {
+	Event *ContractRewardsClaimed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsClaimedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsClaimedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsClaimed struct {
+	Root          [32]byte
+	Earner        common.Address
+	Claimer       common.Address
+	Recipient     common.Address
+	Token         common.Address
+	ClaimedAmount *big.Int
+	Raw           types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsClaimed(opts *bind.FilterOpts, earner []common.Address, claimer []common.Address, recipient []common.Address) (*ContractRewardsClaimedIterator, error) {
+
+	var earnerRule []interface{}
+	for _, earnerItem := range earner {
+		earnerRule = append(earnerRule, earnerItem)
+	}
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsClaimed", earnerRule, claimerRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsClaimedIterator{contract: _Contract.contract, event: "RewardsClaimed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *ContractRewardsClaimed, earner []common.Address, claimer []common.Address, recipient []common.Address) (event.Subscription, error) {
+
+	var earnerRule []interface{}
+	for _, earnerItem := range earner {
+		earnerRule = append(earnerRule, earnerItem)
+	}
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsClaimed", earnerRule, claimerRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsClaimed)
+				if err := _Contract.contract.UnpackLog(event, "RewardsClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsClaimed(log types.Log) (*ContractRewardsClaimed, error) {
+	event := new(ContractRewardsClaimed)
+	if err := _Contract.contract.UnpackLog(event, "RewardsClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsForAllSubmitterSetIterator struct {
+	Event *ContractRewardsForAllSubmitterSet
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsForAllSubmitterSetIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsForAllSubmitterSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsForAllSubmitterSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsForAllSubmitterSetIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsForAllSubmitterSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsForAllSubmitterSet struct {
+	RewardsForAllSubmitter common.Address
+	OldValue               bool
+	NewValue               bool
+	Raw                    types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (*ContractRewardsForAllSubmitterSetIterator, error) {
+
+	var rewardsForAllSubmitterRule []interface{}
+	for _, rewardsForAllSubmitterItem := range rewardsForAllSubmitter {
+		rewardsForAllSubmitterRule = append(rewardsForAllSubmitterRule, rewardsForAllSubmitterItem)
+	}
+	var oldValueRule []interface{}
+	for _, oldValueItem := range oldValue {
+		oldValueRule = append(oldValueRule, oldValueItem)
+	}
+	var newValueRule []interface{}
+	for _, newValueItem := range newValue {
+		newValueRule = append(newValueRule, newValueItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsForAllSubmitterSet", rewardsForAllSubmitterRule, oldValueRule, newValueRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsForAllSubmitterSetIterator{contract: _Contract.contract, event: "RewardsForAllSubmitterSet", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *ContractRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (event.Subscription, error) {
+
+	var rewardsForAllSubmitterRule []interface{}
+	for _, rewardsForAllSubmitterItem := range rewardsForAllSubmitter {
+		rewardsForAllSubmitterRule = append(rewardsForAllSubmitterRule, rewardsForAllSubmitterItem)
+	}
+	var oldValueRule []interface{}
+	for _, oldValueItem := range oldValue {
+		oldValueRule = append(oldValueRule, oldValueItem)
+	}
+	var newValueRule []interface{}
+	for _, newValueItem := range newValue {
+		newValueRule = append(newValueRule, newValueItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsForAllSubmitterSet", rewardsForAllSubmitterRule, oldValueRule, newValueRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsForAllSubmitterSet)
+				if err := _Contract.contract.UnpackLog(event, "RewardsForAllSubmitterSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsForAllSubmitterSet(log types.Log) (*ContractRewardsForAllSubmitterSet, error) {
+	event := new(ContractRewardsForAllSubmitterSet)
+	if err := _Contract.contract.UnpackLog(event, "RewardsForAllSubmitterSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsSubmissionForAllCreatedIterator struct {
+	Event *ContractRewardsSubmissionForAllCreated
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsSubmissionForAllCreatedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsSubmissionForAllCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsSubmissionForAllCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsSubmissionForAllCreatedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsSubmissionForAllCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsSubmissionForAllCreated struct {
+	Submitter             common.Address
+	SubmissionNonce       *big.Int
+	RewardsSubmissionHash [32]byte
+	RewardsSubmission     IRewardsCoordinatorRewardsSubmission
+	Raw                   types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*ContractRewardsSubmissionForAllCreatedIterator, error) {
+
+	var submitterRule []interface{}
+	for _, submitterItem := range submitter {
+		submitterRule = append(submitterRule, submitterItem)
+	}
+	var submissionNonceRule []interface{}
+	for _, submissionNonceItem := range submissionNonce {
+		submissionNonceRule = append(submissionNonceRule, submissionNonceItem)
+	}
+	var rewardsSubmissionHashRule []interface{}
+	for _, rewardsSubmissionHashItem := range rewardsSubmissionHash {
+		rewardsSubmissionHashRule = append(rewardsSubmissionHashRule, rewardsSubmissionHashItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsSubmissionForAllCreated", submitterRule, submissionNonceRule, rewardsSubmissionHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsSubmissionForAllCreatedIterator{contract: _Contract.contract, event: "RewardsSubmissionForAllCreated", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *ContractRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error) {
+
+	var submitterRule []interface{}
+	for _, submitterItem := range submitter {
+		submitterRule = append(submitterRule, submitterItem)
+	}
+	var submissionNonceRule []interface{}
+	for _, submissionNonceItem := range submissionNonce {
+		submissionNonceRule = append(submissionNonceRule, submissionNonceItem)
+	}
+	var rewardsSubmissionHashRule []interface{}
+	for _, rewardsSubmissionHashItem := range rewardsSubmissionHash {
+		rewardsSubmissionHashRule = append(rewardsSubmissionHashRule, rewardsSubmissionHashItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsSubmissionForAllCreated", submitterRule, submissionNonceRule, rewardsSubmissionHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsSubmissionForAllCreated)
+				if err := _Contract.contract.UnpackLog(event, "RewardsSubmissionForAllCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsSubmissionForAllCreated(log types.Log) (*ContractRewardsSubmissionForAllCreated, error) {
+	event := new(ContractRewardsSubmissionForAllCreated)
+	if err := _Contract.contract.UnpackLog(event, "RewardsSubmissionForAllCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsUpdaterSetIterator struct {
+	Event *ContractRewardsUpdaterSet
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsUpdaterSetIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsUpdaterSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsUpdaterSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsUpdaterSetIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsUpdaterSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsUpdaterSet struct {
+	OldRewardsUpdater common.Address
+	NewRewardsUpdater common.Address
+	Raw               types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (*ContractRewardsUpdaterSetIterator, error) {
+
+	var oldRewardsUpdaterRule []interface{}
+	for _, oldRewardsUpdaterItem := range oldRewardsUpdater {
+		oldRewardsUpdaterRule = append(oldRewardsUpdaterRule, oldRewardsUpdaterItem)
+	}
+	var newRewardsUpdaterRule []interface{}
+	for _, newRewardsUpdaterItem := range newRewardsUpdater {
+		newRewardsUpdaterRule = append(newRewardsUpdaterRule, newRewardsUpdaterItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsUpdaterSet", oldRewardsUpdaterRule, newRewardsUpdaterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsUpdaterSetIterator{contract: _Contract.contract, event: "RewardsUpdaterSet", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *ContractRewardsUpdaterSet, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (event.Subscription, error) {
+
+	var oldRewardsUpdaterRule []interface{}
+	for _, oldRewardsUpdaterItem := range oldRewardsUpdater {
+		oldRewardsUpdaterRule = append(oldRewardsUpdaterRule, oldRewardsUpdaterItem)
+	}
+	var newRewardsUpdaterRule []interface{}
+	for _, newRewardsUpdaterItem := range newRewardsUpdater {
+		newRewardsUpdaterRule = append(newRewardsUpdaterRule, newRewardsUpdaterItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsUpdaterSet", oldRewardsUpdaterRule, newRewardsUpdaterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsUpdaterSet)
+				if err := _Contract.contract.UnpackLog(event, "RewardsUpdaterSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsUpdaterSet(log types.Log) (*ContractRewardsUpdaterSet, error) {
+	event := new(ContractRewardsUpdaterSet)
+	if err := _Contract.contract.UnpackLog(event, "RewardsUpdaterSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["AVSRewardsSubmissionCreated"].ID:
+		return _Contract.ParseAVSRewardsSubmissionCreated(log)
+	case _Contract.abi.Events["ActivationDelaySet"].ID:
+		return _Contract.ParseActivationDelaySet(log)
+	case _Contract.abi.Events["ClaimerForSet"].ID:
+		return _Contract.ParseClaimerForSet(log)
+	case _Contract.abi.Events["DistributionRootDisabled"].ID:
+		return _Contract.ParseDistributionRootDisabled(log)
+	case _Contract.abi.Events["DistributionRootSubmitted"].ID:
+		return _Contract.ParseDistributionRootSubmitted(log)
+	case _Contract.abi.Events["GlobalCommissionBipsSet"].ID:
+		return _Contract.ParseGlobalCommissionBipsSet(log)
+	case _Contract.abi.Events["RewardsClaimed"].ID:
+		return _Contract.ParseRewardsClaimed(log)
+	case _Contract.abi.Events["RewardsForAllSubmitterSet"].ID:
+		return _Contract.ParseRewardsForAllSubmitterSet(log)
+	case _Contract.abi.Events["RewardsSubmissionForAllCreated"].ID:
+		return _Contract.ParseRewardsSubmissionForAllCreated(log)
+	case _Contract.abi.Events["RewardsUpdaterSet"].ID:
+		return _Contract.ParseRewardsUpdaterSet(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractAVSRewardsSubmissionCreated) Topic() common.Hash {
+	return common.HexToHash("0x450a367a380c4e339e5ae7340c8464ef27af7781ad9945cfe8abd828f89e6281")
+}
+
+func (ContractActivationDelaySet) Topic() common.Hash {
+	return common.HexToHash("0xaf557c6c02c208794817a705609cfa935f827312a1adfdd26494b6b95dd2b4b3")
+}
+
+func (ContractClaimerForSet) Topic() common.Hash {
+	return common.HexToHash("0xbab947934d42e0ad206f25c9cab18b5bb6ae144acfb00f40b4e3aa59590ca312")
+}
+
+func (ContractDistributionRootDisabled) Topic() common.Hash {
+	return common.HexToHash("0xd850e6e5dfa497b72661fa73df2923464eaed9dc2ff1d3cb82bccbfeabe5c41e")
+}
+
+func (ContractDistributionRootSubmitted) Topic() common.Hash {
+	return common.HexToHash("0xecd866c3c158fa00bf34d803d5f6023000b57080bcb48af004c2b4b46b3afd08")
+}
+
+func (ContractGlobalCommissionBipsSet) Topic() common.Hash {
+	return common.HexToHash("0x8cdc428b0431b82d1619763f443a48197db344ba96905f3949643acd1c863a06")
+}
+
+func (ContractRewardsClaimed) Topic() common.Hash {
+	return common.HexToHash("0x9543dbd55580842586a951f0386e24d68a5df99ae29e3b216588b45fd684ce31")
+}
+
+func (ContractRewardsForAllSubmitterSet) Topic() common.Hash {
+	return common.HexToHash("0x4de6293e668df1398422e1def12118052c1539a03cbfedc145895d48d7685f1c")
+}
+
+func (ContractRewardsSubmissionForAllCreated) Topic() common.Hash {
+	return common.HexToHash("0x51088b8c89628df3a8174002c2a034d0152fce6af8415d651b2a4734bf270482")
+}
+
+func (ContractRewardsUpdaterSet) Topic() common.Hash {
+	return common.HexToHash("0x237b82f438d75fc568ebab484b75b01d9287b9e98b490b7c23221623b6705dbb")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	return nil, false
+}
+
+func (_Contract *Contract) CreateAVSRewardsSubmissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.CreateAVSRewardsSubmission(opts, rewardsSubmissions)
+	})
+}
+
+func (_Contract *Contract) CreateRewardsForAllSubmissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.CreateRewardsForAllSubmission(opts, rewardsSubmission)
+	})
+}
+
+func (_Contract *Contract) DisableRootAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, rootIndex uint32) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.DisableRoot(opts, rootIndex)
+	})
+}
+
+func (_Contract *Contract) ProcessClaimAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.ProcessClaim(opts, claim, recipient)
+	})
+}
+
+func (_Contract *Contract) SetActivationDelayAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _activationDelay uint32) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetActivationDelay(opts, _activationDelay)
+	})
+}
+
+func (_Contract *Contract) SetClaimerForAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, claimer common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetClaimerFor(opts, claimer)
+	})
+}
+
+func (_Contract *Contract) SetGlobalOperatorCommissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _globalCommissionBips uint16) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetGlobalOperatorCommission(opts, _globalCommissionBips)
+	})
+}
+
+func (_Contract *Contract) SetRewardsForAllSubmitterAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetRewardsForAllSubmitter(opts, _submitter, _newValue)
+	})
+}
+
+func (_Contract *Contract) SetRewardsUpdaterAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _rewardsUpdater common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetRewardsUpdater(opts, _rewardsUpdater)
+	})
+}
+
+func (_Contract *Contract) SubmitRootAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SubmitRoot(opts, root, rewardsCalculationEndTimestamp)
+	})
+}
+
+func (_Contract *Contract) SimulateCreateAVSRewardsSubmission(sim *generated.Simulator, opts *generated.SimulateOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "createAVSRewardsSubmission", rewardsSubmissions)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateCreateRewardsForAllSubmission(sim *generated.Simulator, opts *generated.SimulateOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "createRewardsForAllSubmission", rewardsSubmission)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateDisableRoot(sim *generated.Simulator, opts *generated.SimulateOpts, rootIndex uint32) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "disableRoot", rootIndex)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateProcessClaim(sim *generated.Simulator, opts *generated.SimulateOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "processClaim", claim, recipient)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetActivationDelay(sim *generated.Simulator, opts *generated.SimulateOpts, _activationDelay uint32) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setActivationDelay", _activationDelay)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetClaimerFor(sim *generated.Simulator, opts *generated.SimulateOpts, claimer common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setClaimerFor", claimer)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetGlobalOperatorCommission(sim *generated.Simulator, opts *generated.SimulateOpts, _globalCommissionBips uint16) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setGlobalOperatorCommission", _globalCommissionBips)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetRewardsForAllSubmitter(sim *generated.Simulator, opts *generated.SimulateOpts, _submitter common.Address, _newValue bool) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setRewardsForAllSubmitter", _submitter, _newValue)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetRewardsUpdater(sim *generated.Simulator, opts *generated.SimulateOpts, _rewardsUpdater common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setRewardsUpdater", _rewardsUpdater)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSubmitRoot(sim *generated.Simulator, opts *generated.SimulateOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "submitRoot", root, rewardsCalculationEndTimestamp)
+	return gas, err
+}
+
+type ContractInterface interface {
+	CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error)
+
+	GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error)
+
+	MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error)
+
+	MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error)
+
+	MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error)
+
+	ActivationDelay(opts *bind.CallOpts) (uint32, error)
+
+	CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error)
+
+	CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error)
+
+	CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorRewardsMerkleClaim) (bool, error)
+
+	ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error)
+
+	CumulativeClaimed(opts *bind.CallOpts, claimer common.Address, token common.Address) (*big.Int, error)
+
+	CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error)
+
+	GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorDistributionRoot, error)
+
+	GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorDistributionRoot, error)
+
+	GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorDistributionRoot, error)
+
+	GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error)
+
+	GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error)
+
+	GlobalOperatorCommissionBips(opts *bind.CallOpts) (uint16, error)
+
+	OperatorCommissionBips(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error)
+
+	RewardsUpdater(opts *bind.CallOpts) (common.Address, error)
+
+	CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error)
+
+	CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error)
+
+	DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error)
+
+	ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+
+	SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error)
+
+	SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error)
+
+	SetGlobalOperatorCommission(opts *bind.TransactOpts, _globalCommissionBips uint16) (*types.Transaction, error)
+
+	SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error)
+
+	SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error)
+
+	SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error)
+
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*ContractAVSRewardsSubmissionCreatedIterator, error)
+
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *ContractAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	ParseAVSRewardsSubmissionCreated(log types.Log) (*ContractAVSRewardsSubmissionCreated, error)
+
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*ContractActivationDelaySetIterator, error)
+
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *ContractActivationDelaySet) (event.Subscription, error)
+
+	ParseActivationDelaySet(log types.Log) (*ContractActivationDelaySet, error)
+
+	FilterClaimerForSet(opts *bind.FilterOpts, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (*ContractClaimerForSetIterator, error)
+
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *ContractClaimerForSet, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (event.Subscription, error)
+
+	ParseClaimerForSet(log types.Log) (*ContractClaimerForSet, error)
+
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*ContractDistributionRootDisabledIterator, error)
+
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *ContractDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+
+	ParseDistributionRootDisabled(log types.Log) (*ContractDistributionRootDisabled, error)
+
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*ContractDistributionRootSubmittedIterator, error)
+
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *ContractDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+
+	ParseDistributionRootSubmitted(log types.Log) (*ContractDistributionRootSubmitted, error)
+
+	FilterGlobalCommissionBipsSet(opts *bind.FilterOpts) (*ContractGlobalCommissionBipsSetIterator, error)
+
+	WatchGlobalCommissionBipsSet(opts *bind.WatchOpts, sink chan<- *ContractGlobalCommissionBipsSet) (event.Subscription, error)
+
+	ParseGlobalCommissionBipsSet(log types.Log) (*ContractGlobalCommissionBipsSet, error)
+
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner []common.Address, claimer []common.Address, recipient []common.Address) (*ContractRewardsClaimedIterator, error)
+
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *ContractRewardsClaimed, earner []common.Address, claimer []common.Address, recipient []common.Address) (event.Subscription, error)
+
+	ParseRewardsClaimed(log types.Log) (*ContractRewardsClaimed, error)
+
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (*ContractRewardsForAllSubmitterSetIterator, error)
+
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *ContractRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (event.Subscription, error)
+
+	ParseRewardsForAllSubmitterSet(log types.Log) (*ContractRewardsForAllSubmitterSet, error)
+
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*ContractRewardsSubmissionForAllCreatedIterator, error)
+
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *ContractRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+
+	ParseRewardsSubmissionForAllCreated(log types.Log) (*ContractRewardsSubmissionForAllCreated, error)
+
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (*ContractRewardsUpdaterSetIterator, error)
+
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *ContractRewardsUpdaterSet, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (event.Subscription, error)
+
+	ParseRewardsUpdaterSet(log types.Log) (*ContractRewardsUpdaterSet, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	CreateAVSRewardsSubmissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*generated.Result, error)
+
+	CreateRewardsForAllSubmissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*generated.Result, error)
+
+	DisableRootAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, rootIndex uint32) (*generated.Result, error)
+
+	ProcessClaimAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*generated.Result, error)
+
+	SetActivationDelayAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _activationDelay uint32) (*generated.Result, error)
+
+	SetClaimerForAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, claimer common.Address) (*generated.Result, error)
+
+	SetGlobalOperatorCommissionAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _globalCommissionBips uint16) (*generated.Result, error)
+
+	SetRewardsForAllSubmitterAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*generated.Result, error)
+
+	SetRewardsUpdaterAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, _rewardsUpdater common.Address) (*generated.Result, error)
+
+	SubmitRootAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*generated.Result, error)
+
+	SimulateCreateAVSRewardsSubmission(sim *generated.Simulator, opts *generated.SimulateOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (uint64, error)
+
+	SimulateCreateRewardsForAllSubmission(sim *generated.Simulator, opts *generated.SimulateOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (uint64, error)
+
+	SimulateDisableRoot(sim *generated.Simulator, opts *generated.SimulateOpts, rootIndex uint32) (uint64, error)
+
+	SimulateProcessClaim(sim *generated.Simulator, opts *generated.SimulateOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (uint64, error)
+
+	SimulateSetActivationDelay(sim *generated.Simulator, opts *generated.SimulateOpts, _activationDelay uint32) (uint64, error)
+
+	SimulateSetClaimerFor(sim *generated.Simulator, opts *generated.SimulateOpts, claimer common.Address) (uint64, error)
+
+	SimulateSetGlobalOperatorCommission(sim *generated.Simulator, opts *generated.SimulateOpts, _globalCommissionBips uint16) (uint64, error)
+
+	SimulateSetRewardsForAllSubmitter(sim *generated.Simulator, opts *generated.SimulateOpts, _submitter common.Address, _newValue bool) (uint64, error)
+
+	SimulateSetRewardsUpdater(sim *generated.Simulator, opts *generated.SimulateOpts, _rewardsUpdater common.Address) (uint64, error)
+
+	SimulateSubmitRoot(sim *generated.Simulator, opts *generated.SimulateOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (uint64, error)
+}
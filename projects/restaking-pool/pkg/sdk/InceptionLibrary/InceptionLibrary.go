@@ -0,0 +1,232 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package InceptionLibrary
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"capacity\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"optimalCapacity\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"optimalBonusRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxDepositBonusRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"targetCapacity\",\"type\":\"uint256\"}],\"name\":\"calculateDepositBonus\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"bonus\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"capacity\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"optimalCapacity\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"optimalFeeRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxFlashWithdrawalFeeRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"targetCapacity\",\"type\":\"uint256\"}],\"name\":\"calculateWithdrawalFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"}]",
+	Bin: "0x61045161003a600b82828239805160001a60731461002d57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600436106100405760003560e01c8063cfe6424414610045578063fea65f721461006a575b600080fd5b610058610053366004610344565b61007d565b60405190815260200160405180910390f35b610058610078366004610344565b6101e5565b6000808711801561008d57508486115b1561010557868561009e828961039d565b10156100b1576100ae868861039d565b90505b6402540be4006100c186836103b6565b6100cb91906103cd565b6100d590836103ef565b91506100e1818961039d565b97506100ed818861039d565b9650816000036101035761010082610402565b91505b505b86156101c75760008261012087670de0b6b3a76400006103b6565b61012a91906103cd565b610134868661039d565b61014690670de0b6b3a76400006103b6565b61015091906103cd565b905060008361016060028b6103cd565b61016a908a61039d565b61017490846103b6565b61017e91906103cd565b610188908661039d565b90506402540be40061019a828b6103b6565b6101a491906103cd565b6101ae90846103ef565b9250826000036101c4576101c183610402565b92505b50505b806000036101db576101d881610402565b90505b9695505050505050565b600080871180156101f557508486105b156102da578661020581886103ef565b86101561021957610216878761039d565b90505b60008361022e88670de0b6b3a76400006103b6565b61023891906103cd565b610242878761039d565b61025490670de0b6b3a76400006103b6565b61025e91906103cd565b905060008461026e6002856103cd565b610278908b6103ef565b61028290846103b6565b61028c91906103cd565b610296908761039d565b90506102a2838a6103ef565b98506402540be4006102b482856103b6565b6102be91906103cd565b6102c890856103ef565b93506102d4838b61039d565b99505050505b6000871180156102ea5750818611155b156101db5760006102fb88886103ef565b83116103105761030b878461039d565b610312565b875b90506402540be40061032486836103b6565b61032e91906103cd565b61033890836103ef565b98975050505050505050565b60008060008060008060c0878903121561035d57600080fd5b505084359660208601359650604086013595606081013595506080810135945060a0013592509050565b634e487b7160e01b600052601160045260246000fd5b818103818111156103b0576103b0610387565b92915050565b80820281158282048414176103b0576103b0610387565b6000826103ea57634e487b7160e01b600052601260045260246000fd5b500490565b808201808211156103b0576103b0610387565b60006001820161041457610414610387565b506001019056fea2646970667358221220ae5a640f7026d332c4c9cd66a6953170aa411d48c1b80daab74f553e80e8bac964736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) CalculateDepositBonus(opts *bind.CallOpts, amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalBonusRate *big.Int, maxDepositBonusRate *big.Int, targetCapacity *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "calculateDepositBonus", amount, capacity, optimalCapacity, optimalBonusRate, maxDepositBonusRate, targetCapacity)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CalculateDepositBonus(amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalBonusRate *big.Int, maxDepositBonusRate *big.Int, targetCapacity *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateDepositBonus(&_Contract.CallOpts, amount, capacity, optimalCapacity, optimalBonusRate, maxDepositBonusRate, targetCapacity)
+}
+
+func (_Contract *ContractCallerSession) CalculateDepositBonus(amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalBonusRate *big.Int, maxDepositBonusRate *big.Int, targetCapacity *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateDepositBonus(&_Contract.CallOpts, amount, capacity, optimalCapacity, optimalBonusRate, maxDepositBonusRate, targetCapacity)
+}
+
+func (_Contract *ContractCaller) CalculateWithdrawalFee(opts *bind.CallOpts, amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalFeeRate *big.Int, maxFlashWithdrawalFeeRate *big.Int, targetCapacity *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "calculateWithdrawalFee", amount, capacity, optimalCapacity, optimalFeeRate, maxFlashWithdrawalFeeRate, targetCapacity)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CalculateWithdrawalFee(amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalFeeRate *big.Int, maxFlashWithdrawalFeeRate *big.Int, targetCapacity *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateWithdrawalFee(&_Contract.CallOpts, amount, capacity, optimalCapacity, optimalFeeRate, maxFlashWithdrawalFeeRate, targetCapacity)
+}
+
+func (_Contract *ContractCallerSession) CalculateWithdrawalFee(amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalFeeRate *big.Int, maxFlashWithdrawalFeeRate *big.Int, targetCapacity *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateWithdrawalFee(&_Contract.CallOpts, amount, capacity, optimalCapacity, optimalFeeRate, maxFlashWithdrawalFeeRate, targetCapacity)
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	return nil, false
+}
+
+type ContractInterface interface {
+	CalculateDepositBonus(opts *bind.CallOpts, amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalBonusRate *big.Int, maxDepositBonusRate *big.Int, targetCapacity *big.Int) (*big.Int, error)
+
+	CalculateWithdrawalFee(opts *bind.CallOpts, amount *big.Int, capacity *big.Int, optimalCapacity *big.Int, optimalFeeRate *big.Int, maxFlashWithdrawalFeeRate *big.Int, targetCapacity *big.Int) (*big.Int, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+}
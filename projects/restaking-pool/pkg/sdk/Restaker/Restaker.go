@@ -0,0 +1,1025 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Restaker
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"RestakerCannotClaim\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Claimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"rewardCoordinator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newRewardCoordinator\",\"type\":\"address\"}],\"name\":\"RewardsCoordinatorChanged\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"__claim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newRewardsCoordinator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"__setRewardsCoordinator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"contractIRestakerFacets\",\"name\":\"facets\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5061001961001e565b6100d0565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff161561006e5760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146100cd5780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b6107bb806100df6000396000f3fe6080604052600436106100595760003560e01c8063485cc9551461011d578063715018a61461013d57806388b856a7146101525780638da5cb5b14610172578063ef8cdf7c146101a3578063f2fde38b146101b857610060565b3661006057005b6100686101d8565b60008054604051630de0c5cd60e01b815282356001600160e01b03191660048201526001600160a01b0390911690630de0c5cd90602401602060405180830381865afa1580156100bc573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100e0919061070b565b90506001600160a01b0381166100f557600080fd5b34366000803760008036600084865af13d6000803e808015610116573d6000f35b3d6000fd5b005b34801561012957600080fd5b5061011b61013836600461072f565b610211565b34801561014957600080fd5b5061011b61032b565b34801561015e57600080fd5b5061011b61016d36600461072f565b61033d565b34801561017e57600080fd5b506101876103ff565b6040516001600160a01b03909116815260200160405180910390f35b3480156101af57600080fd5b5061011b61042d565b3480156101c457600080fd5b5061011b6101d3366004610768565b6104fd565b336101e16103ff565b6001600160a01b03161461020f5760405163118cdaa760e01b81523360048201526024015b60405180910390fd5b565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff16159067ffffffffffffffff166000811580156102575750825b905060008267ffffffffffffffff1660011480156102745750303b155b905081158015610282575080155b156102a05760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff1916600117855583156102ca57845460ff60401b1916600160401b1785555b6102d38761053b565b6102dc8661054c565b831561032257845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50505050505050565b6103336101d8565b61020f6000610634565b6103456101d8565b60405163a0169ddd60e01b81526001600160a01b03828116600483015283169063a0169ddd90602401600060405180830381600087803b15801561038857600080fd5b505af115801561039c573d6000803e3d6000fd5b50506002546040516001600160a01b03868116945090911691507f27384265e97ccc2d74f200040aec40808816c693dda1e0e9e1a932f415716fcd90600090a350600280546001600160a01b0319166001600160a01b0392909216919091179055565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b4760006104386103ff565b905081156104f9576000816001600160a01b03168360405160006040518083038185875af1925050503d806000811461048d576040519150601f19603f3d011682016040523d82523d6000602084013e610492565b606091505b50509050806104b457604051635885364d60e11b815260040160405180910390fd5b816001600160a01b03167fd8138f8a3f377c5259ca548e70e4c2de94f129f5a11036a15b69513cba2b426a846040516104ef91815260200190565b60405180910390a2505b5050565b6105056101d8565b6001600160a01b03811661052f57604051631e4fbdf760e01b815260006004820152602401610206565b61053881610634565b50565b6105436106a5565b610538816106ee565b6105546106a5565b600080546001600160a01b0319166001600160a01b0383169081179091556040805163c664d38b60e01b8152905163c664d38b916004808201926020929091908290030181865afa1580156105ad573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105d1919061070b565b6001600160a01b03166384d810626040518163ffffffff1660e01b81526004016020604051808303816000875af1158015610610573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104f9919061070b565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff1661020f57604051631afcd79f60e31b815260040160405180910390fd5b6105056106a5565b6001600160a01b038116811461053857600080fd5b60006020828403121561071d57600080fd5b8151610728816106f6565b9392505050565b6000806040838503121561074257600080fd5b823561074d816106f6565b9150602083013561075d816106f6565b809150509250929050565b60006020828403121561077a57600080fd5b8135610728816106f656fea2646970667358221220f5f89817cd1e79ead11581281513c9834c7c282be57ae01822942c603a346cf764736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Owner() (common.Address, error) {
+	return _Contract.Contract.Owner(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Owner() (common.Address, error) {
+	return _Contract.Contract.Owner(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractTransactor) Claim(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "__claim")
+}
+
+func (_Contract *ContractSession) Claim() (*types.Transaction, error) {
+	return _Contract.Contract.Claim(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Claim() (*types.Transaction, error) {
+	return _Contract.Contract.Claim(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) SetRewardsCoordinator(opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "__setRewardsCoordinator", newRewardsCoordinator, claimer)
+}
+
+func (_Contract *ContractSession) SetRewardsCoordinator(newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsCoordinator(&_Contract.TransactOpts, newRewardsCoordinator, claimer)
+}
+
+func (_Contract *ContractTransactorSession) SetRewardsCoordinator(newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsCoordinator(&_Contract.TransactOpts, newRewardsCoordinator, claimer)
+}
+
+func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, owner common.Address, facets common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "initialize", owner, facets)
+}
+
+func (_Contract *ContractSession) Initialize(owner common.Address, facets common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, owner, facets)
+}
+
+func (_Contract *ContractTransactorSession) Initialize(owner common.Address, facets common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, owner, facets)
+}
+
+func (_Contract *ContractTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "renounceOwnership")
+}
+
+func (_Contract *ContractSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Contract.Contract.RenounceOwnership(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Contract.Contract.RenounceOwnership(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+func (_Contract *ContractSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.TransferOwnership(&_Contract.TransactOpts, newOwner)
+}
+
+func (_Contract *ContractTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.TransferOwnership(&_Contract.TransactOpts, newOwner)
+}
+
+func (_Contract *ContractTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _Contract.contract.RawTransact(opts, calldata)
+}
+
+func (_Contract *ContractSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _Contract.Contract.Fallback(&_Contract.TransactOpts, calldata)
+}
+
+func (_Contract *ContractTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _Contract.Contract.Fallback(&_Contract.TransactOpts, calldata)
+}
+
+func (_Contract *ContractTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.RawTransact(opts, nil)
+}
+
+func (_Contract *ContractSession) Receive() (*types.Transaction, error) {
+	return _Contract.Contract.Receive(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Receive() (*types.Transaction, error) {
+	return _Contract.Contract.Receive(&_Contract.TransactOpts)
+}
+
+type ContractClaimedIterator struct {
+	Event *ContractClaimed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractClaimedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractClaimedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractClaimed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterClaimed(opts *bind.FilterOpts, recipient []common.Address) (*ContractClaimedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Claimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractClaimedIterator{contract: _Contract.contract, event: "Claimed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *ContractClaimed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Claimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractClaimed)
+				if err := _Contract.contract.UnpackLog(event, "Claimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseClaimed(log types.Log) (*ContractClaimed, error) {
+	event := new(ContractClaimed)
+	if err := _Contract.contract.UnpackLog(event, "Claimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractInitializedIterator struct {
+	Event *ContractInitialized
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractInitializedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractInitialized struct {
+	Version uint64
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractInitializedIterator{contract: _Contract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractInitialized)
+				if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseInitialized(log types.Log) (*ContractInitialized, error) {
+	event := new(ContractInitialized)
+	if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractOwnershipTransferredIterator struct {
+	Event *ContractOwnershipTransferred
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractOwnershipTransferredIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log
+}
+
+func (_Contract *ContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ContractOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractOwnershipTransferredIterator{contract: _Contract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractOwnershipTransferred)
+				if err := _Contract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseOwnershipTransferred(log types.Log) (*ContractOwnershipTransferred, error) {
+	event := new(ContractOwnershipTransferred)
+	if err := _Contract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsCoordinatorChangedIterator struct {
+	Event *ContractRewardsCoordinatorChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsCoordinatorChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsCoordinatorChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsCoordinatorChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsCoordinatorChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsCoordinatorChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsCoordinatorChanged struct {
+	RewardCoordinator    common.Address
+	NewRewardCoordinator common.Address
+	Raw                  types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsCoordinatorChanged(opts *bind.FilterOpts, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (*ContractRewardsCoordinatorChangedIterator, error) {
+
+	var rewardCoordinatorRule []interface{}
+	for _, rewardCoordinatorItem := range rewardCoordinator {
+		rewardCoordinatorRule = append(rewardCoordinatorRule, rewardCoordinatorItem)
+	}
+	var newRewardCoordinatorRule []interface{}
+	for _, newRewardCoordinatorItem := range newRewardCoordinator {
+		newRewardCoordinatorRule = append(newRewardCoordinatorRule, newRewardCoordinatorItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsCoordinatorChanged", rewardCoordinatorRule, newRewardCoordinatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsCoordinatorChangedIterator{contract: _Contract.contract, event: "RewardsCoordinatorChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsCoordinatorChanged(opts *bind.WatchOpts, sink chan<- *ContractRewardsCoordinatorChanged, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (event.Subscription, error) {
+
+	var rewardCoordinatorRule []interface{}
+	for _, rewardCoordinatorItem := range rewardCoordinator {
+		rewardCoordinatorRule = append(rewardCoordinatorRule, rewardCoordinatorItem)
+	}
+	var newRewardCoordinatorRule []interface{}
+	for _, newRewardCoordinatorItem := range newRewardCoordinator {
+		newRewardCoordinatorRule = append(newRewardCoordinatorRule, newRewardCoordinatorItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsCoordinatorChanged", rewardCoordinatorRule, newRewardCoordinatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsCoordinatorChanged)
+				if err := _Contract.contract.UnpackLog(event, "RewardsCoordinatorChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsCoordinatorChanged(log types.Log) (*ContractRewardsCoordinatorChanged, error) {
+	event := new(ContractRewardsCoordinatorChanged)
+	if err := _Contract.contract.UnpackLog(event, "RewardsCoordinatorChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["Claimed"].ID:
+		return _Contract.ParseClaimed(log)
+	case _Contract.abi.Events["Initialized"].ID:
+		return _Contract.ParseInitialized(log)
+	case _Contract.abi.Events["OwnershipTransferred"].ID:
+		return _Contract.ParseOwnershipTransferred(log)
+	case _Contract.abi.Events["RewardsCoordinatorChanged"].ID:
+		return _Contract.ParseRewardsCoordinatorChanged(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractClaimed) Topic() common.Hash {
+	return common.HexToHash("0xd8138f8a3f377c5259ca548e70e4c2de94f129f5a11036a15b69513cba2b426a")
+}
+
+func (ContractInitialized) Topic() common.Hash {
+	return common.HexToHash("0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2")
+}
+
+func (ContractOwnershipTransferred) Topic() common.Hash {
+	return common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0")
+}
+
+func (ContractRewardsCoordinatorChanged) Topic() common.Hash {
+	return common.HexToHash("0x27384265e97ccc2d74f200040aec40808816c693dda1e0e9e1a932f415716fcd")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractInvalidInitializationError struct{}
+
+func (e *ContractInvalidInitializationError) Error() string {
+	return "InvalidInitialization()"
+}
+
+type ContractNotInitializingError struct{}
+
+func (e *ContractNotInitializingError) Error() string {
+	return "NotInitializing()"
+}
+
+type ContractOwnableInvalidOwnerError struct {
+	Owner common.Address
+}
+
+func (e *ContractOwnableInvalidOwnerError) Error() string {
+	return fmt.Sprintf("OwnableInvalidOwner(%v)", e.Owner)
+}
+
+type ContractOwnableUnauthorizedAccountError struct {
+	Account common.Address
+}
+
+func (e *ContractOwnableUnauthorizedAccountError) Error() string {
+	return fmt.Sprintf("OwnableUnauthorizedAccount(%v)", e.Account)
+}
+
+type ContractRestakerCannotClaimError struct{}
+
+func (e *ContractRestakerCannotClaimError) Error() string {
+	return "RestakerCannotClaim()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "InvalidInitialization":
+		return &ContractInvalidInitializationError{}, true
+	case "NotInitializing":
+		return &ContractNotInitializingError{}, true
+	case "OwnableInvalidOwner":
+		return &ContractOwnableInvalidOwnerError{Owner: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "OwnableUnauthorizedAccount":
+		return &ContractOwnableUnauthorizedAccountError{Account: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "RestakerCannotClaim":
+		return &ContractRestakerCannotClaimError{}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) ClaimAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Claim(opts)
+	})
+}
+
+func (_Contract *Contract) SetRewardsCoordinatorAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetRewardsCoordinator(opts, newRewardsCoordinator, claimer)
+	})
+}
+
+func (_Contract *Contract) InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, owner common.Address, facets common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Initialize(opts, owner, facets)
+	})
+}
+
+func (_Contract *Contract) RenounceOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.RenounceOwnership(opts)
+	})
+}
+
+func (_Contract *Contract) TransferOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newOwner common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.TransferOwnership(opts, newOwner)
+	})
+}
+
+func (_Contract *Contract) FallbackAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, calldata []byte) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Fallback(opts, calldata)
+	})
+}
+
+func (_Contract *Contract) ReceiveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Receive(opts)
+	})
+}
+
+func (_Contract *Contract) SimulateClaim(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "__claim")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetRewardsCoordinator(sim *generated.Simulator, opts *generated.SimulateOpts, newRewardsCoordinator common.Address, claimer common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "__setRewardsCoordinator", newRewardsCoordinator, claimer)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, owner common.Address, facets common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "initialize", owner, facets)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateRenounceOwnership(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "renounceOwnership")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateTransferOwnership(sim *generated.Simulator, opts *generated.SimulateOpts, newOwner common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "transferOwnership", newOwner)
+	return gas, err
+}
+
+type ContractInterface interface {
+	Owner(opts *bind.CallOpts) (common.Address, error)
+
+	Claim(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	SetRewardsCoordinator(opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*types.Transaction, error)
+
+	Initialize(opts *bind.TransactOpts, owner common.Address, facets common.Address) (*types.Transaction, error)
+
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+
+	Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error)
+
+	Receive(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	FilterClaimed(opts *bind.FilterOpts, recipient []common.Address) (*ContractClaimedIterator, error)
+
+	WatchClaimed(opts *bind.WatchOpts, sink chan<- *ContractClaimed, recipient []common.Address) (event.Subscription, error)
+
+	ParseClaimed(log types.Log) (*ContractClaimed, error)
+
+	FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error)
+
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error)
+
+	ParseInitialized(log types.Log) (*ContractInitialized, error)
+
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ContractOwnershipTransferredIterator, error)
+
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+
+	ParseOwnershipTransferred(log types.Log) (*ContractOwnershipTransferred, error)
+
+	FilterRewardsCoordinatorChanged(opts *bind.FilterOpts, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (*ContractRewardsCoordinatorChangedIterator, error)
+
+	WatchRewardsCoordinatorChanged(opts *bind.WatchOpts, sink chan<- *ContractRewardsCoordinatorChanged, rewardCoordinator []common.Address, newRewardCoordinator []common.Address) (event.Subscription, error)
+
+	ParseRewardsCoordinatorChanged(log types.Log) (*ContractRewardsCoordinatorChanged, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	ClaimAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SetRewardsCoordinatorAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newRewardsCoordinator common.Address, claimer common.Address) (*generated.Result, error)
+
+	InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, owner common.Address, facets common.Address) (*generated.Result, error)
+
+	RenounceOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	TransferOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newOwner common.Address) (*generated.Result, error)
+
+	FallbackAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, calldata []byte) (*generated.Result, error)
+
+	ReceiveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SimulateClaim(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateSetRewardsCoordinator(sim *generated.Simulator, opts *generated.SimulateOpts, newRewardsCoordinator common.Address, claimer common.Address) (uint64, error)
+
+	SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, owner common.Address, facets common.Address) (uint64, error)
+
+	SimulateRenounceOwnership(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateTransferOwnership(sim *generated.Simulator, opts *generated.SimulateOpts, newOwner common.Address) (uint64, error)
+}
@@ -0,0 +1,532 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package RestakerDeployer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"beacon_\",\"type\":\"address\"},{\"internalType\":\"contractIRestakerFacets\",\"name\":\"facets_\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"Create2EmptyBytecode\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"Create2FailedDeployment\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"Create2InsufficientBalance\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"contractIRestaker\",\"name\":\"restaker\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"RestakerDeployed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BEACON_PROXY_BYTECODE\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"beacon\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployRestaker\",\"outputs\":[{\"internalType\":\"contractIRestaker\",\"name\":\"restaker\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"facets\",\"outputs\":[{\"internalType\":\"contractIRestakerFacets\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"getRestaker\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x60c060405234801561001057600080fd5b50604051610b3b380380610b3b83398101604081905261002f9161005e565b6001600160a01b039182166080521660a052610098565b6001600160a01b038116811461005b57600080fd5b50565b6000806040838503121561007157600080fd5b825161007c81610046565b602084015190925061008d81610046565b809150509250929050565b60805160a051610a726100c96000396000818160b00152610151015260008181606c015261027f0152610a726000f3fe608060405234801561001057600080fd5b50600436106100625760003560e01c806359659e90146100675780637a0ed627146100ab5780639ce953c8146100d2578063affed0e0146100da578063cda3ef36146100f1578063d52fc71014610106575b600080fd5b61008e7f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020015b60405180910390f35b61008e7f000000000000000000000000000000000000000000000000000000000000000081565b61008e610119565b6100e360005481565b6040519081526020016100a2565b6100f9610211565b6040516100a291906103db565b61008e61011436600461040e565b610236565b60008054339061013290839061012d610256565b6102ec565b60405163485cc95560e01b81526001600160a01b0383811660048301527f0000000000000000000000000000000000000000000000000000000000000000811660248301529193509083169063485cc95590604401600060405180830381600087803b1580156101a157600080fd5b505af11580156101b5573d6000803e3d6000fd5b5050600080546001600160a01b038087169450851692507f66b1c85e3aa7b590e4fcd19543377d320772af5d49300c8c50653f46253ee99f91806101f883610427565b9091555060405190815260200160405180910390a35090565b604051610220602082016103a9565b601f1982820381018352601f9091011660405281565b600061025082610244610256565b80519060200120610377565b92915050565b606060405180602001610268906103a9565b601f1982820381018352601f9091011660408181527f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166020830152808201526000606082015260800160408051601f19818403018152908290526102d8929160200161044e565b604051602081830303815290604052905090565b60008347101561031c5760405163392efb2b60e21b81524760048201526024810185905260440160405180910390fd5b815160000361033e57604051631328927760e21b815260040160405180910390fd5b8282516020840186f590506001600160a01b03811661037057604051633a0ba96160e11b815260040160405180910390fd5b9392505050565b60006103708383306000604051836040820152846020820152828152600b8101905060ff815360559020949350505050565b6105bf806200047e83390190565b60005b838110156103d25781810151838201526020016103ba565b50506000910152565b60208152600082518060208401526103fa8160408501602087016103b7565b601f01601f19169190910160400192915050565b60006020828403121561042057600080fd5b5035919050565b60006001820161044757634e487b7160e01b600052601160045260246000fd5b5060010190565b600083516104608184602088016103b7565b8351908301906104748183602088016103b7565b0194935050505056fe60a06040526040516105bf3803806105bf83398101604081905261002291610387565b61002c828261003e565b506001600160a01b031660805261047e565b610047826100fe565b6040516001600160a01b038316907f1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e90600090a28051156100f2576100ed826001600160a01b0316635c60da1b6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156100c3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906100e79190610447565b82610211565b505050565b6100fa610288565b5050565b806001600160a01b03163b60000361013957604051631933b43b60e21b81526001600160a01b03821660048201526024015b60405180910390fd5b807fa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d5080546001600160a01b0319166001600160a01b0392831617905560408051635c60da1b60e01b81529051600092841691635c60da1b9160048083019260209291908290030181865afa1580156101b5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906101d99190610447565b9050806001600160a01b03163b6000036100fa57604051634c9c8ce360e01b81526001600160a01b0382166004820152602401610130565b6060600080846001600160a01b03168460405161022e9190610462565b600060405180830381855af49150503d8060008114610269576040519150601f19603f3d011682016040523d82523d6000602084013e61026e565b606091505b50909250905061027f8583836102a9565b95945050505050565b34156102a75760405163b398979f60e01b815260040160405180910390fd5b565b6060826102be576102b982610308565b610301565b81511580156102d557506001600160a01b0384163b155b156102fe57604051639996b31560e01b81526001600160a01b0385166004820152602401610130565b50805b9392505050565b8051156103185780518082602001fd5b604051630a12f52160e11b815260040160405180910390fd5b80516001600160a01b038116811461034857600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b60005b8381101561037e578181015183820152602001610366565b50506000910152565b6000806040838503121561039a57600080fd5b6103a383610331565b60208401519092506001600160401b03808211156103c057600080fd5b818501915085601f8301126103d457600080fd5b8151818111156103e6576103e661034d565b604051601f8201601f19908116603f0116810190838211818310171561040e5761040e61034d565b8160405282815288602084870101111561042757600080fd5b610438836020830160208801610363565b80955050505050509250929050565b60006020828403121561045957600080fd5b61030182610331565b60008251610474818460208701610363565b9190910192915050565b6080516101276104986000396000601e01526101276000f3fe6080604052600a600c565b005b60186014601a565b60a0565b565b60007f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316635c60da1b6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156079573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190609b919060c3565b905090565b3660008037600080366000845af43d6000803e80801560be573d6000f35b3d6000fd5b60006020828403121560d457600080fd5b81516001600160a01b038116811460ea57600080fd5b939250505056fea2646970667358221220f727ce9d64bfa02a59ff02f1e7958151d669c99c207b68012ee0ca31ad8a2f7464736f6c63430008150033a26469706673582212201a1c8e0e50528c86c072a907d28cca2809d6453b43b16430c34422b4b5653c2d64736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend, beacon_ common.Address, facets_ common.Address) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend, beacon_, facets_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) BEACONPROXYBYTECODE(opts *bind.CallOpts) ([]byte, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "BEACON_PROXY_BYTECODE")
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) BEACONPROXYBYTECODE() ([]byte, error) {
+	return _Contract.Contract.BEACONPROXYBYTECODE(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) BEACONPROXYBYTECODE() ([]byte, error) {
+	return _Contract.Contract.BEACONPROXYBYTECODE(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Beacon(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "beacon")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Beacon() (common.Address, error) {
+	return _Contract.Contract.Beacon(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Beacon() (common.Address, error) {
+	return _Contract.Contract.Beacon(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Facets(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "facets")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Facets() (common.Address, error) {
+	return _Contract.Contract.Facets(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Facets() (common.Address, error) {
+	return _Contract.Contract.Facets(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetRestaker(opts *bind.CallOpts, id *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getRestaker", id)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetRestaker(id *big.Int) (common.Address, error) {
+	return _Contract.Contract.GetRestaker(&_Contract.CallOpts, id)
+}
+
+func (_Contract *ContractCallerSession) GetRestaker(id *big.Int) (common.Address, error) {
+	return _Contract.Contract.GetRestaker(&_Contract.CallOpts, id)
+}
+
+func (_Contract *ContractCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "nonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Nonce() (*big.Int, error) {
+	return _Contract.Contract.Nonce(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Nonce() (*big.Int, error) {
+	return _Contract.Contract.Nonce(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractTransactor) DeployRestaker(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "deployRestaker")
+}
+
+func (_Contract *ContractSession) DeployRestaker() (*types.Transaction, error) {
+	return _Contract.Contract.DeployRestaker(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) DeployRestaker() (*types.Transaction, error) {
+	return _Contract.Contract.DeployRestaker(&_Contract.TransactOpts)
+}
+
+type ContractRestakerDeployedIterator struct {
+	Event *ContractRestakerDeployed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRestakerDeployedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRestakerDeployed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRestakerDeployed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRestakerDeployedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRestakerDeployedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRestakerDeployed struct {
+	Creator  common.Address
+	Restaker common.Address
+	Id       *big.Int
+	Raw      types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRestakerDeployed(opts *bind.FilterOpts, creator []common.Address, restaker []common.Address) (*ContractRestakerDeployedIterator, error) {
+
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+	var restakerRule []interface{}
+	for _, restakerItem := range restaker {
+		restakerRule = append(restakerRule, restakerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RestakerDeployed", creatorRule, restakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRestakerDeployedIterator{contract: _Contract.contract, event: "RestakerDeployed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRestakerDeployed(opts *bind.WatchOpts, sink chan<- *ContractRestakerDeployed, creator []common.Address, restaker []common.Address) (event.Subscription, error) {
+
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+	var restakerRule []interface{}
+	for _, restakerItem := range restaker {
+		restakerRule = append(restakerRule, restakerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RestakerDeployed", creatorRule, restakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRestakerDeployed)
+				if err := _Contract.contract.UnpackLog(event, "RestakerDeployed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRestakerDeployed(log types.Log) (*ContractRestakerDeployed, error) {
+	event := new(ContractRestakerDeployed)
+	if err := _Contract.contract.UnpackLog(event, "RestakerDeployed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["RestakerDeployed"].ID:
+		return _Contract.ParseRestakerDeployed(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractRestakerDeployed) Topic() common.Hash {
+	return common.HexToHash("0x66b1c85e3aa7b590e4fcd19543377d320772af5d49300c8c50653f46253ee99f")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractCreate2EmptyBytecodeError struct{}
+
+func (e *ContractCreate2EmptyBytecodeError) Error() string {
+	return "Create2EmptyBytecode()"
+}
+
+type ContractCreate2FailedDeploymentError struct{}
+
+func (e *ContractCreate2FailedDeploymentError) Error() string {
+	return "Create2FailedDeployment()"
+}
+
+type ContractCreate2InsufficientBalanceError struct {
+	Balance *big.Int
+	Needed  *big.Int
+}
+
+func (e *ContractCreate2InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("Create2InsufficientBalance(%v, %v)", e.Balance, e.Needed)
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "Create2EmptyBytecode":
+		return &ContractCreate2EmptyBytecodeError{}, true
+	case "Create2FailedDeployment":
+		return &ContractCreate2FailedDeploymentError{}, true
+	case "Create2InsufficientBalance":
+		return &ContractCreate2InsufficientBalanceError{Balance: *abi.ConvertType(e.Args[0], new(*big.Int)).(**big.Int), Needed: *abi.ConvertType(e.Args[1], new(*big.Int)).(**big.Int)}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) DeployRestakerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.DeployRestaker(opts)
+	})
+}
+
+func (_Contract *Contract) SimulateDeployRestaker(sim *generated.Simulator, opts *generated.SimulateOpts) (common.Address, uint64, error) {
+	out, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "deployRestaker")
+	if err != nil {
+		return *new(common.Address), 0, err
+	}
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	return out0, gas, nil
+}
+
+type ContractInterface interface {
+	BEACONPROXYBYTECODE(opts *bind.CallOpts) ([]byte, error)
+
+	Beacon(opts *bind.CallOpts) (common.Address, error)
+
+	Facets(opts *bind.CallOpts) (common.Address, error)
+
+	GetRestaker(opts *bind.CallOpts, id *big.Int) (common.Address, error)
+
+	Nonce(opts *bind.CallOpts) (*big.Int, error)
+
+	DeployRestaker(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	FilterRestakerDeployed(opts *bind.FilterOpts, creator []common.Address, restaker []common.Address) (*ContractRestakerDeployedIterator, error)
+
+	WatchRestakerDeployed(opts *bind.WatchOpts, sink chan<- *ContractRestakerDeployed, creator []common.Address, restaker []common.Address) (event.Subscription, error)
+
+	ParseRestakerDeployed(log types.Log) (*ContractRestakerDeployed, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	DeployRestakerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SimulateDeployRestaker(sim *generated.Simulator, opts *generated.SimulateOpts) (common.Address, uint64, error)
+}
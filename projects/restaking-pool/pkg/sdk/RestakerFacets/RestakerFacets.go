@@ -0,0 +1,905 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package RestakerFacets
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"enumIRestakerFacets.FuncTarget\",\"name\":\"target\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"bytes4\",\"name\":\"signature\",\"type\":\"bytes4\"}],\"name\":\"SignatureSet\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"getDelegationManager\",\"outputs\":[{\"internalType\":\"contractIDelegationManager\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEigenPodManager\",\"outputs\":[{\"internalType\":\"contractIEigenPodManager\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"contractIEigenPodManager\",\"name\":\"podManager\",\"type\":\"address\"},{\"internalType\":\"contractIDelegationManager\",\"name\":\"delegationManager\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"sig\",\"type\":\"bytes4\"}],\"name\":\"selectorToTarget\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIDelegationManager\",\"name\":\"newDelegationManager\",\"type\":\"address\"}],\"name\":\"setDelegationManager\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"enumIRestakerFacets.FuncTarget\",\"name\":\"target\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"signature\",\"type\":\"string\"}],\"name\":\"setSignature\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5061001961001e565b6100d0565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff161561006e5760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146100cd5780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b61083d806100df6000396000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c80638da5cb5b116100665780638da5cb5b146100f7578063b24e5a3a14610127578063c0c53b8b14610138578063c664d38b1461014b578063f2fde38b1461015c57600080fd5b80630de0c5cd146100985780631a8d0de2146100c7578063715018a6146100dc5780638c537c6d146100e4575b600080fd5b6100ab6100a636600461064f565b61016f565b6040516001600160a01b03909116815260200160405180910390f35b6100da6100d536600461068e565b610265565b005b6100da61028f565b6100da6100f23660046106c1565b6102a3565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b03166100ab565b6002546001600160a01b03166100ab565b6100da610146366004610789565b6102b9565b6001546001600160a01b03166100ab565b6100da61016a36600461068e565b6103d5565b6001600160e01b0319811660009081526020819052604081205460ff1660018160028111156101a0576101a06107d4565b036101b85750506001546001600160a01b0316919050565b60028160028111156101cc576101cc6107d4565b036101e45750506002546001600160a01b0316919050565b6001546001600160a01b031663a38406a3336040516001600160e01b031960e084901b1681526001600160a01b039091166004820152602401602060405180830381865afa15801561023a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061025e91906107ea565b9392505050565b61026d610418565b600280546001600160a01b0319166001600160a01b0392909216919091179055565b610297610418565b6102a16000610473565b565b6102ab610418565b6102b582826104e4565b5050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff16159067ffffffffffffffff166000811580156102ff5750825b905060008267ffffffffffffffff16600114801561031c5750303b155b90508115801561032a575080155b156103485760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff19166001178555831561037257845460ff60401b1916600160401b1785555b61037b8861057e565b610385878761058f565b83156103cb57845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050505050565b6103dd610418565b6001600160a01b03811661040c57604051631e4fbdf760e01b8152600060048201526024015b60405180910390fd5b61041581610473565b50565b3361044a7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316146102a15760405163118cdaa760e01b8152336004820152602401610403565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b80516020808301919091206001600160e01b031981166000908152918290526040909120805484919060ff19166001836002811115610525576105256107d4565b021790555082600281111561053c5761053c6107d4565b6040516001600160e01b0319831681527f2d68f7f9788b3207d1a77f40d81758efd807d1a239636683f6e7d25267cc74969060200160405180910390a2505050565b6105866105d7565b61041581610620565b6105976105d7565b6105a082610628565b6105a981610628565b600180546001600160a01b039384166001600160a01b03199182161790915560028054929093169116179055565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff166102a157604051631afcd79f60e31b815260040160405180910390fd5b6103dd6105d7565b6001600160a01b0381166104155760405163d92e233d60e01b815260040160405180910390fd5b60006020828403121561066157600080fd5b81356001600160e01b03198116811461025e57600080fd5b6001600160a01b038116811461041557600080fd5b6000602082840312156106a057600080fd5b813561025e81610679565b634e487b7160e01b600052604160045260246000fd5b600080604083850312156106d457600080fd5b8235600381106106e357600080fd5b9150602083013567ffffffffffffffff8082111561070057600080fd5b818501915085601f83011261071457600080fd5b813581811115610726576107266106ab565b604051601f8201601f19908116603f0116810190838211818310171561074e5761074e6106ab565b8160405282815288602084870101111561076757600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b60008060006060848603121561079e57600080fd5b83356107a981610679565b925060208401356107b981610679565b915060408401356107c981610679565b809150509250925092565b634e487b7160e01b600052602160045260246000fd5b6000602082840312156107fc57600080fd5b815161025e8161067956fea26469706673582212206b3f2cd90271e563e8803f3e0b31ce1b8b8bf3f515b84ec2eee16e8b64e8b46864736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) GetDelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getDelegationManager")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetDelegationManager() (common.Address, error) {
+	return _Contract.Contract.GetDelegationManager(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetDelegationManager() (common.Address, error) {
+	return _Contract.Contract.GetDelegationManager(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetEigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getEigenPodManager")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetEigenPodManager() (common.Address, error) {
+	return _Contract.Contract.GetEigenPodManager(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetEigenPodManager() (common.Address, error) {
+	return _Contract.Contract.GetEigenPodManager(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Owner() (common.Address, error) {
+	return _Contract.Contract.Owner(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Owner() (common.Address, error) {
+	return _Contract.Contract.Owner(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) SelectorToTarget(opts *bind.CallOpts, sig [4]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "selectorToTarget", sig)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) SelectorToTarget(sig [4]byte) (common.Address, error) {
+	return _Contract.Contract.SelectorToTarget(&_Contract.CallOpts, sig)
+}
+
+func (_Contract *ContractCallerSession) SelectorToTarget(sig [4]byte) (common.Address, error) {
+	return _Contract.Contract.SelectorToTarget(&_Contract.CallOpts, sig)
+}
+
+func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, owner common.Address, podManager common.Address, delegationManager common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "initialize", owner, podManager, delegationManager)
+}
+
+func (_Contract *ContractSession) Initialize(owner common.Address, podManager common.Address, delegationManager common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, owner, podManager, delegationManager)
+}
+
+func (_Contract *ContractTransactorSession) Initialize(owner common.Address, podManager common.Address, delegationManager common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, owner, podManager, delegationManager)
+}
+
+func (_Contract *ContractTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "renounceOwnership")
+}
+
+func (_Contract *ContractSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Contract.Contract.RenounceOwnership(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Contract.Contract.RenounceOwnership(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) SetDelegationManager(opts *bind.TransactOpts, newDelegationManager common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setDelegationManager", newDelegationManager)
+}
+
+func (_Contract *ContractSession) SetDelegationManager(newDelegationManager common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetDelegationManager(&_Contract.TransactOpts, newDelegationManager)
+}
+
+func (_Contract *ContractTransactorSession) SetDelegationManager(newDelegationManager common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetDelegationManager(&_Contract.TransactOpts, newDelegationManager)
+}
+
+func (_Contract *ContractTransactor) SetSignature(opts *bind.TransactOpts, target uint8, signature string) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setSignature", target, signature)
+}
+
+func (_Contract *ContractSession) SetSignature(target uint8, signature string) (*types.Transaction, error) {
+	return _Contract.Contract.SetSignature(&_Contract.TransactOpts, target, signature)
+}
+
+func (_Contract *ContractTransactorSession) SetSignature(target uint8, signature string) (*types.Transaction, error) {
+	return _Contract.Contract.SetSignature(&_Contract.TransactOpts, target, signature)
+}
+
+func (_Contract *ContractTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+func (_Contract *ContractSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.TransferOwnership(&_Contract.TransactOpts, newOwner)
+}
+
+func (_Contract *ContractTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.TransferOwnership(&_Contract.TransactOpts, newOwner)
+}
+
+type ContractInitializedIterator struct {
+	Event *ContractInitialized
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractInitializedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractInitialized struct {
+	Version uint64
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractInitializedIterator{contract: _Contract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractInitialized)
+				if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseInitialized(log types.Log) (*ContractInitialized, error) {
+	event := new(ContractInitialized)
+	if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractOwnershipTransferredIterator struct {
+	Event *ContractOwnershipTransferred
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractOwnershipTransferredIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log
+}
+
+func (_Contract *ContractFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ContractOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractOwnershipTransferredIterator{contract: _Contract.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractOwnershipTransferred)
+				if err := _Contract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseOwnershipTransferred(log types.Log) (*ContractOwnershipTransferred, error) {
+	event := new(ContractOwnershipTransferred)
+	if err := _Contract.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractSignatureSetIterator struct {
+	Event *ContractSignatureSet
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractSignatureSetIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractSignatureSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractSignatureSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractSignatureSetIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractSignatureSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractSignatureSet struct {
+	Target    uint8
+	Signature [4]byte
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterSignatureSet(opts *bind.FilterOpts, target []uint8) (*ContractSignatureSetIterator, error) {
+
+	var targetRule []interface{}
+	for _, targetItem := range target {
+		targetRule = append(targetRule, targetItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "SignatureSet", targetRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractSignatureSetIterator{contract: _Contract.contract, event: "SignatureSet", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchSignatureSet(opts *bind.WatchOpts, sink chan<- *ContractSignatureSet, target []uint8) (event.Subscription, error) {
+
+	var targetRule []interface{}
+	for _, targetItem := range target {
+		targetRule = append(targetRule, targetItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "SignatureSet", targetRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractSignatureSet)
+				if err := _Contract.contract.UnpackLog(event, "SignatureSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseSignatureSet(log types.Log) (*ContractSignatureSet, error) {
+	event := new(ContractSignatureSet)
+	if err := _Contract.contract.UnpackLog(event, "SignatureSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["Initialized"].ID:
+		return _Contract.ParseInitialized(log)
+	case _Contract.abi.Events["OwnershipTransferred"].ID:
+		return _Contract.ParseOwnershipTransferred(log)
+	case _Contract.abi.Events["SignatureSet"].ID:
+		return _Contract.ParseSignatureSet(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractInitialized) Topic() common.Hash {
+	return common.HexToHash("0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2")
+}
+
+func (ContractOwnershipTransferred) Topic() common.Hash {
+	return common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0")
+}
+
+func (ContractSignatureSet) Topic() common.Hash {
+	return common.HexToHash("0x2d68f7f9788b3207d1a77f40d81758efd807d1a239636683f6e7d25267cc7496")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractInvalidInitializationError struct{}
+
+func (e *ContractInvalidInitializationError) Error() string {
+	return "InvalidInitialization()"
+}
+
+type ContractNotInitializingError struct{}
+
+func (e *ContractNotInitializingError) Error() string {
+	return "NotInitializing()"
+}
+
+type ContractOwnableInvalidOwnerError struct {
+	Owner common.Address
+}
+
+func (e *ContractOwnableInvalidOwnerError) Error() string {
+	return fmt.Sprintf("OwnableInvalidOwner(%v)", e.Owner)
+}
+
+type ContractOwnableUnauthorizedAccountError struct {
+	Account common.Address
+}
+
+func (e *ContractOwnableUnauthorizedAccountError) Error() string {
+	return fmt.Sprintf("OwnableUnauthorizedAccount(%v)", e.Account)
+}
+
+type ContractZeroAddressError struct{}
+
+func (e *ContractZeroAddressError) Error() string {
+	return "ZeroAddress()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "InvalidInitialization":
+		return &ContractInvalidInitializationError{}, true
+	case "NotInitializing":
+		return &ContractNotInitializingError{}, true
+	case "OwnableInvalidOwner":
+		return &ContractOwnableInvalidOwnerError{Owner: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "OwnableUnauthorizedAccount":
+		return &ContractOwnableUnauthorizedAccountError{Account: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "ZeroAddress":
+		return &ContractZeroAddressError{}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, owner common.Address, podManager common.Address, delegationManager common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Initialize(opts, owner, podManager, delegationManager)
+	})
+}
+
+func (_Contract *Contract) RenounceOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.RenounceOwnership(opts)
+	})
+}
+
+func (_Contract *Contract) SetDelegationManagerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newDelegationManager common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetDelegationManager(opts, newDelegationManager)
+	})
+}
+
+func (_Contract *Contract) SetSignatureAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, target uint8, signature string) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetSignature(opts, target, signature)
+	})
+}
+
+func (_Contract *Contract) TransferOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newOwner common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.TransferOwnership(opts, newOwner)
+	})
+}
+
+func (_Contract *Contract) SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, owner common.Address, podManager common.Address, delegationManager common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "initialize", owner, podManager, delegationManager)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateRenounceOwnership(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "renounceOwnership")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetDelegationManager(sim *generated.Simulator, opts *generated.SimulateOpts, newDelegationManager common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setDelegationManager", newDelegationManager)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetSignature(sim *generated.Simulator, opts *generated.SimulateOpts, target uint8, signature string) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setSignature", target, signature)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateTransferOwnership(sim *generated.Simulator, opts *generated.SimulateOpts, newOwner common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "transferOwnership", newOwner)
+	return gas, err
+}
+
+type ContractInterface interface {
+	GetDelegationManager(opts *bind.CallOpts) (common.Address, error)
+
+	GetEigenPodManager(opts *bind.CallOpts) (common.Address, error)
+
+	Owner(opts *bind.CallOpts) (common.Address, error)
+
+	SelectorToTarget(opts *bind.CallOpts, sig [4]byte) (common.Address, error)
+
+	Initialize(opts *bind.TransactOpts, owner common.Address, podManager common.Address, delegationManager common.Address) (*types.Transaction, error)
+
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	SetDelegationManager(opts *bind.TransactOpts, newDelegationManager common.Address) (*types.Transaction, error)
+
+	SetSignature(opts *bind.TransactOpts, target uint8, signature string) (*types.Transaction, error)
+
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+
+	FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error)
+
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error)
+
+	ParseInitialized(log types.Log) (*ContractInitialized, error)
+
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ContractOwnershipTransferredIterator, error)
+
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ContractOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+
+	ParseOwnershipTransferred(log types.Log) (*ContractOwnershipTransferred, error)
+
+	FilterSignatureSet(opts *bind.FilterOpts, target []uint8) (*ContractSignatureSetIterator, error)
+
+	WatchSignatureSet(opts *bind.WatchOpts, sink chan<- *ContractSignatureSet, target []uint8) (event.Subscription, error)
+
+	ParseSignatureSet(log types.Log) (*ContractSignatureSet, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, owner common.Address, podManager common.Address, delegationManager common.Address) (*generated.Result, error)
+
+	RenounceOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SetDelegationManagerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newDelegationManager common.Address) (*generated.Result, error)
+
+	SetSignatureAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, target uint8, signature string) (*generated.Result, error)
+
+	TransferOwnershipAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newOwner common.Address) (*generated.Result, error)
+
+	SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, owner common.Address, podManager common.Address, delegationManager common.Address) (uint64, error)
+
+	SimulateRenounceOwnership(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateSetDelegationManager(sim *generated.Simulator, opts *generated.SimulateOpts, newDelegationManager common.Address) (uint64, error)
+
+	SimulateSetSignature(sim *generated.Simulator, opts *generated.SimulateOpts, target uint8, signature string) (uint64, error)
+
+	SimulateTransferOwnership(sim *generated.Simulator, opts *generated.SimulateOpts, newOwner common.Address) (uint64, error)
+}
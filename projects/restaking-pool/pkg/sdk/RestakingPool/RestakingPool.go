@@ -0,0 +1,5341 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package RestakingPool
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+type BeaconChainProofsStateRootProof struct {
+	BeaconStateRoot [32]byte
+	Proof           []byte
+}
+
+type IDelegationManagerQueuedWithdrawalParams struct {
+	Strategies []common.Address
+	Shares     []*big.Int
+	Withdrawer common.Address
+}
+
+type IDelegationManagerWithdrawal struct {
+	Staker      common.Address
+	DelegatedTo common.Address
+	Withdrawer  common.Address
+	Nonce       *big.Int
+	StartBlock  uint32
+	Strategies  []common.Address
+	Shares      []*big.Int
+}
+
+type IRestakingPoolUnstake struct {
+	Recipient common.Address
+	Amount    *big.Int
+}
+
+type ISignatureUtilsSignatureWithExpiry struct {
+	Signature []byte
+	Expiry    *big.Int
+}
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"claimed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"}],\"name\":\"AmbiguousFee\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InconsistentData\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"capacity\",\"type\":\"uint256\"}],\"name\":\"InsufficientCapacity\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyGovernanceAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyOperatorAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyRestakingPoolAllowed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"param\",\"type\":\"uint256\"}],\"name\":\"ParameterExceedsLimits\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"max\",\"type\":\"uint64\"}],\"name\":\"PoolDistributeGasLimitNotInRange\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolFailedInnerCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolInsufficientBalance\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolRestakerExists\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolRestakerNotExists\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolStakeAmGreaterThanAvailable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolStakeAmLessThanMin\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolUnstakeAmLessThanMin\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolWrongInputLength\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"PoolZeroAmount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TargetCapacityNotSet\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TimelineNotOver\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ClaimExpected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes[]\",\"name\":\"pubkeys\",\"type\":\"bytes[]\"}],\"name\":\"Deposited\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"prevValue\",\"type\":\"uint32\"},{\"indexed\":false,\"internalType\":\"uint32\",\"name\":\"newValue\",\"type\":\"uint32\"}],\"name\":\"DistributeGasLimitChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"restaker\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"totalClaimed\",\"type\":\"uint256\"}],\"name\":\"FeeClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"}],\"name\":\"FlashUnstaked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"prevValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"MaxTVLChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"prevValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"MinStakeChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"prevValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"MinUnstakeChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"ownerAddress\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"receiverAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"PendingUnstake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"prevValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"ProtocolFeeChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Received\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"code\",\"type\":\"bytes32\"}],\"name\":\"ReferralStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"restaker\",\"type\":\"address\"}],\"name\":\"RestakerAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"startTimeline\",\"type\":\"uint256\"}],\"name\":\"RewardsAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rewardsTimeline\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newTimelineInSeconds\",\"type\":\"uint256\"}],\"name\":\"RewardsTimelineChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"StakeBonus\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newMaxBonusRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newOptimalBonusRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newDepositUtilizationKink\",\"type\":\"uint256\"}],\"name\":\"StakeBonusParamsChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Staked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"prevValue\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"TargetCapacityChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"caller\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"UnstakeClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newMaxFlashFeeRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newOptimalWithdrawalRate\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newWithdrawUtilizationKink\",\"type\":\"uint256\"}],\"name\":\"UnstakeFeeParamsChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"Unstaked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIRestakingPool.Unstake[]\",\"name\":\"unstakes\",\"type\":\"tuple[]\"}],\"name\":\"UnstakesDistributed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"CALL_GAS_LIMIT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_PERCENT\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"}],\"name\":\"addRestaker\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"addRewards\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"availableToStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"bytes[]\",\"name\":\"pubkeys\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes[]\",\"name\":\"signatures\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"deposit_data_roots\",\"type\":\"bytes32[]\"}],\"name\":\"batchDeposit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"calculateFlashUnstakeFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"calculateStakeBonus\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"fee\",\"type\":\"uint256\"}],\"name\":\"claimRestaker\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"claimUnstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"claimableOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"staker\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"delegatedTo\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"withdrawer\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"nonce\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"startBlock\",\"type\":\"uint32\"},{\"internalType\":\"contractIStrategy[]\",\"name\":\"strategies\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"shares\",\"type\":\"uint256[]\"}],\"internalType\":\"structIDelegationManager.Withdrawal[]\",\"name\":\"withdrawals\",\"type\":\"tuple[]\"},{\"internalType\":\"contractIERC20[][]\",\"name\":\"tokens\",\"type\":\"address[][]\"},{\"internalType\":\"uint256[]\",\"name\":\"middlewareTimesIndexes\",\"type\":\"uint256[]\"},{\"internalType\":\"bool[]\",\"name\":\"receiveAsTokens\",\"type\":\"bool[]\"}],\"name\":\"completeWithdrawals\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currentRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"elOperator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"expiry\",\"type\":\"uint256\"}],\"internalType\":\"structISignatureUtils.SignatureWithExpiry\",\"name\":\"approverSignatureAndExpiry\",\"type\":\"tuple\"},{\"internalType\":\"bytes32\",\"name\":\"approverSalt\",\"type\":\"bytes32\"}],\"name\":\"delegateTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"distributeUnstakes\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"flashUnstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getFlashCapacity\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getFreeBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinUnstake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPending\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"}],\"name\":\"getRestaker\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalClaimable\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTotalPendingUnstakes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"getTotalUnstakesOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnstakes\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIRestakingPool.Unstake[]\",\"name\":\"unstakes\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"getUnstakesOf\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIRestakingPool.Unstake[]\",\"name\":\"unstakes\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"hasClaimable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"config\",\"type\":\"address\"},{\"internalType\":\"uint32\",\"name\":\"distributeGasLimit\",\"type\":\"uint32\"},{\"internalType\":\"uint256\",\"name\":\"newMaxTVL\",\"type\":\"uint256\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxBonusRate\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxFlashFeeRate\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxTVL\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"optimalBonusRate\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"optimalUnstakeRate\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"protocolFee\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"contractIStrategy[]\",\"name\":\"strategies\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"shares\",\"type\":\"uint256[]\"},{\"internalType\":\"address\",\"name\":\"withdrawer\",\"type\":\"address\"}],\"internalType\":\"structIDelegationManager.QueuedWithdrawalParams[]\",\"name\":\"withdrawals\",\"type\":\"tuple[]\"}],\"name\":\"queueWithdrawals\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"contractIERC20[]\",\"name\":\"tokenList\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amountsToWithdraw\",\"type\":\"uint256[]\"}],\"name\":\"recoverTokens\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"rewardsTimeline\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint32\",\"name\":\"newValue\",\"type\":\"uint32\"}],\"name\":\"setDistributeGasLimit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"newMaxFlashFeeRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"newOptimalUnstakeRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"newUnstakeUtilizationKink\",\"type\":\"uint64\"}],\"name\":\"setFlashUnstakeFeeParams\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"setMaxTVL\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"setMinStake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newValue\",\"type\":\"uint256\"}],\"name\":\"setMinUnstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"newProtocolFee\",\"type\":\"uint64\"}],\"name\":\"setProtocolFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newCoordinator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"claimer\",\"type\":\"address\"}],\"name\":\"setRewardsCoordinator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newTimelineInSeconds\",\"type\":\"uint256\"}],\"name\":\"setRewardsTimeline\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"newMaxBonusRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"newOptimalBonusRate\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"newStakeUtilizationKink\",\"type\":\"uint64\"}],\"name\":\"setStakeBonusParams\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"newTargetCapacity\",\"type\":\"uint64\"}],\"name\":\"setTargetFlashCapacity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stake\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"code\",\"type\":\"bytes32\"}],\"name\":\"stake\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakeBonusAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"stakeUtilizationKink\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"startTimeline\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"revertIfNoBalance\",\"type\":\"bool\"}],\"name\":\"startWithdrawalCheckpoint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"targetCapacity\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"}],\"name\":\"undelegate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"unstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unstakeUtilizationKink\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"provider\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"oracleTimestamp\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"beaconStateRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"proof\",\"type\":\"bytes\"}],\"internalType\":\"structBeaconChainProofs.StateRootProof\",\"name\":\"stateRootProof\",\"type\":\"tuple\"},{\"internalType\":\"uint40[]\",\"name\":\"validatorIndices\",\"type\":\"uint40[]\"},{\"internalType\":\"bytes[]\",\"name\":\"validatorFieldsProofs\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes32[][]\",\"name\":\"validatorFields\",\"type\":\"bytes32[][]\"}],\"name\":\"verifyWithdrawalCredentials\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b506200001c62000022565b620000d6565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff1615620000735760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b0390811614620000d35780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b6157e680620000e66000396000f3fe6080604052600436106103a55760003560e01c80637e388224116101e7578063bc7829271161010d578063e6a49733116100a0578063f21748ad1161006f578063f21748ad14610acc578063f527463314610aec578063fa57d00214610b0c578063fb8fa29014610b2157600080fd5b8063e6a4973314610a50578063e93c4f1514610a70578063eea4684d14610a85578063f1d37a2014610aa557600080fd5b8063d2045f6d116100dc578063d2045f6d146109db578063d50b810d146109f0578063d95edac914610a10578063dc835d6b14610a3057600080fd5b8063bc78292714610952578063c0d64a8714610988578063c2a672e01461099b578063d11531da146109bb57600080fd5b80639669c7cf11610185578063b0e21e8a11610154578063b0e21e8a146108ce578063b3870ef7146108f5578063b56c044114610915578063b637d6691461093c57600080fd5b80639669c7cf146104b657806396dae88b1461086e578063aa7e4e541461088e578063ad72cc35146108ae57600080fd5b80638c80fd90116101c15780638c80fd90146108025780638e59fbf6146108225780638e5f141d14610838578063901a7d531461085857600080fd5b80637e3882241461078c578063845b9150146107ac5780638903ab9d146107cc57600080fd5b80633f908551116102cc5780636180c6091161026a578063779a6cdd11610239578063779a6cdd146106c957806378288a38146106f057806379502c55146107125780637cf3d51e1461074457600080fd5b80636180c609146106575780636b77b2f51461066c5780636ff23d3114610682578063765fccca146106a957600080fd5b806345cced29116102a657806345cced29146105e25780634a6b629d1461060257806356a3b5fa146106225780635f4aa38d1461063757600080fd5b80633f9085511461058d578063417f9246146105a257806343bc42d3146105c257600080fd5b8063126d5df6116103445780633397547211610313578063339754721461052f5780633a0acdc1146105455780633a4b66f1146105655780633b1ddc611461056d57600080fd5b8063126d5df6146104cb57806314d6aed0146104e05780631f2ed36f146104e857806328af87b51461050f57600080fd5b80630c4f0e3f116103805780630c4f0e3f1461045b5780630f19aaef1461047d5780631036bbe21461049d57806311ae9ed2146104b657600080fd5b8062928917146103e657806301e1d11414610423578063034c44541461044657600080fd5b366103e15760405134815233907f88a5966d370b9919b20f3e2c13ff65706f196a4e32cc2c12bf57088f885258749060200160405180910390a2005b600080fd5b3480156103f257600080fd5b50604154610406906001600160401b031681565b6040516001600160401b0390911681526020015b60405180910390f35b34801561042f57600080fd5b50610438610b41565b60405190815260200161041a565b34801561045257600080fd5b50610438610bbf565b34801561046757600080fd5b5061047b610476366004614400565b610d2a565b005b34801561048957600080fd5b5061047b61049836600461444b565b610e39565b3480156104a957600080fd5b506104066402540be40081565b3480156104c257600080fd5b50610438610f5b565b3480156104d757600080fd5b50603854610438565b61047b610f6a565b3480156104f457600080fd5b5060405461040690600160801b90046001600160401b031681565b34801561051b57600080fd5b5061047b61052a366004614494565b6110bb565b34801561053b57600080fd5b50610438603f5481565b34801561055157600080fd5b5061047b6105603660046144c4565b611440565b61047b611561565b34801561057957600080fd5b5061047b610588366004614525565b611847565b34801561059957600080fd5b5061043861199d565b3480156105ae57600080fd5b5061047b6105bd3660046146b5565b611a9c565b3480156105ce57600080fd5b5061047b6105dd366004614400565b611bcc565b3480156105ee57600080fd5b5061047b6105fd36600461471d565b611d22565b34801561060e57600080fd5b50604054610406906001600160401b031681565b34801561062e57600080fd5b50610438611e49565b34801561064357600080fd5b5061047b610652366004614751565b611f46565b34801561066357600080fd5b50603b54610438565b34801561067857600080fd5b5061043860435481565b34801561068e57600080fd5b5060415461040690600160801b90046001600160401b031681565b3480156106b557600080fd5b5061047b6106c43660046149f2565b6121c5565b3480156106d557600080fd5b5060405461040690600160401b90046001600160401b031681565b3480156106fc57600080fd5b506107056122e8565b60405161041a9190614ac7565b34801561071e57600080fd5b506000546001600160a01b03165b6040516001600160a01b03909116815260200161041a565b34801561075057600080fd5b5061077c61075f366004614b1f565b6001600160a01b03166000908152603c6020526040902054151590565b604051901515815260200161041a565b34801561079857600080fd5b5061047b6107a7366004614b3c565b6123f1565b3480156107b857600080fd5b5061047b6107c73660046144c4565b6125f0565b3480156107d857600080fd5b506104386107e7366004614b1f565b6001600160a01b03166000908152603c602052604090205490565b34801561080e57600080fd5b5061047b61081d3660046144c4565b6126d7565b34801561082e57600080fd5b5061043861271081565b34801561084457600080fd5b5061047b610853366004614b3c565b6127be565b34801561086457600080fd5b5061043860425481565b34801561087a57600080fd5b506104386108893660046144c4565b6129a3565b34801561089a57600080fd5b5061047b6108a9366004614b7f565b6129bc565b3480156108ba57600080fd5b5061072c6108c9366004614bcc565b612ac7565b3480156108da57600080fd5b5060415461040690600160c01b90046001600160401b031681565b34801561090157600080fd5b5061047b610910366004614c0d565b612b2d565b34801561092157600080fd5b5060405461040690600160c01b90046001600160401b031681565b34801561094857600080fd5b5061043860445481565b34801561095e57600080fd5b5061043861096d366004614b1f565b6001600160a01b031660009081526039602052604090205490565b61047b6109963660046144c4565b612cb0565b3480156109a757600080fd5b5061047b6109b6366004614c94565b612ce6565b3480156109c757600080fd5b5061047b6109d6366004614b1f565b612ee1565b3480156109e757600080fd5b50603e54610438565b3480156109fc57600080fd5b5061047b610a0b366004614cc0565b61302d565b348015610a1c57600080fd5b5061047b610a2b366004614d0b565b61328c565b348015610a3c57600080fd5b5061047b610a4b366004614d26565b61333b565b348015610a5c57600080fd5b50610705610a6b366004614b1f565b61341f565b348015610a7c57600080fd5b50610438613582565b348015610a9157600080fd5b5061047b610aa0366004614e0c565b6135b6565b348015610ab157600080fd5b5060415461040690600160401b90046001600160401b031681565b348015610ad857600080fd5b5061047b610ae73660046144c4565b6136e2565b348015610af857600080fd5b50610438610b073660046144c4565b613791565b348015610b1857600080fd5b5061047b6138b8565b348015610b2d57600080fd5b5061047b610b3c36600461471d565b613af9565b6000806201518060435442610b569190614f17565b610b609190614f40565b9050600062015180604454610b759190614f40565b905080821115610b8757479250505090565b6000610b938383614f17565b82604254610ba19190614f40565b610bab9190614f54565b9050610bb78147614f17565b935050505090565b600080610bd46000546001600160a01b031690565b6001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610c11573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610c359190614f6b565b604051600162e422bd60e01b03198152600160048201529091506000906001600160a01b0383169063c6e6f59290829063ff1bdd4390602401602060405180830381865afa158015610c8b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610caf9190614f88565b6040518263ffffffff1660e01b8152600401610ccd91815260200190565b602060405180830381865afa158015610cea573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610d0e9190614f88565b90508060335411610d1f5780610d23565b6033545b9250505090565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610d7b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610d9f9190614f6b565b6001600160a01b0316336001600160a01b031614610dd05760405163e2d4f15f60e01b815260040160405180910390fd5b6040805481516001600160401b03918216815290831660208201527fbf127022ae43ce46c7042f13c6e64c507f75f20314c2aee1b03b0cf208a10f39910160405180910390a16040805467ffffffffffffffff19166001600160401b0392909216919091179055565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff1615906001600160401b0316600081158015610e7e5750825b90506000826001600160401b03166001148015610e9a5750303b155b905081158015610ea8575080155b15610ec65760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff191660011785558315610ef057845460ff60401b1916600160401b1785555b610ef8613d33565b610f0188613d43565b610f0b8787613d6d565b8315610f5157845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050505050565b6000610f65613d87565b905090565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015610fbb573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610fdf9190614f6b565b6001600160a01b0316336001600160a01b03161461101057604051633734611360e01b815260040160405180910390fd5b6042543490156110735760006201518060445461102d9190614f40565b9050600062015180604354426110439190614f17565b61104d9190614f40565b90508181101561107057604051637f50d1ab60e11b815260040160405180910390fd5b50505b60428190554260438190556040805183815260208101929092527f40df43107e8b4d467127964bd3c966687c0a6a39aaede970755397fd09535e98910160405180910390a150565b6110c3613dd5565b6040546001600160401b03166000036110ef5760405163fe17a04160e01b815260040160405180910390fd5b3360006111046000546001600160a01b031690565b6001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611141573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906111659190614f6b565b604051600162e422bd60e01b03198152600481018690529091506000906001600160a01b0383169063ff1bdd4390602401602060405180830381865afa1580156111b3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906111d79190614f88565b90506111e1610bbf565b811015611201576040516307bd37ff60e01b815260040160405180910390fd5b611209610f5b565b81111561123d57611218610f5b565b604051636688dd2d60e11b815260040161123491815260200190565b60405180910390fd5b600061124882613791565b90508060000361126b57604051630d23170560e01b815260040160405180910390fd5b6041546000906402540be4009061129290600160c01b90046001600160401b031684614f54565b61129c9190614f40565b604051632770a7eb60e21b81526001600160a01b038781166004830152602482018a905291925090851690639dc29fac90604401600060405180830381600087803b1580156112ea57600080fd5b505af11580156112fe573d6000803e3d6000fd5b5050505082603560008282546113149190614fa1565b9091555061132490508284614f17565b92506113308183614f17565b603f60008282546113419190614fa1565b90915550506000546113bf906001600160a01b03166001600160a01b0316633b19e84a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611393573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906113b79190614f6b565b826000613e0d565b506113cc86846000613e0d565b5060408051848152602081018990529081018390526001600160a01b03808716919088169082907f628a6f39215835333501afcce023c08e1e61c026768b46f5076804d344e023e39060600160405180910390a4505050505061143c600160008051602061579183398151915255565b5050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611491573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906114b59190614f6b565b6001600160a01b0316336001600160a01b0316146114e65760405163e2d4f15f60e01b815260040160405180910390fd5b6201518081108061150257506114ff6201518082614fb4565b15155b156115205760405163afff5f7760e01b815260040160405180910390fd5b60445460408051918252602082018390527f1638f4dadb33895a0e192a35d63a2677a5b9dbe482091d94d8877d0919473320910160405180910390a1604455565b60405434906001600160401b031660000361158f5760405163fe17a04160e01b815260040160405180910390fd5b611597611e49565b8110156115b75760405163273d2bdb60e21b815260040160405180910390fd5b6115bf61199d565b8111156115df57604051633175986360e21b815260040160405180910390fd5b603f546000901561168d5760006115f4610f5b565b90508281101561161057611609600084613ec1565b9150611626565b61162361161d8483614f17565b84613ec1565b91505b603f5482111561164057603f805460009091559150611658565b81603f60008282546116529190614f17565b90915550505b6040518281527f98b37da5bc0dc3a6af25a940988aef0df1a74411148692d8d74aa066658173059060200160405180910390a1505b6116978183614fa1565b915060006116ad6000546001600160a01b031690565b6001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156116ea573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061170e9190614f6b565b6040516363737ac960e11b8152600481018590529091506000906001600160a01b0383169063c6e6f59290602401602060405180830381865afa158015611759573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061177d9190614f88565b90506001600160a01b0382166340c10f19336040516001600160e01b031960e084901b1681526001600160a01b03909116600482015260248101849052604401600060405180830381600087803b1580156117d757600080fd5b505af11580156117eb573d6000803e3d6000fd5b5050505083603460008282546118019190614fa1565b9091555050604080518581526020810183905233917f1449c6dd7851abc30abf37f57715f492010519147cc2652fbc38202c18a6ee90910160405180910390a250505050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611898573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906118bc9190614f6b565b6001600160a01b0316336001600160a01b0316146118ed5760405163e2d4f15f60e01b815260040160405180910390fd5b600061192e85858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613f9492505050565b6040516388b856a760e01b81526001600160a01b0385811660048301528481166024830152919250908216906388b856a7906044015b600060405180830381600087803b15801561197e57600080fd5b505af1158015611992573d6000803e3d6000fd5b505050505050505050565b6000806119b26000546001600160a01b031690565b6001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156119ef573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611a139190614f6b565b6001600160a01b03166301e1d1146040518163ffffffff1660e01b8152600401602060405180830381865afa158015611a50573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611a749190614f88565b9050603e54811115611a8857600091505090565b80603e54611a969190614f17565b91505090565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015611aed573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611b119190614f6b565b6001600160a01b0316336001600160a01b031614611b4257604051633734611360e01b815260040160405180910390fd5b6000611b4d84613f94565b6040516306ec6e8160e11b81529091506001600160a01b03821690630dd8dd0290611b7e908690869060040161508b565b6000604051808303816000875af1158015611b9d573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052611bc59190810190615155565b5050505050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611c1d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611c419190614f6b565b6001600160a01b0316336001600160a01b031614611c725760405163e2d4f15f60e01b815260040160405180910390fd5b6402540be4006001600160401b0382161115611cac57604051630513fb8f60e31b81526001600160401b0382166004820152602401611234565b604154604080516001600160401b03600160c01b9093048316815291831660208301527fb51bef650ff5ad43303dbe2e500a74d4fd1bdc9ae05f046bece330e82ae0ba87910160405180910390a1604180546001600160401b03909216600160c01b026001600160c01b03909216919091179055565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015611d73573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611d979190614f6b565b6001600160a01b0316336001600160a01b031614611dc857604051633734611360e01b815260040160405180910390fd5b6000611dd382613f94565b6040516336a2fa1960e21b81526001600160a01b0382166004820181905291925063da8be864906024016000604051808303816000875af1158015611e1c573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052611e449190810190615155565b505050565b600080611e5e6000546001600160a01b031690565b6001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611e9b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611ebf9190614f6b565b604051600162e422bd60e01b03198152600160048201526001600160a01b03919091169063ff1bdd4390602401602060405180830381865afa158015611f09573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611f2d9190614f88565b90508060325411611f3e5780611a96565b505060325490565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015611f97573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611fbb9190614f6b565b6001600160a01b0316336001600160a01b031614611fec57604051633734611360e01b815260040160405180910390fd5b611ff4613dd5565b8483811415806120045750808214155b15612022576040516367af08e760e11b815260040160405180910390fd5b612035816801bc16d674ec800000614f54565b61203d613582565b101561205c57604051635093740560e01b815260040160405180910390fd5b600061206789613f94565b905060005b8281101561215357816001600160a01b0316639b4e46346801bc16d674ec8000008b8b8581811061209f5761209f6151e5565b90506020028101906120b191906151fb565b8b8b878181106120c3576120c36151e5565b90506020028101906120d591906151fb565b8b8b898181106120e7576120e76151e5565b905060200201356040518763ffffffff1660e01b815260040161210e95949392919061526a565b6000604051808303818588803b15801561212757600080fd5b505af115801561213b573d6000803e3d6000fd5b5050505050808061214b906152a4565b91505061206c565b508860405161216291906152e1565b60405180910390207f82893d4437db377df0abca554a4a9ef54de226c99efe74077b214d6d3390e7dd898960405161219b929190615392565b60405180910390a250506121bc600160008051602061579183398151915255565b50505050505050565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015612216573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061223a9190614f6b565b6001600160a01b0316336001600160a01b03161461226b57604051633734611360e01b815260040160405180910390fd5b600061227687613f94565b6040516319a021cb60e11b81529091506001600160a01b038216906333404396906122ad9089908990899089908990600401615489565b600060405180830381600087803b1580156122c757600080fd5b505af11580156122db573d6000803e3d6000fd5b5050505050505050505050565b6036546037546060916122fa91614f17565b6001600160401b038111156123115761231161458c565b60405190808252806020026020018201604052801561235657816020015b604080518082019091526000808252602082015281526020019060019003908161232f5790505b506036549091506000905b6037548110156123ec576037818154811061237e5761237e6151e5565b60009182526020918290206040805180820190915260029092020180546001600160a01b03168252600101549181019190915283836123bc816152a4565b9450815181106123ce576123ce6151e5565b602002602001018190525080806123e4906152a4565b915050612361565b505090565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015612442573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906124669190614f6b565b6001600160a01b0316336001600160a01b0316146124975760405163e2d4f15f60e01b815260040160405180910390fd5b6402540be4006001600160401b03841611156124d157604051630513fb8f60e31b81526001600160401b0384166004820152602401611234565b6402540be4006001600160401b038316111561250b57604051630513fb8f60e31b81526001600160401b0383166004820152602401611234565b6402540be4006001600160401b038216111561254557604051630513fb8f60e31b81526001600160401b0382166004820152602401611234565b6040805477ffffffffffffffffffffffffffffffff00000000000000001916600160401b6001600160401b0386811691820267ffffffffffffffff60801b191692909217600160801b868416908102919091176001600160c01b0316600160c01b93861693840217845583519182526020820152918201527fbdd363e1b2c509839731ca7a94e48c8835b6de783af60bbfaeb08b9ba3862bea906060015b60405180910390a1505050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015612641573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906126659190614f6b565b6001600160a01b0316336001600160a01b0316146126965760405163e2d4f15f60e01b815260040160405180910390fd5b60335460408051918252602082018390527f984016d328adef81f3cc09f8ea9e3420f85d390635be94215c432e83837aa0a2910160405180910390a1603355565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015612728573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061274c9190614f6b565b6001600160a01b0316336001600160a01b03161461277d5760405163e2d4f15f60e01b815260040160405180910390fd5b60325460408051918252602082018390527fca11c8a4c461b60c9f485404c272650c2aaae260b2067d72e9924abb68556593910160405180910390a1603255565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561280f573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906128339190614f6b565b6001600160a01b0316336001600160a01b0316146128645760405163e2d4f15f60e01b815260040160405180910390fd5b6402540be4006001600160401b038416111561289e57604051630513fb8f60e31b81526001600160401b0384166004820152602401611234565b6402540be4006001600160401b03831611156128d857604051630513fb8f60e31b81526001600160401b0383166004820152602401611234565b6402540be4006001600160401b038216111561291257604051630513fb8f60e31b81526001600160401b0382166004820152602401611234565b604180546001600160401b03838116600160801b810267ffffffffffffffff60801b19878416600160401b81026fffffffffffffffffffffffffffffffff19909616948a16948517959095171617909355604080519182526020820192909252908101919091527ff3f49a7eaa29cb38bb074c44803e3ef1f47960b9529ee0c0a3aa47c0d4f93a9d906060016125e3565b60006129b66129b0610f5b565b83613ec1565b92915050565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015612a0d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612a319190614f6b565b6001600160a01b0316336001600160a01b031614612a6257604051633734611360e01b815260040160405180910390fd5b6000612a6d83613f94565b6040516388676cad60e01b815283151560048201529091506001600160a01b038216906388676cad90602401600060405180830381600087803b158015612ab357600080fd5b505af11580156121bc573d6000803e3d6000fd5b6000603d6000612b0c85858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613fe892505050565b81526020810191909152604001600020546001600160a01b03169392505050565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015612b7e573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612ba29190614f6b565b6001600160a01b0316336001600160a01b031614612bd357604051633734611360e01b815260040160405180910390fd5b6000612bde84613f94565b9050806001600160a01b031663dda3346c8484612c036000546001600160a01b031690565b6001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015612c40573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612c649190614f6b565b6040518463ffffffff1660e01b8152600401612c82939291906155e4565b600060405180830381600087803b158015612c9c57600080fd5b505af1158015610f51573d6000803e3d6000fd5b612cb8611561565b60405181907f8c355e502e4eade8ac341bcb08dcfca0ae238efe6431e5a63b75be9026d9163b90600090a250565b612cee613dd5565b600080543391906001600160a01b03166001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa158015612d3b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612d5f9190614f6b565b604051600162e422bd60e01b03198152600481018590529091506000906001600160a01b0383169063ff1bdd4390602401602060405180830381865afa158015612dad573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612dd19190614f88565b9050612ddb610bbf565b811015612dfb576040516307bd37ff60e01b815260040160405180910390fd5b604051632770a7eb60e21b81526001600160a01b03848116600483015260248201869052831690639dc29fac90604401600060405180830381600087803b158015612e4557600080fd5b505af1158015612e59573d6000803e3d6000fd5b50505050612e678582613ff3565b8060356000828254612e799190614fa1565b909155505060408051828152602081018690526001600160a01b0380881692908616917f06cc7e90b4f2b554a9614b0caa84f909f3498c820ae47c731f490c28c07f7d3b910160405180910390a350505061143c600160008051602061579183398151915255565b612ee9613dd5565b6001600160a01b038116612f10576040516321cb944560e11b815260040160405180910390fd5b6001600160a01b0381166000908152603c602052604081205490819003612f4a57604051630d23170560e01b815260040160405180910390fd5b603b54612f55610b41565b1015612f7457604051635093740560e01b815260040160405180910390fd5b80603b6000828254612f869190614f17565b90915550506001600160a01b0382166000908152603c60205260408120819055612fb1838383613e0d565b905080612fd157604051634dbb90c960e01b815260040160405180910390fd5b60405182815233906001600160a01b038516907febc6a30f46090cafd1f043241dbc148c4310b7026aae29ff950ea25a5411879b9060200160405180910390a3505061302a600160008051602061579183398151915255565b50565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa15801561307e573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906130a29190614f6b565b6001600160a01b0316336001600160a01b0316146130d357604051633734611360e01b815260040160405180910390fd5b600061311484848080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613f9492505050565b90506000479050816001600160a01b031663ef8cdf7c6040518163ffffffff1660e01b8152600401600060405180830381600087803b15801561315657600080fd5b505af115801561316a573d6000803e3d6000fd5b505050506000814761317c9190614f17565b9050808411156131a957604051634f274ad560e11b81526004810182905260248101859052604401611234565b600080546001600160a01b03166001600160a01b0316633b19e84a6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156131f3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906132179190614f6b565b9050841561322d5761322b81866000613e0d565b505b806001600160a01b0316846001600160a01b03167f2ee827557318e24ad8fdb9f616bbfd73b44656ea23439623780d719c369f0c1d878560405161327b929190918252602082015260400190565b60405180910390a350505050505050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156132dd573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906133019190614f6b565b6001600160a01b0316336001600160a01b0316146133325760405163e2d4f15f60e01b815260040160405180910390fd5b61302a81614114565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa15801561338c573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906133b09190614f6b565b6001600160a01b0316336001600160a01b0316146133e157604051633734611360e01b815260040160405180910390fd5b60006133ec85613f94565b60405163eea9064b60e01b81529091506001600160a01b0382169063eea9064b9061196490879087908790600401615622565b60365460375460609161343191614f17565b6001600160401b038111156134485761344861458c565b60405190808252806020026020018201604052801561348d57816020015b60408051808201909152600080825260208201528152602001906001900390816134665790505b506036549091506000905b60375481101561355f57836001600160a01b0316603782815481106134bf576134bf6151e5565b60009182526020909120600290910201546001600160a01b03160361354d57603781815481106134f1576134f16151e5565b60009182526020918290206040805180820190915260029092020180546001600160a01b031682526001015491810191909152838361352f816152a4565b945081518110613541576135416151e5565b60200260200101819052505b80613557816152a4565b915050613498565b50600081835161356f9190614f17565b9050801561357b578183525b5050919050565b60008061358d610f5b565b905060006135996141b4565b9050818111156135ac5760009250505090565b610d238183614f17565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015613607573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061362b9190614f6b565b6001600160a01b0316336001600160a01b03161461365c57604051633734611360e01b815260040160405180910390fd5b60006136678a613f94565b604051633f65cf1960e01b81529091506001600160a01b03821690633f65cf19906136a4908c908c908c908c908c908c908c908c906004016156d1565b600060405180830381600087803b1580156136be57600080fd5b505af11580156136d2573d6000803e3d6000fd5b5050505050505050505050505050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015613733573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906137579190614f6b565b6001600160a01b0316336001600160a01b0316146137885760405163e2d4f15f60e01b815260040160405180910390fd5b61302a816142b0565b60008061379c610f5b565b9050808311156137c257604051636688dd2d60e11b815260048101829052602401611234565b60006137cc6141b4565b60415490915073__$ec7a21a4973ff4f9d32c2f23e932567ae1$__9063cfe6424490869085906402540be4009061381390600160801b90046001600160401b031687614f54565b61381d9190614f40565b6041546040516001600160e01b031960e087901b1681526004810194909452602484019290925260448301526001600160401b03600160401b82048116606484015216608482015260a4810184905260c401602060405180830381865af415801561388c573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906138b09190614f88565b949350505050565b60008054906101000a90046001600160a01b03166001600160a01b031663e7f43c686040518163ffffffff1660e01b8152600401602060405180830381865afa158015613909573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061392d9190614f6b565b6001600160a01b0316336001600160a01b03161461395e57604051633734611360e01b815260040160405180910390fd5b613966613dd5565b6000613970613582565b603754603654919250905b818110801561398a5750600083115b801561399d5750603a5463ffffffff165a115b15613ade576000603782815481106139b7576139b76151e5565b60009182526020918290206040805180820190915260029092020180546001600160a01b031680835260019091015492820192909252915015806139fd57506020810151155b15613a1357613a0b826152a4565b91505061397b565b8060200151841015613a255750613ade565b60208082015182516001600160a01b03166000908152603990925260408220805491929091613a55908490614f17565b9091555050602081015160388054600090613a71908490614f17565b90915550506020810151613a859085614f17565b935060378281548110613a9a57613a9a6151e5565b60009182526020822060029091020180546001600160a01b031916815560010155613ac4826152a4565b9150613ad881600001518260200151614312565b5061397b565b6036555050600160008051602061579183398151915255565b565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015613b4a573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613b6e9190614f6b565b6001600160a01b0316336001600160a01b031614613b9f5760405163e2d4f15f60e01b815260040160405180910390fd5b805160208201206000906000818152603d60205260409020549091506001600160a01b03168015613be357604051638b1a6ce760e01b815260040160405180910390fd5b6000546001600160a01b03166001600160a01b0316633f69e0f76040518163ffffffff1660e01b8152600401602060405180830381865afa158015613c2c573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613c509190614f6b565b6001600160a01b0316639ce953c86040518163ffffffff1660e01b81526004016020604051808303816000875af1158015613c8f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190613cb39190614f6b565b6000838152603d60205260409081902080546001600160a01b0319166001600160a01b03841617905551909150613ceb9084906152e1565b6040519081900381206001600160a01b0383168252907f187f9ad308b4b25229d086a632166f351b45905117cc3162fb9ec6873b9a9ce89060200160405180910390a2505050565b613d3b614398565b613af76143e1565b613d4b614398565b600080546001600160a01b0319166001600160a01b0392909216919091179055565b613d75614398565b613d7e82614114565b61143c816142b0565b600080613d92610b41565b90506000613d9f603b5490565b603f5490915082613db08284614fa1565b1115613dc0576000935050505090565b80613dcb8385614f17565b610bb79190614f17565b600080516020615791833981519152805460011901613e0757604051633ee5aeb560e01b815260040160405180910390fd5b60029055565b600082613e18610b41565b1015613e3757604051635093740560e01b815260040160405180910390fd5b838215613e51576000806000808785612710f19150613ea5565b6040516001600160a01b038216908590600081818185875af1925050503d8060008114613e9a576040519150601f19603f3d011682016040523d82523d6000602084013e613e9f565b606091505b50909250505b509392505050565b600160008051602061579183398151915255565b600080613ecc6141b4565b60405490915073__$ec7a21a4973ff4f9d32c2f23e932567ae1$__9063fea65f7290859087906402540be40090613f1390600160c01b90046001600160401b031687614f54565b613f1d9190614f40565b6040805490516001600160e01b031960e087901b1681526004810194909452602484019290925260448301526001600160401b03600160801b820481166064840152600160401b90910416608482015260a4810184905260c401602060405180830381865af415801561388c573d6000803e3d6000fd5b6000603d6000613fa984805160209091012090565b81526020810191909152604001600020546001600160a01b0316905080613fe357604051631b355a8760e31b815260040160405180910390fd5b919050565b805160209091012090565b6001600160a01b03821661401a576040516321cb944560e11b815260040160405180910390fd5b8060000361403b57604051630d23170560e01b815260040160405180910390fd5b806038600082825461404d9190614fa1565b90915550506001600160a01b0382166000908152603960205260408120805483929061407a908490614fa1565b9091555050604080518082019091526001600160a01b039283168152602081019182526037805460018101825560009190915290517f42a7b7dd785cd69714a189dffb3fd7d7174edc9ece837694ce50f7078f7c31ae600290920291820180546001600160a01b0319169190941617909255517f42a7b7dd785cd69714a189dffb3fd7d7174edc9ece837694ce50f7078f7c31af90910155565b6301c9c38063ffffffff82161180614130575063ffffffff8116155b1561415457604051633bbbd90160e21b81526301c9c3806004820152602401611234565b603a546040805163ffffffff928316815291831660208301527f55c1474264b8038534b3e34b09a3a99d1f3436634d4e34fa13cea4c33c9a832e910160405180910390a1603a805463ffffffff191663ffffffff92909216919091179055565b60006402540be4006141ce6000546001600160a01b031690565b6001600160a01b031663ec6c350c6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561420b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061422f9190614f6b565b6001600160a01b03166301e1d1146040518163ffffffff1660e01b8152600401602060405180830381865afa15801561426c573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906142909190614f88565b6040546142a691906001600160401b0316614f54565b610f659190614f40565b806000036142d157604051630d23170560e01b815260040160405180910390fd5b603e5460408051918252602082018390527f3e6e3950c3fc7a0bbe5c0ded9285010d1c1ef843817fed86973510375eb07418910160405180910390a1603e55565b80603b60008282546143249190614fa1565b90915550506001600160a01b0382166000908152603c602052604081208054839290614351908490614fa1565b90915550506040518181526001600160a01b038316907ff4426c1412b22225874f3898e67e34c12ba628bff6d89fd87ba593927a80b4219060200160405180910390a25050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff16613af757604051631afcd79f60e31b815260040160405180910390fd5b613ead614398565b80356001600160401b0381168114613fe357600080fd5b60006020828403121561441257600080fd5b61441b826143e9565b9392505050565b6001600160a01b038116811461302a57600080fd5b803563ffffffff81168114613fe357600080fd5b60008060006060848603121561446057600080fd5b833561446b81614422565b925061447960208501614437565b9150604084013590509250925092565b8035613fe381614422565b600080604083850312156144a757600080fd5b8235915060208301356144b981614422565b809150509250929050565b6000602082840312156144d657600080fd5b5035919050565b60008083601f8401126144ef57600080fd5b5081356001600160401b0381111561450657600080fd5b60208301915083602082850101111561451e57600080fd5b9250929050565b6000806000806060858703121561453b57600080fd5b84356001600160401b0381111561455157600080fd5b61455d878288016144dd565b909550935050602085013561457181614422565b9150604085013561458181614422565b939692955090935050565b634e487b7160e01b600052604160045260246000fd5b604080519081016001600160401b03811182821017156145c4576145c461458c565b60405290565b604051601f8201601f191681016001600160401b03811182821017156145f2576145f261458c565b604052919050565b60006001600160401b038311156146135761461361458c565b614626601f8401601f19166020016145ca565b905082815283838301111561463a57600080fd5b828260208301376000602084830101529392505050565b600082601f83011261466257600080fd5b61441b838335602085016145fa565b60008083601f84011261468357600080fd5b5081356001600160401b0381111561469a57600080fd5b6020830191508360208260051b850101111561451e57600080fd5b6000806000604084860312156146ca57600080fd5b83356001600160401b03808211156146e157600080fd5b6146ed87838801614651565b9450602086013591508082111561470357600080fd5b5061471086828701614671565b9497909650939450505050565b60006020828403121561472f57600080fd5b81356001600160401b0381111561474557600080fd5b6138b084828501614651565b60008060008060008060006080888a03121561476c57600080fd5b87356001600160401b038082111561478357600080fd5b61478f8b838c01614651565b985060208a01359150808211156147a557600080fd5b6147b18b838c01614671565b909850965060408a01359150808211156147ca57600080fd5b6147d68b838c01614671565b909650945060608a01359150808211156147ef57600080fd5b506147fc8a828b01614671565b989b979a50959850939692959293505050565b60006001600160401b038211156148285761482861458c565b5060051b60200190565b600082601f83011261484357600080fd5b813560206148586148538361480f565b6145ca565b82815260059290921b8401810191818101908684111561487757600080fd5b8286015b8481101561489b57803561488e81614422565b835291830191830161487b565b509695505050505050565b600082601f8301126148b757600080fd5b813560206148c76148538361480f565b82815260059290921b840181019181810190868411156148e657600080fd5b8286015b8481101561489b5780356001600160401b038111156149095760008081fd5b6149178986838b0101614832565b8452509183019183016148ea565b600082601f83011261493657600080fd5b813560206149466148538361480f565b82815260059290921b8401810191818101908684111561496557600080fd5b8286015b8481101561489b5780358352918301918301614969565b80358015158114613fe357600080fd5b600082601f8301126149a157600080fd5b813560206149b16148538361480f565b82815260059290921b840181019181810190868411156149d057600080fd5b8286015b8481101561489b576149e581614980565b83529183019183016149d4565b60008060008060008060a08789031215614a0b57600080fd5b86356001600160401b0380821115614a2257600080fd5b614a2e8a838b01614651565b97506020890135915080821115614a4457600080fd5b614a508a838b01614671565b90975095506040890135915080821115614a6957600080fd5b614a758a838b016148a6565b94506060890135915080821115614a8b57600080fd5b614a978a838b01614925565b93506080890135915080821115614aad57600080fd5b50614aba89828a01614990565b9150509295509295509295565b602080825282518282018190526000919060409081850190868401855b82811015614b1257815180516001600160a01b03168552860151868501529284019290850190600101614ae4565b5091979650505050505050565b600060208284031215614b3157600080fd5b813561441b81614422565b600080600060608486031215614b5157600080fd5b614b5a846143e9565b9250614b68602085016143e9565b9150614b76604085016143e9565b90509250925092565b60008060408385031215614b9257600080fd5b82356001600160401b03811115614ba857600080fd5b614bb485828601614651565b925050614bc360208401614980565b90509250929050565b60008060208385031215614bdf57600080fd5b82356001600160401b03811115614bf557600080fd5b614c01858286016144dd565b90969095509350505050565b600080600060608486031215614c2257600080fd5b83356001600160401b0380821115614c3957600080fd5b614c4587838801614651565b94506020860135915080821115614c5b57600080fd5b614c6787838801614832565b93506040860135915080821115614c7d57600080fd5b50614c8a86828701614925565b9150509250925092565b60008060408385031215614ca757600080fd5b8235614cb281614422565b946020939093013593505050565b600080600060408486031215614cd557600080fd5b83356001600160401b03811115614ceb57600080fd5b614cf7868287016144dd565b909790965060209590950135949350505050565b600060208284031215614d1d57600080fd5b61441b82614437565b60008060008060808587031215614d3c57600080fd5b84356001600160401b0380821115614d5357600080fd5b614d5f88838901614651565b955060208701359150614d7182614422565b90935060408601359080821115614d8757600080fd5b9086019060408289031215614d9b57600080fd5b614da36145a2565b823582811115614db257600080fd5b83019150601f82018913614dc557600080fd5b614dd4898335602085016145fa565b815260209283013592810192909252509396929550929360600135925050565b600060408284031215614e0657600080fd5b50919050565b600080600080600080600080600060c08a8c031215614e2a57600080fd5b89356001600160401b0380821115614e4157600080fd5b614e4d8d838e01614651565b9a50614e5b60208d016143e9565b995060408c0135915080821115614e7157600080fd5b614e7d8d838e01614df4565b985060608c0135915080821115614e9357600080fd5b614e9f8d838e01614671565b909850965060808c0135915080821115614eb857600080fd5b614ec48d838e01614671565b909650945060a08c0135915080821115614edd57600080fd5b50614eea8c828d01614671565b915080935050809150509295985092959850929598565b634e487b7160e01b600052601160045260246000fd5b818103818111156129b6576129b6614f01565b634e487b7160e01b600052601260045260246000fd5b600082614f4f57614f4f614f2a565b500490565b80820281158282048414176129b6576129b6614f01565b600060208284031215614f7d57600080fd5b815161441b81614422565b600060208284031215614f9a57600080fd5b5051919050565b808201808211156129b6576129b6614f01565b600082614fc357614fc3614f2a565b500690565b6000808335601e19843603018112614fdf57600080fd5b83016020810192503590506001600160401b03811115614ffe57600080fd5b8060051b360382131561451e57600080fd5b8183526000602080850194508260005b8581101561504e57813561503381614422565b6001600160a01b031687529582019590820190600101615020565b509495945050505050565b81835260006001600160fb1b0383111561507257600080fd5b8260051b80836020870137939093016020019392505050565b60208082528181018390526000906040808401600586901b850182018785805b8981101561514657888403603f190185528235368c9003605e190181126150d0578283fd5b8b0160606150de8280614fc8565b8288526150ee8389018284615010565b925050506150fe89830183614fc8565b8783038b890152615110838284615059565b9250505087820135915061512382614422565b6001600160a01b03919091169487019490945293860193918601916001016150ab565b50919998505050505050505050565b6000602080838503121561516857600080fd5b82516001600160401b0381111561517e57600080fd5b8301601f8101851361518f57600080fd5b805161519d6148538261480f565b81815260059190911b820183019083810190878311156151bc57600080fd5b928401925b828410156151da578351825292840192908401906151c1565b979650505050505050565b634e487b7160e01b600052603260045260246000fd5b6000808335601e1984360301811261521257600080fd5b8301803591506001600160401b0382111561522c57600080fd5b60200191503681900382131561451e57600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b60608152600061527e606083018789615241565b8281036020840152615291818688615241565b9150508260408301529695505050505050565b6000600182016152b6576152b6614f01565b5060010190565b60005b838110156152d85781810151838201526020016152c0565b50506000910152565b600082516152f38184602087016152bd565b9190910192915050565b6000808335601e1984360301811261531457600080fd5b83016020810192503590506001600160401b0381111561533357600080fd5b80360382131561451e57600080fd5b81835260006020808501808196508560051b810191508460005b87811015614b1257828403895261537382886152fd565b61537e868284615241565b9a87019a955050509084019060010161535c565b6020815260006138b0602083018486615342565b600081518084526020808501945080840160005b8381101561504e5781516001600160a01b0316875295820195908201906001016153ba565b600081518084526020808501808196508360051b8101915082860160005b85811015614b125782840389526154158483516153a6565b988501989350908401906001016153fd565b600081518084526020808501945080840160005b8381101561504e5781518752958201959082019060010161543b565b600081518084526020808501945080840160005b8381101561504e57815115158752958201959082019060010161546b565b608080825281810186905260009060a0808401600589901b850182018a85805b8c81101561559857888403609f190185528235368f900360de190181126154ce578283fd5b8e0160e081356154dd81614422565b6001600160a01b031686526020828101356154f781614422565b6001600160a01b0316818801526040615511848201614489565b6001600160a01b031690880152606083810135908801526155338a8401614437565b63ffffffff168a880152615549838a0184614fc8565b838b8a015261555b848a018284615010565b9350505060c061556d81850185614fc8565b9450888403828a0152615581848683615059565b9983019998505050949094019350506001016154a9565b50505085810360208701526155ad818a6153df565b935050505082810360408401526155c48186615427565b905082810360608401526155d88185615457565b98975050505050505050565b6060815260006155f760608301866153a6565b82810360208401526156098186615427565b91505060018060a01b0383166040830152949350505050565b60018060a01b038416815260606020820152600083516040606084015280518060a08501526156588160c08601602085016152bd565b60209590950151608084015250506040810191909152601f91909101601f19160160c001919050565b81835260006020808501808196508560051b810191508460005b87811015614b125782840389526156b28288614fc8565b6156bd868284615059565b9a87019a955050509084019060010161569b565b6001600160401b03891681526000602060a081840152893560a08401526156fa818b018b6152fd565b604060c086015261570f60e086018284615241565b85810360408701528a81528b9250830190506000805b8b81101561575557833564ffffffffff8116808214615742578384fd5b8452509284019291840191600101615725565b5050848103606086015261576a81898b615342565b925050508281036080840152615781818587615681565b9b9a505050505050505050505056fe9b779b17422d0df92223018b32b4d1fa46e071723d6817e2486d003becc55f00a2646970667358221220569cbf6b526c27890a5724ca5afb2f56b934e00d226306aa91f9595955553e2364736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) CALLGASLIMIT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "CALL_GAS_LIMIT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CALLGASLIMIT() (*big.Int, error) {
+	return _Contract.Contract.CALLGASLIMIT(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) CALLGASLIMIT() (*big.Int, error) {
+	return _Contract.Contract.CALLGASLIMIT(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) MAXPERCENT(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "MAX_PERCENT")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MAXPERCENT() (uint64, error) {
+	return _Contract.Contract.MAXPERCENT(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MAXPERCENT() (uint64, error) {
+	return _Contract.Contract.MAXPERCENT(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) AvailableToStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "availableToStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) AvailableToStake() (*big.Int, error) {
+	return _Contract.Contract.AvailableToStake(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) AvailableToStake() (*big.Int, error) {
+	return _Contract.Contract.AvailableToStake(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) CalculateFlashUnstakeFee(opts *bind.CallOpts, amount *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "calculateFlashUnstakeFee", amount)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CalculateFlashUnstakeFee(amount *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateFlashUnstakeFee(&_Contract.CallOpts, amount)
+}
+
+func (_Contract *ContractCallerSession) CalculateFlashUnstakeFee(amount *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateFlashUnstakeFee(&_Contract.CallOpts, amount)
+}
+
+func (_Contract *ContractCaller) CalculateStakeBonus(opts *bind.CallOpts, amount *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "calculateStakeBonus", amount)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CalculateStakeBonus(amount *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateStakeBonus(&_Contract.CallOpts, amount)
+}
+
+func (_Contract *ContractCallerSession) CalculateStakeBonus(amount *big.Int) (*big.Int, error) {
+	return _Contract.Contract.CalculateStakeBonus(&_Contract.CallOpts, amount)
+}
+
+func (_Contract *ContractCaller) ClaimableOf(opts *bind.CallOpts, claimer common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "claimableOf", claimer)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) ClaimableOf(claimer common.Address) (*big.Int, error) {
+	return _Contract.Contract.ClaimableOf(&_Contract.CallOpts, claimer)
+}
+
+func (_Contract *ContractCallerSession) ClaimableOf(claimer common.Address) (*big.Int, error) {
+	return _Contract.Contract.ClaimableOf(&_Contract.CallOpts, claimer)
+}
+
+func (_Contract *ContractCaller) Config(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "config")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) CurrentRewards(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "currentRewards")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) CurrentRewards() (*big.Int, error) {
+	return _Contract.Contract.CurrentRewards(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) CurrentRewards() (*big.Int, error) {
+	return _Contract.Contract.CurrentRewards(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetFlashCapacity(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getFlashCapacity")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetFlashCapacity() (*big.Int, error) {
+	return _Contract.Contract.GetFlashCapacity(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetFlashCapacity() (*big.Int, error) {
+	return _Contract.Contract.GetFlashCapacity(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetFreeBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getFreeBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetFreeBalance() (*big.Int, error) {
+	return _Contract.Contract.GetFreeBalance(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetFreeBalance() (*big.Int, error) {
+	return _Contract.Contract.GetFreeBalance(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetMinStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getMinStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetMinStake() (*big.Int, error) {
+	return _Contract.Contract.GetMinStake(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetMinStake() (*big.Int, error) {
+	return _Contract.Contract.GetMinStake(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetMinUnstake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getMinUnstake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetMinUnstake() (*big.Int, error) {
+	return _Contract.Contract.GetMinUnstake(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetMinUnstake() (*big.Int, error) {
+	return _Contract.Contract.GetMinUnstake(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetPending(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getPending")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetPending() (*big.Int, error) {
+	return _Contract.Contract.GetPending(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetPending() (*big.Int, error) {
+	return _Contract.Contract.GetPending(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetRestaker(opts *bind.CallOpts, provider string) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getRestaker", provider)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetRestaker(provider string) (common.Address, error) {
+	return _Contract.Contract.GetRestaker(&_Contract.CallOpts, provider)
+}
+
+func (_Contract *ContractCallerSession) GetRestaker(provider string) (common.Address, error) {
+	return _Contract.Contract.GetRestaker(&_Contract.CallOpts, provider)
+}
+
+func (_Contract *ContractCaller) GetTotalClaimable(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getTotalClaimable")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetTotalClaimable() (*big.Int, error) {
+	return _Contract.Contract.GetTotalClaimable(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetTotalClaimable() (*big.Int, error) {
+	return _Contract.Contract.GetTotalClaimable(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetTotalPendingUnstakes(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getTotalPendingUnstakes")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetTotalPendingUnstakes() (*big.Int, error) {
+	return _Contract.Contract.GetTotalPendingUnstakes(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetTotalPendingUnstakes() (*big.Int, error) {
+	return _Contract.Contract.GetTotalPendingUnstakes(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetTotalUnstakesOf(opts *bind.CallOpts, recipient common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getTotalUnstakesOf", recipient)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetTotalUnstakesOf(recipient common.Address) (*big.Int, error) {
+	return _Contract.Contract.GetTotalUnstakesOf(&_Contract.CallOpts, recipient)
+}
+
+func (_Contract *ContractCallerSession) GetTotalUnstakesOf(recipient common.Address) (*big.Int, error) {
+	return _Contract.Contract.GetTotalUnstakesOf(&_Contract.CallOpts, recipient)
+}
+
+func (_Contract *ContractCaller) GetUnstakes(opts *bind.CallOpts) ([]IRestakingPoolUnstake, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getUnstakes")
+
+	if err != nil {
+		return *new([]IRestakingPoolUnstake), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IRestakingPoolUnstake)).(*[]IRestakingPoolUnstake)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetUnstakes() ([]IRestakingPoolUnstake, error) {
+	return _Contract.Contract.GetUnstakes(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) GetUnstakes() ([]IRestakingPoolUnstake, error) {
+	return _Contract.Contract.GetUnstakes(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) GetUnstakesOf(opts *bind.CallOpts, recipient common.Address) ([]IRestakingPoolUnstake, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "getUnstakesOf", recipient)
+
+	if err != nil {
+		return *new([]IRestakingPoolUnstake), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IRestakingPoolUnstake)).(*[]IRestakingPoolUnstake)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) GetUnstakesOf(recipient common.Address) ([]IRestakingPoolUnstake, error) {
+	return _Contract.Contract.GetUnstakesOf(&_Contract.CallOpts, recipient)
+}
+
+func (_Contract *ContractCallerSession) GetUnstakesOf(recipient common.Address) ([]IRestakingPoolUnstake, error) {
+	return _Contract.Contract.GetUnstakesOf(&_Contract.CallOpts, recipient)
+}
+
+func (_Contract *ContractCaller) HasClaimable(opts *bind.CallOpts, claimer common.Address) (bool, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "hasClaimable", claimer)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) HasClaimable(claimer common.Address) (bool, error) {
+	return _Contract.Contract.HasClaimable(&_Contract.CallOpts, claimer)
+}
+
+func (_Contract *ContractCallerSession) HasClaimable(claimer common.Address) (bool, error) {
+	return _Contract.Contract.HasClaimable(&_Contract.CallOpts, claimer)
+}
+
+func (_Contract *ContractCaller) MaxBonusRate(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "maxBonusRate")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MaxBonusRate() (uint64, error) {
+	return _Contract.Contract.MaxBonusRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MaxBonusRate() (uint64, error) {
+	return _Contract.Contract.MaxBonusRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) MaxFlashFeeRate(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "maxFlashFeeRate")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MaxFlashFeeRate() (uint64, error) {
+	return _Contract.Contract.MaxFlashFeeRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MaxFlashFeeRate() (uint64, error) {
+	return _Contract.Contract.MaxFlashFeeRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) MaxTVL(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "maxTVL")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) MaxTVL() (*big.Int, error) {
+	return _Contract.Contract.MaxTVL(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) MaxTVL() (*big.Int, error) {
+	return _Contract.Contract.MaxTVL(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) OptimalBonusRate(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "optimalBonusRate")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) OptimalBonusRate() (uint64, error) {
+	return _Contract.Contract.OptimalBonusRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) OptimalBonusRate() (uint64, error) {
+	return _Contract.Contract.OptimalBonusRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) OptimalUnstakeRate(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "optimalUnstakeRate")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) OptimalUnstakeRate() (uint64, error) {
+	return _Contract.Contract.OptimalUnstakeRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) OptimalUnstakeRate() (uint64, error) {
+	return _Contract.Contract.OptimalUnstakeRate(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) ProtocolFee(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "protocolFee")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) ProtocolFee() (uint64, error) {
+	return _Contract.Contract.ProtocolFee(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) ProtocolFee() (uint64, error) {
+	return _Contract.Contract.ProtocolFee(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) RewardsTimeline(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "rewardsTimeline")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) RewardsTimeline() (*big.Int, error) {
+	return _Contract.Contract.RewardsTimeline(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) RewardsTimeline() (*big.Int, error) {
+	return _Contract.Contract.RewardsTimeline(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) StakeBonusAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "stakeBonusAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) StakeBonusAmount() (*big.Int, error) {
+	return _Contract.Contract.StakeBonusAmount(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) StakeBonusAmount() (*big.Int, error) {
+	return _Contract.Contract.StakeBonusAmount(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) StakeUtilizationKink(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "stakeUtilizationKink")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) StakeUtilizationKink() (uint64, error) {
+	return _Contract.Contract.StakeUtilizationKink(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) StakeUtilizationKink() (uint64, error) {
+	return _Contract.Contract.StakeUtilizationKink(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) StartTimeline(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "startTimeline")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) StartTimeline() (*big.Int, error) {
+	return _Contract.Contract.StartTimeline(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) StartTimeline() (*big.Int, error) {
+	return _Contract.Contract.StartTimeline(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) TargetCapacity(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "targetCapacity")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) TargetCapacity() (uint64, error) {
+	return _Contract.Contract.TargetCapacity(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) TargetCapacity() (uint64, error) {
+	return _Contract.Contract.TargetCapacity(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) TotalAssets() (*big.Int, error) {
+	return _Contract.Contract.TotalAssets(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) TotalAssets() (*big.Int, error) {
+	return _Contract.Contract.TotalAssets(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) UnstakeUtilizationKink(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "unstakeUtilizationKink")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) UnstakeUtilizationKink() (uint64, error) {
+	return _Contract.Contract.UnstakeUtilizationKink(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) UnstakeUtilizationKink() (uint64, error) {
+	return _Contract.Contract.UnstakeUtilizationKink(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractTransactor) AddRestaker(opts *bind.TransactOpts, provider string) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "addRestaker", provider)
+}
+
+func (_Contract *ContractSession) AddRestaker(provider string) (*types.Transaction, error) {
+	return _Contract.Contract.AddRestaker(&_Contract.TransactOpts, provider)
+}
+
+func (_Contract *ContractTransactorSession) AddRestaker(provider string) (*types.Transaction, error) {
+	return _Contract.Contract.AddRestaker(&_Contract.TransactOpts, provider)
+}
+
+func (_Contract *ContractTransactor) AddRewards(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "addRewards")
+}
+
+func (_Contract *ContractSession) AddRewards() (*types.Transaction, error) {
+	return _Contract.Contract.AddRewards(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) AddRewards() (*types.Transaction, error) {
+	return _Contract.Contract.AddRewards(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) BatchDeposit(opts *bind.TransactOpts, provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "batchDeposit", provider, pubkeys, signatures, deposit_data_roots)
+}
+
+func (_Contract *ContractSession) BatchDeposit(provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.BatchDeposit(&_Contract.TransactOpts, provider, pubkeys, signatures, deposit_data_roots)
+}
+
+func (_Contract *ContractTransactorSession) BatchDeposit(provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.BatchDeposit(&_Contract.TransactOpts, provider, pubkeys, signatures, deposit_data_roots)
+}
+
+func (_Contract *ContractTransactor) ClaimRestaker(opts *bind.TransactOpts, provider string, fee *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "claimRestaker", provider, fee)
+}
+
+func (_Contract *ContractSession) ClaimRestaker(provider string, fee *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.ClaimRestaker(&_Contract.TransactOpts, provider, fee)
+}
+
+func (_Contract *ContractTransactorSession) ClaimRestaker(provider string, fee *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.ClaimRestaker(&_Contract.TransactOpts, provider, fee)
+}
+
+func (_Contract *ContractTransactor) ClaimUnstake(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "claimUnstake", claimer)
+}
+
+func (_Contract *ContractSession) ClaimUnstake(claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.ClaimUnstake(&_Contract.TransactOpts, claimer)
+}
+
+func (_Contract *ContractTransactorSession) ClaimUnstake(claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.ClaimUnstake(&_Contract.TransactOpts, claimer)
+}
+
+func (_Contract *ContractTransactor) CompleteWithdrawals(opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "completeWithdrawals", provider, withdrawals, tokens, middlewareTimesIndexes, receiveAsTokens)
+}
+
+func (_Contract *ContractSession) CompleteWithdrawals(provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error) {
+	return _Contract.Contract.CompleteWithdrawals(&_Contract.TransactOpts, provider, withdrawals, tokens, middlewareTimesIndexes, receiveAsTokens)
+}
+
+func (_Contract *ContractTransactorSession) CompleteWithdrawals(provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error) {
+	return _Contract.Contract.CompleteWithdrawals(&_Contract.TransactOpts, provider, withdrawals, tokens, middlewareTimesIndexes, receiveAsTokens)
+}
+
+func (_Contract *ContractTransactor) DelegateTo(opts *bind.TransactOpts, provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "delegateTo", provider, elOperator, approverSignatureAndExpiry, approverSalt)
+}
+
+func (_Contract *ContractSession) DelegateTo(provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.DelegateTo(&_Contract.TransactOpts, provider, elOperator, approverSignatureAndExpiry, approverSalt)
+}
+
+func (_Contract *ContractTransactorSession) DelegateTo(provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.DelegateTo(&_Contract.TransactOpts, provider, elOperator, approverSignatureAndExpiry, approverSalt)
+}
+
+func (_Contract *ContractTransactor) DistributeUnstakes(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "distributeUnstakes")
+}
+
+func (_Contract *ContractSession) DistributeUnstakes() (*types.Transaction, error) {
+	return _Contract.Contract.DistributeUnstakes(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) DistributeUnstakes() (*types.Transaction, error) {
+	return _Contract.Contract.DistributeUnstakes(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) FlashUnstake(opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "flashUnstake", shares, receiver)
+}
+
+func (_Contract *ContractSession) FlashUnstake(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.FlashUnstake(&_Contract.TransactOpts, shares, receiver)
+}
+
+func (_Contract *ContractTransactorSession) FlashUnstake(shares *big.Int, receiver common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.FlashUnstake(&_Contract.TransactOpts, shares, receiver)
+}
+
+func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "initialize", config, distributeGasLimit, newMaxTVL)
+}
+
+func (_Contract *ContractSession) Initialize(config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, config, distributeGasLimit, newMaxTVL)
+}
+
+func (_Contract *ContractTransactorSession) Initialize(config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, config, distributeGasLimit, newMaxTVL)
+}
+
+func (_Contract *ContractTransactor) QueueWithdrawals(opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "queueWithdrawals", provider, withdrawals)
+}
+
+func (_Contract *ContractSession) QueueWithdrawals(provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error) {
+	return _Contract.Contract.QueueWithdrawals(&_Contract.TransactOpts, provider, withdrawals)
+}
+
+func (_Contract *ContractTransactorSession) QueueWithdrawals(provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error) {
+	return _Contract.Contract.QueueWithdrawals(&_Contract.TransactOpts, provider, withdrawals)
+}
+
+func (_Contract *ContractTransactor) RecoverTokens(opts *bind.TransactOpts, provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "recoverTokens", provider, tokenList, amountsToWithdraw)
+}
+
+func (_Contract *ContractSession) RecoverTokens(provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.RecoverTokens(&_Contract.TransactOpts, provider, tokenList, amountsToWithdraw)
+}
+
+func (_Contract *ContractTransactorSession) RecoverTokens(provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.RecoverTokens(&_Contract.TransactOpts, provider, tokenList, amountsToWithdraw)
+}
+
+func (_Contract *ContractTransactor) SetDistributeGasLimit(opts *bind.TransactOpts, newValue uint32) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setDistributeGasLimit", newValue)
+}
+
+func (_Contract *ContractSession) SetDistributeGasLimit(newValue uint32) (*types.Transaction, error) {
+	return _Contract.Contract.SetDistributeGasLimit(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactorSession) SetDistributeGasLimit(newValue uint32) (*types.Transaction, error) {
+	return _Contract.Contract.SetDistributeGasLimit(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactor) SetFlashUnstakeFeeParams(opts *bind.TransactOpts, newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setFlashUnstakeFeeParams", newMaxFlashFeeRate, newOptimalUnstakeRate, newUnstakeUtilizationKink)
+}
+
+func (_Contract *ContractSession) SetFlashUnstakeFeeParams(newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetFlashUnstakeFeeParams(&_Contract.TransactOpts, newMaxFlashFeeRate, newOptimalUnstakeRate, newUnstakeUtilizationKink)
+}
+
+func (_Contract *ContractTransactorSession) SetFlashUnstakeFeeParams(newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetFlashUnstakeFeeParams(&_Contract.TransactOpts, newMaxFlashFeeRate, newOptimalUnstakeRate, newUnstakeUtilizationKink)
+}
+
+func (_Contract *ContractTransactor) SetMaxTVL(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setMaxTVL", newValue)
+}
+
+func (_Contract *ContractSession) SetMaxTVL(newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetMaxTVL(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactorSession) SetMaxTVL(newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetMaxTVL(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactor) SetMinStake(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setMinStake", newValue)
+}
+
+func (_Contract *ContractSession) SetMinStake(newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetMinStake(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactorSession) SetMinStake(newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetMinStake(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactor) SetMinUnstake(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setMinUnstake", newValue)
+}
+
+func (_Contract *ContractSession) SetMinUnstake(newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetMinUnstake(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactorSession) SetMinUnstake(newValue *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetMinUnstake(&_Contract.TransactOpts, newValue)
+}
+
+func (_Contract *ContractTransactor) SetProtocolFee(opts *bind.TransactOpts, newProtocolFee uint64) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setProtocolFee", newProtocolFee)
+}
+
+func (_Contract *ContractSession) SetProtocolFee(newProtocolFee uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetProtocolFee(&_Contract.TransactOpts, newProtocolFee)
+}
+
+func (_Contract *ContractTransactorSession) SetProtocolFee(newProtocolFee uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetProtocolFee(&_Contract.TransactOpts, newProtocolFee)
+}
+
+func (_Contract *ContractTransactor) SetRewardsCoordinator(opts *bind.TransactOpts, provider string, newCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setRewardsCoordinator", provider, newCoordinator, claimer)
+}
+
+func (_Contract *ContractSession) SetRewardsCoordinator(provider string, newCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsCoordinator(&_Contract.TransactOpts, provider, newCoordinator, claimer)
+}
+
+func (_Contract *ContractTransactorSession) SetRewardsCoordinator(provider string, newCoordinator common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsCoordinator(&_Contract.TransactOpts, provider, newCoordinator, claimer)
+}
+
+func (_Contract *ContractTransactor) SetRewardsTimeline(opts *bind.TransactOpts, newTimelineInSeconds *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setRewardsTimeline", newTimelineInSeconds)
+}
+
+func (_Contract *ContractSession) SetRewardsTimeline(newTimelineInSeconds *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsTimeline(&_Contract.TransactOpts, newTimelineInSeconds)
+}
+
+func (_Contract *ContractTransactorSession) SetRewardsTimeline(newTimelineInSeconds *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.SetRewardsTimeline(&_Contract.TransactOpts, newTimelineInSeconds)
+}
+
+func (_Contract *ContractTransactor) SetStakeBonusParams(opts *bind.TransactOpts, newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setStakeBonusParams", newMaxBonusRate, newOptimalBonusRate, newStakeUtilizationKink)
+}
+
+func (_Contract *ContractSession) SetStakeBonusParams(newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetStakeBonusParams(&_Contract.TransactOpts, newMaxBonusRate, newOptimalBonusRate, newStakeUtilizationKink)
+}
+
+func (_Contract *ContractTransactorSession) SetStakeBonusParams(newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetStakeBonusParams(&_Contract.TransactOpts, newMaxBonusRate, newOptimalBonusRate, newStakeUtilizationKink)
+}
+
+func (_Contract *ContractTransactor) SetTargetFlashCapacity(opts *bind.TransactOpts, newTargetCapacity uint64) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "setTargetFlashCapacity", newTargetCapacity)
+}
+
+func (_Contract *ContractSession) SetTargetFlashCapacity(newTargetCapacity uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetTargetFlashCapacity(&_Contract.TransactOpts, newTargetCapacity)
+}
+
+func (_Contract *ContractTransactorSession) SetTargetFlashCapacity(newTargetCapacity uint64) (*types.Transaction, error) {
+	return _Contract.Contract.SetTargetFlashCapacity(&_Contract.TransactOpts, newTargetCapacity)
+}
+
+func (_Contract *ContractTransactor) Stake(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "stake")
+}
+
+func (_Contract *ContractSession) Stake() (*types.Transaction, error) {
+	return _Contract.Contract.Stake(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Stake() (*types.Transaction, error) {
+	return _Contract.Contract.Stake(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) Stake0(opts *bind.TransactOpts, code [32]byte) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "stake0", code)
+}
+
+func (_Contract *ContractSession) Stake0(code [32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.Stake0(&_Contract.TransactOpts, code)
+}
+
+func (_Contract *ContractTransactorSession) Stake0(code [32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.Stake0(&_Contract.TransactOpts, code)
+}
+
+func (_Contract *ContractTransactor) StartWithdrawalCheckpoint(opts *bind.TransactOpts, provider string, revertIfNoBalance bool) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "startWithdrawalCheckpoint", provider, revertIfNoBalance)
+}
+
+func (_Contract *ContractSession) StartWithdrawalCheckpoint(provider string, revertIfNoBalance bool) (*types.Transaction, error) {
+	return _Contract.Contract.StartWithdrawalCheckpoint(&_Contract.TransactOpts, provider, revertIfNoBalance)
+}
+
+func (_Contract *ContractTransactorSession) StartWithdrawalCheckpoint(provider string, revertIfNoBalance bool) (*types.Transaction, error) {
+	return _Contract.Contract.StartWithdrawalCheckpoint(&_Contract.TransactOpts, provider, revertIfNoBalance)
+}
+
+func (_Contract *ContractTransactor) Undelegate(opts *bind.TransactOpts, provider string) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "undelegate", provider)
+}
+
+func (_Contract *ContractSession) Undelegate(provider string) (*types.Transaction, error) {
+	return _Contract.Contract.Undelegate(&_Contract.TransactOpts, provider)
+}
+
+func (_Contract *ContractTransactorSession) Undelegate(provider string) (*types.Transaction, error) {
+	return _Contract.Contract.Undelegate(&_Contract.TransactOpts, provider)
+}
+
+func (_Contract *ContractTransactor) Unstake(opts *bind.TransactOpts, to common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "unstake", to, shares)
+}
+
+func (_Contract *ContractSession) Unstake(to common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Unstake(&_Contract.TransactOpts, to, shares)
+}
+
+func (_Contract *ContractTransactorSession) Unstake(to common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Unstake(&_Contract.TransactOpts, to, shares)
+}
+
+func (_Contract *ContractTransactor) VerifyWithdrawalCredentials(opts *bind.TransactOpts, provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "verifyWithdrawalCredentials", provider, oracleTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+}
+
+func (_Contract *ContractSession) VerifyWithdrawalCredentials(provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.VerifyWithdrawalCredentials(&_Contract.TransactOpts, provider, oracleTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+}
+
+func (_Contract *ContractTransactorSession) VerifyWithdrawalCredentials(provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	return _Contract.Contract.VerifyWithdrawalCredentials(&_Contract.TransactOpts, provider, oracleTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+}
+
+func (_Contract *ContractTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.RawTransact(opts, nil)
+}
+
+func (_Contract *ContractSession) Receive() (*types.Transaction, error) {
+	return _Contract.Contract.Receive(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Receive() (*types.Transaction, error) {
+	return _Contract.Contract.Receive(&_Contract.TransactOpts)
+}
+
+type ContractClaimExpectedIterator struct {
+	Event *ContractClaimExpected
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractClaimExpectedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractClaimExpected)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractClaimExpected)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractClaimExpectedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractClaimExpectedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractClaimExpected struct {
+	Claimer common.Address
+	Value   *big.Int
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterClaimExpected(opts *bind.FilterOpts, claimer []common.Address) (*ContractClaimExpectedIterator, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "ClaimExpected", claimerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractClaimExpectedIterator{contract: _Contract.contract, event: "ClaimExpected", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchClaimExpected(opts *bind.WatchOpts, sink chan<- *ContractClaimExpected, claimer []common.Address) (event.Subscription, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "ClaimExpected", claimerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractClaimExpected)
+				if err := _Contract.contract.UnpackLog(event, "ClaimExpected", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseClaimExpected(log types.Log) (*ContractClaimExpected, error) {
+	event := new(ContractClaimExpected)
+	if err := _Contract.contract.UnpackLog(event, "ClaimExpected", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractDepositedIterator struct {
+	Event *ContractDeposited
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractDepositedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractDeposited)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractDeposited)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractDepositedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractDepositedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractDeposited struct {
+	Provider common.Hash
+	Pubkeys  [][]byte
+	Raw      types.Log
+}
+
+func (_Contract *ContractFilterer) FilterDeposited(opts *bind.FilterOpts, provider []string) (*ContractDepositedIterator, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Deposited", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractDepositedIterator{contract: _Contract.contract, event: "Deposited", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchDeposited(opts *bind.WatchOpts, sink chan<- *ContractDeposited, provider []string) (event.Subscription, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Deposited", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractDeposited)
+				if err := _Contract.contract.UnpackLog(event, "Deposited", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseDeposited(log types.Log) (*ContractDeposited, error) {
+	event := new(ContractDeposited)
+	if err := _Contract.contract.UnpackLog(event, "Deposited", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractDistributeGasLimitChangedIterator struct {
+	Event *ContractDistributeGasLimitChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractDistributeGasLimitChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractDistributeGasLimitChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractDistributeGasLimitChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractDistributeGasLimitChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractDistributeGasLimitChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractDistributeGasLimitChanged struct {
+	PrevValue uint32
+	NewValue  uint32
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterDistributeGasLimitChanged(opts *bind.FilterOpts) (*ContractDistributeGasLimitChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "DistributeGasLimitChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractDistributeGasLimitChangedIterator{contract: _Contract.contract, event: "DistributeGasLimitChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchDistributeGasLimitChanged(opts *bind.WatchOpts, sink chan<- *ContractDistributeGasLimitChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "DistributeGasLimitChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractDistributeGasLimitChanged)
+				if err := _Contract.contract.UnpackLog(event, "DistributeGasLimitChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseDistributeGasLimitChanged(log types.Log) (*ContractDistributeGasLimitChanged, error) {
+	event := new(ContractDistributeGasLimitChanged)
+	if err := _Contract.contract.UnpackLog(event, "DistributeGasLimitChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractFeeClaimedIterator struct {
+	Event *ContractFeeClaimed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractFeeClaimedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractFeeClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractFeeClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractFeeClaimedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractFeeClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractFeeClaimed struct {
+	Restaker     common.Address
+	Treasury     common.Address
+	Fee          *big.Int
+	TotalClaimed *big.Int
+	Raw          types.Log
+}
+
+func (_Contract *ContractFilterer) FilterFeeClaimed(opts *bind.FilterOpts, restaker []common.Address, treasury []common.Address) (*ContractFeeClaimedIterator, error) {
+
+	var restakerRule []interface{}
+	for _, restakerItem := range restaker {
+		restakerRule = append(restakerRule, restakerItem)
+	}
+	var treasuryRule []interface{}
+	for _, treasuryItem := range treasury {
+		treasuryRule = append(treasuryRule, treasuryItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "FeeClaimed", restakerRule, treasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFeeClaimedIterator{contract: _Contract.contract, event: "FeeClaimed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchFeeClaimed(opts *bind.WatchOpts, sink chan<- *ContractFeeClaimed, restaker []common.Address, treasury []common.Address) (event.Subscription, error) {
+
+	var restakerRule []interface{}
+	for _, restakerItem := range restaker {
+		restakerRule = append(restakerRule, restakerItem)
+	}
+	var treasuryRule []interface{}
+	for _, treasuryItem := range treasury {
+		treasuryRule = append(treasuryRule, treasuryItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "FeeClaimed", restakerRule, treasuryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractFeeClaimed)
+				if err := _Contract.contract.UnpackLog(event, "FeeClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseFeeClaimed(log types.Log) (*ContractFeeClaimed, error) {
+	event := new(ContractFeeClaimed)
+	if err := _Contract.contract.UnpackLog(event, "FeeClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractFlashUnstakedIterator struct {
+	Event *ContractFlashUnstaked
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractFlashUnstakedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractFlashUnstaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractFlashUnstaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractFlashUnstakedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractFlashUnstakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractFlashUnstaked struct {
+	Sender   common.Address
+	Receiver common.Address
+	Owner    common.Address
+	Amount   *big.Int
+	Shares   *big.Int
+	Fee      *big.Int
+	Raw      types.Log
+}
+
+func (_Contract *ContractFilterer) FilterFlashUnstaked(opts *bind.FilterOpts, sender []common.Address, receiver []common.Address, owner []common.Address) (*ContractFlashUnstakedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "FlashUnstaked", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFlashUnstakedIterator{contract: _Contract.contract, event: "FlashUnstaked", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchFlashUnstaked(opts *bind.WatchOpts, sink chan<- *ContractFlashUnstaked, sender []common.Address, receiver []common.Address, owner []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var receiverRule []interface{}
+	for _, receiverItem := range receiver {
+		receiverRule = append(receiverRule, receiverItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "FlashUnstaked", senderRule, receiverRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractFlashUnstaked)
+				if err := _Contract.contract.UnpackLog(event, "FlashUnstaked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseFlashUnstaked(log types.Log) (*ContractFlashUnstaked, error) {
+	event := new(ContractFlashUnstaked)
+	if err := _Contract.contract.UnpackLog(event, "FlashUnstaked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractInitializedIterator struct {
+	Event *ContractInitialized
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractInitializedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractInitialized struct {
+	Version uint64
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractInitializedIterator{contract: _Contract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractInitialized)
+				if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseInitialized(log types.Log) (*ContractInitialized, error) {
+	event := new(ContractInitialized)
+	if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractMaxTVLChangedIterator struct {
+	Event *ContractMaxTVLChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractMaxTVLChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractMaxTVLChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractMaxTVLChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractMaxTVLChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractMaxTVLChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractMaxTVLChanged struct {
+	PrevValue *big.Int
+	NewValue  *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterMaxTVLChanged(opts *bind.FilterOpts) (*ContractMaxTVLChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "MaxTVLChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractMaxTVLChangedIterator{contract: _Contract.contract, event: "MaxTVLChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchMaxTVLChanged(opts *bind.WatchOpts, sink chan<- *ContractMaxTVLChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "MaxTVLChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractMaxTVLChanged)
+				if err := _Contract.contract.UnpackLog(event, "MaxTVLChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseMaxTVLChanged(log types.Log) (*ContractMaxTVLChanged, error) {
+	event := new(ContractMaxTVLChanged)
+	if err := _Contract.contract.UnpackLog(event, "MaxTVLChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractMinStakeChangedIterator struct {
+	Event *ContractMinStakeChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractMinStakeChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractMinStakeChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractMinStakeChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractMinStakeChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractMinStakeChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractMinStakeChanged struct {
+	PrevValue *big.Int
+	NewValue  *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterMinStakeChanged(opts *bind.FilterOpts) (*ContractMinStakeChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "MinStakeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractMinStakeChangedIterator{contract: _Contract.contract, event: "MinStakeChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchMinStakeChanged(opts *bind.WatchOpts, sink chan<- *ContractMinStakeChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "MinStakeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractMinStakeChanged)
+				if err := _Contract.contract.UnpackLog(event, "MinStakeChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseMinStakeChanged(log types.Log) (*ContractMinStakeChanged, error) {
+	event := new(ContractMinStakeChanged)
+	if err := _Contract.contract.UnpackLog(event, "MinStakeChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractMinUnstakeChangedIterator struct {
+	Event *ContractMinUnstakeChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractMinUnstakeChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractMinUnstakeChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractMinUnstakeChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractMinUnstakeChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractMinUnstakeChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractMinUnstakeChanged struct {
+	PrevValue *big.Int
+	NewValue  *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterMinUnstakeChanged(opts *bind.FilterOpts) (*ContractMinUnstakeChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "MinUnstakeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractMinUnstakeChangedIterator{contract: _Contract.contract, event: "MinUnstakeChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchMinUnstakeChanged(opts *bind.WatchOpts, sink chan<- *ContractMinUnstakeChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "MinUnstakeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractMinUnstakeChanged)
+				if err := _Contract.contract.UnpackLog(event, "MinUnstakeChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseMinUnstakeChanged(log types.Log) (*ContractMinUnstakeChanged, error) {
+	event := new(ContractMinUnstakeChanged)
+	if err := _Contract.contract.UnpackLog(event, "MinUnstakeChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractPendingUnstakeIterator struct {
+	Event *ContractPendingUnstake
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractPendingUnstakeIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractPendingUnstake)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractPendingUnstake)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractPendingUnstakeIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractPendingUnstakeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractPendingUnstake struct {
+	OwnerAddress    common.Address
+	ReceiverAddress common.Address
+	Amount          *big.Int
+	Shares          *big.Int
+	Raw             types.Log
+}
+
+func (_Contract *ContractFilterer) FilterPendingUnstake(opts *bind.FilterOpts, ownerAddress []common.Address, receiverAddress []common.Address) (*ContractPendingUnstakeIterator, error) {
+
+	var ownerAddressRule []interface{}
+	for _, ownerAddressItem := range ownerAddress {
+		ownerAddressRule = append(ownerAddressRule, ownerAddressItem)
+	}
+	var receiverAddressRule []interface{}
+	for _, receiverAddressItem := range receiverAddress {
+		receiverAddressRule = append(receiverAddressRule, receiverAddressItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "PendingUnstake", ownerAddressRule, receiverAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractPendingUnstakeIterator{contract: _Contract.contract, event: "PendingUnstake", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchPendingUnstake(opts *bind.WatchOpts, sink chan<- *ContractPendingUnstake, ownerAddress []common.Address, receiverAddress []common.Address) (event.Subscription, error) {
+
+	var ownerAddressRule []interface{}
+	for _, ownerAddressItem := range ownerAddress {
+		ownerAddressRule = append(ownerAddressRule, ownerAddressItem)
+	}
+	var receiverAddressRule []interface{}
+	for _, receiverAddressItem := range receiverAddress {
+		receiverAddressRule = append(receiverAddressRule, receiverAddressItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "PendingUnstake", ownerAddressRule, receiverAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractPendingUnstake)
+				if err := _Contract.contract.UnpackLog(event, "PendingUnstake", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParsePendingUnstake(log types.Log) (*ContractPendingUnstake, error) {
+	event := new(ContractPendingUnstake)
+	if err := _Contract.contract.UnpackLog(event, "PendingUnstake", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractProtocolFeeChangedIterator struct {
+	Event *ContractProtocolFeeChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractProtocolFeeChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractProtocolFeeChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractProtocolFeeChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractProtocolFeeChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractProtocolFeeChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractProtocolFeeChanged struct {
+	PrevValue *big.Int
+	NewValue  *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterProtocolFeeChanged(opts *bind.FilterOpts) (*ContractProtocolFeeChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "ProtocolFeeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractProtocolFeeChangedIterator{contract: _Contract.contract, event: "ProtocolFeeChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchProtocolFeeChanged(opts *bind.WatchOpts, sink chan<- *ContractProtocolFeeChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "ProtocolFeeChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractProtocolFeeChanged)
+				if err := _Contract.contract.UnpackLog(event, "ProtocolFeeChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseProtocolFeeChanged(log types.Log) (*ContractProtocolFeeChanged, error) {
+	event := new(ContractProtocolFeeChanged)
+	if err := _Contract.contract.UnpackLog(event, "ProtocolFeeChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractReceivedIterator struct {
+	Event *ContractReceived
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractReceivedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractReceivedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractReceived struct {
+	Sender common.Address
+	Amount *big.Int
+	Raw    types.Log
+}
+
+func (_Contract *ContractFilterer) FilterReceived(opts *bind.FilterOpts, sender []common.Address) (*ContractReceivedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Received", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractReceivedIterator{contract: _Contract.contract, event: "Received", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchReceived(opts *bind.WatchOpts, sink chan<- *ContractReceived, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Received", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractReceived)
+				if err := _Contract.contract.UnpackLog(event, "Received", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseReceived(log types.Log) (*ContractReceived, error) {
+	event := new(ContractReceived)
+	if err := _Contract.contract.UnpackLog(event, "Received", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractReferralStakeIterator struct {
+	Event *ContractReferralStake
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractReferralStakeIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractReferralStake)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractReferralStake)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractReferralStakeIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractReferralStakeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractReferralStake struct {
+	Code [32]byte
+	Raw  types.Log
+}
+
+func (_Contract *ContractFilterer) FilterReferralStake(opts *bind.FilterOpts, code [][32]byte) (*ContractReferralStakeIterator, error) {
+
+	var codeRule []interface{}
+	for _, codeItem := range code {
+		codeRule = append(codeRule, codeItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "ReferralStake", codeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractReferralStakeIterator{contract: _Contract.contract, event: "ReferralStake", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchReferralStake(opts *bind.WatchOpts, sink chan<- *ContractReferralStake, code [][32]byte) (event.Subscription, error) {
+
+	var codeRule []interface{}
+	for _, codeItem := range code {
+		codeRule = append(codeRule, codeItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "ReferralStake", codeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractReferralStake)
+				if err := _Contract.contract.UnpackLog(event, "ReferralStake", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseReferralStake(log types.Log) (*ContractReferralStake, error) {
+	event := new(ContractReferralStake)
+	if err := _Contract.contract.UnpackLog(event, "ReferralStake", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRestakerAddedIterator struct {
+	Event *ContractRestakerAdded
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRestakerAddedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRestakerAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRestakerAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRestakerAddedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRestakerAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRestakerAdded struct {
+	Provider common.Hash
+	Restaker common.Address
+	Raw      types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRestakerAdded(opts *bind.FilterOpts, provider []string) (*ContractRestakerAddedIterator, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RestakerAdded", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRestakerAddedIterator{contract: _Contract.contract, event: "RestakerAdded", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRestakerAdded(opts *bind.WatchOpts, sink chan<- *ContractRestakerAdded, provider []string) (event.Subscription, error) {
+
+	var providerRule []interface{}
+	for _, providerItem := range provider {
+		providerRule = append(providerRule, providerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RestakerAdded", providerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRestakerAdded)
+				if err := _Contract.contract.UnpackLog(event, "RestakerAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRestakerAdded(log types.Log) (*ContractRestakerAdded, error) {
+	event := new(ContractRestakerAdded)
+	if err := _Contract.contract.UnpackLog(event, "RestakerAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsAddedIterator struct {
+	Event *ContractRewardsAdded
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsAddedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsAddedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsAdded struct {
+	Amount        *big.Int
+	StartTimeline *big.Int
+	Raw           types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsAdded(opts *bind.FilterOpts) (*ContractRewardsAddedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsAdded")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsAddedIterator{contract: _Contract.contract, event: "RewardsAdded", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsAdded(opts *bind.WatchOpts, sink chan<- *ContractRewardsAdded) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsAdded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsAdded)
+				if err := _Contract.contract.UnpackLog(event, "RewardsAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsAdded(log types.Log) (*ContractRewardsAdded, error) {
+	event := new(ContractRewardsAdded)
+	if err := _Contract.contract.UnpackLog(event, "RewardsAdded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractRewardsTimelineChangedIterator struct {
+	Event *ContractRewardsTimelineChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractRewardsTimelineChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractRewardsTimelineChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractRewardsTimelineChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractRewardsTimelineChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractRewardsTimelineChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractRewardsTimelineChanged struct {
+	RewardsTimeline      *big.Int
+	NewTimelineInSeconds *big.Int
+	Raw                  types.Log
+}
+
+func (_Contract *ContractFilterer) FilterRewardsTimelineChanged(opts *bind.FilterOpts) (*ContractRewardsTimelineChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "RewardsTimelineChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractRewardsTimelineChangedIterator{contract: _Contract.contract, event: "RewardsTimelineChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchRewardsTimelineChanged(opts *bind.WatchOpts, sink chan<- *ContractRewardsTimelineChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "RewardsTimelineChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractRewardsTimelineChanged)
+				if err := _Contract.contract.UnpackLog(event, "RewardsTimelineChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseRewardsTimelineChanged(log types.Log) (*ContractRewardsTimelineChanged, error) {
+	event := new(ContractRewardsTimelineChanged)
+	if err := _Contract.contract.UnpackLog(event, "RewardsTimelineChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractStakeBonusIterator struct {
+	Event *ContractStakeBonus
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractStakeBonusIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractStakeBonus)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractStakeBonus)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractStakeBonusIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractStakeBonusIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractStakeBonus struct {
+	Amount *big.Int
+	Raw    types.Log
+}
+
+func (_Contract *ContractFilterer) FilterStakeBonus(opts *bind.FilterOpts) (*ContractStakeBonusIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "StakeBonus")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractStakeBonusIterator{contract: _Contract.contract, event: "StakeBonus", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchStakeBonus(opts *bind.WatchOpts, sink chan<- *ContractStakeBonus) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "StakeBonus")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractStakeBonus)
+				if err := _Contract.contract.UnpackLog(event, "StakeBonus", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseStakeBonus(log types.Log) (*ContractStakeBonus, error) {
+	event := new(ContractStakeBonus)
+	if err := _Contract.contract.UnpackLog(event, "StakeBonus", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractStakeBonusParamsChangedIterator struct {
+	Event *ContractStakeBonusParamsChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractStakeBonusParamsChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractStakeBonusParamsChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractStakeBonusParamsChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractStakeBonusParamsChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractStakeBonusParamsChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractStakeBonusParamsChanged struct {
+	NewMaxBonusRate           *big.Int
+	NewOptimalBonusRate       *big.Int
+	NewDepositUtilizationKink *big.Int
+	Raw                       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterStakeBonusParamsChanged(opts *bind.FilterOpts) (*ContractStakeBonusParamsChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "StakeBonusParamsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractStakeBonusParamsChangedIterator{contract: _Contract.contract, event: "StakeBonusParamsChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchStakeBonusParamsChanged(opts *bind.WatchOpts, sink chan<- *ContractStakeBonusParamsChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "StakeBonusParamsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractStakeBonusParamsChanged)
+				if err := _Contract.contract.UnpackLog(event, "StakeBonusParamsChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseStakeBonusParamsChanged(log types.Log) (*ContractStakeBonusParamsChanged, error) {
+	event := new(ContractStakeBonusParamsChanged)
+	if err := _Contract.contract.UnpackLog(event, "StakeBonusParamsChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractStakedIterator struct {
+	Event *ContractStaked
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractStakedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractStaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractStaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractStakedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractStakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractStaked struct {
+	Staker common.Address
+	Amount *big.Int
+	Shares *big.Int
+	Raw    types.Log
+}
+
+func (_Contract *ContractFilterer) FilterStaked(opts *bind.FilterOpts, staker []common.Address) (*ContractStakedIterator, error) {
+
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Staked", stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractStakedIterator{contract: _Contract.contract, event: "Staked", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchStaked(opts *bind.WatchOpts, sink chan<- *ContractStaked, staker []common.Address) (event.Subscription, error) {
+
+	var stakerRule []interface{}
+	for _, stakerItem := range staker {
+		stakerRule = append(stakerRule, stakerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Staked", stakerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractStaked)
+				if err := _Contract.contract.UnpackLog(event, "Staked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseStaked(log types.Log) (*ContractStaked, error) {
+	event := new(ContractStaked)
+	if err := _Contract.contract.UnpackLog(event, "Staked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractTargetCapacityChangedIterator struct {
+	Event *ContractTargetCapacityChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractTargetCapacityChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractTargetCapacityChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractTargetCapacityChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractTargetCapacityChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractTargetCapacityChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractTargetCapacityChanged struct {
+	PrevValue *big.Int
+	NewValue  *big.Int
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterTargetCapacityChanged(opts *bind.FilterOpts) (*ContractTargetCapacityChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "TargetCapacityChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTargetCapacityChangedIterator{contract: _Contract.contract, event: "TargetCapacityChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchTargetCapacityChanged(opts *bind.WatchOpts, sink chan<- *ContractTargetCapacityChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "TargetCapacityChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractTargetCapacityChanged)
+				if err := _Contract.contract.UnpackLog(event, "TargetCapacityChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseTargetCapacityChanged(log types.Log) (*ContractTargetCapacityChanged, error) {
+	event := new(ContractTargetCapacityChanged)
+	if err := _Contract.contract.UnpackLog(event, "TargetCapacityChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractUnstakeClaimedIterator struct {
+	Event *ContractUnstakeClaimed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractUnstakeClaimedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractUnstakeClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractUnstakeClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractUnstakeClaimedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractUnstakeClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractUnstakeClaimed struct {
+	Claimer common.Address
+	Caller  common.Address
+	Value   *big.Int
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterUnstakeClaimed(opts *bind.FilterOpts, claimer []common.Address, caller []common.Address) (*ContractUnstakeClaimedIterator, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "UnstakeClaimed", claimerRule, callerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractUnstakeClaimedIterator{contract: _Contract.contract, event: "UnstakeClaimed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchUnstakeClaimed(opts *bind.WatchOpts, sink chan<- *ContractUnstakeClaimed, claimer []common.Address, caller []common.Address) (event.Subscription, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "UnstakeClaimed", claimerRule, callerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractUnstakeClaimed)
+				if err := _Contract.contract.UnpackLog(event, "UnstakeClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseUnstakeClaimed(log types.Log) (*ContractUnstakeClaimed, error) {
+	event := new(ContractUnstakeClaimed)
+	if err := _Contract.contract.UnpackLog(event, "UnstakeClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractUnstakeFeeParamsChangedIterator struct {
+	Event *ContractUnstakeFeeParamsChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractUnstakeFeeParamsChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractUnstakeFeeParamsChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractUnstakeFeeParamsChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractUnstakeFeeParamsChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractUnstakeFeeParamsChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractUnstakeFeeParamsChanged struct {
+	NewMaxFlashFeeRate         *big.Int
+	NewOptimalWithdrawalRate   *big.Int
+	NewWithdrawUtilizationKink *big.Int
+	Raw                        types.Log
+}
+
+func (_Contract *ContractFilterer) FilterUnstakeFeeParamsChanged(opts *bind.FilterOpts) (*ContractUnstakeFeeParamsChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "UnstakeFeeParamsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractUnstakeFeeParamsChangedIterator{contract: _Contract.contract, event: "UnstakeFeeParamsChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchUnstakeFeeParamsChanged(opts *bind.WatchOpts, sink chan<- *ContractUnstakeFeeParamsChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "UnstakeFeeParamsChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractUnstakeFeeParamsChanged)
+				if err := _Contract.contract.UnpackLog(event, "UnstakeFeeParamsChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseUnstakeFeeParamsChanged(log types.Log) (*ContractUnstakeFeeParamsChanged, error) {
+	event := new(ContractUnstakeFeeParamsChanged)
+	if err := _Contract.contract.UnpackLog(event, "UnstakeFeeParamsChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractUnstakedIterator struct {
+	Event *ContractUnstaked
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractUnstakedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractUnstaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractUnstaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractUnstakedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractUnstakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractUnstaked struct {
+	From   common.Address
+	To     common.Address
+	Amount *big.Int
+	Shares *big.Int
+	Raw    types.Log
+}
+
+func (_Contract *ContractFilterer) FilterUnstaked(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ContractUnstakedIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Unstaked", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractUnstakedIterator{contract: _Contract.contract, event: "Unstaked", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchUnstaked(opts *bind.WatchOpts, sink chan<- *ContractUnstaked, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Unstaked", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractUnstaked)
+				if err := _Contract.contract.UnpackLog(event, "Unstaked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseUnstaked(log types.Log) (*ContractUnstaked, error) {
+	event := new(ContractUnstaked)
+	if err := _Contract.contract.UnpackLog(event, "Unstaked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractUnstakesDistributedIterator struct {
+	Event *ContractUnstakesDistributed
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractUnstakesDistributedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractUnstakesDistributed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractUnstakesDistributed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractUnstakesDistributedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractUnstakesDistributedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractUnstakesDistributed struct {
+	Unstakes []IRestakingPoolUnstake
+	Raw      types.Log
+}
+
+func (_Contract *ContractFilterer) FilterUnstakesDistributed(opts *bind.FilterOpts) (*ContractUnstakesDistributedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "UnstakesDistributed")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractUnstakesDistributedIterator{contract: _Contract.contract, event: "UnstakesDistributed", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchUnstakesDistributed(opts *bind.WatchOpts, sink chan<- *ContractUnstakesDistributed) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "UnstakesDistributed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractUnstakesDistributed)
+				if err := _Contract.contract.UnpackLog(event, "UnstakesDistributed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseUnstakesDistributed(log types.Log) (*ContractUnstakesDistributed, error) {
+	event := new(ContractUnstakesDistributed)
+	if err := _Contract.contract.UnpackLog(event, "UnstakesDistributed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["ClaimExpected"].ID:
+		return _Contract.ParseClaimExpected(log)
+	case _Contract.abi.Events["Deposited"].ID:
+		return _Contract.ParseDeposited(log)
+	case _Contract.abi.Events["DistributeGasLimitChanged"].ID:
+		return _Contract.ParseDistributeGasLimitChanged(log)
+	case _Contract.abi.Events["FeeClaimed"].ID:
+		return _Contract.ParseFeeClaimed(log)
+	case _Contract.abi.Events["FlashUnstaked"].ID:
+		return _Contract.ParseFlashUnstaked(log)
+	case _Contract.abi.Events["Initialized"].ID:
+		return _Contract.ParseInitialized(log)
+	case _Contract.abi.Events["MaxTVLChanged"].ID:
+		return _Contract.ParseMaxTVLChanged(log)
+	case _Contract.abi.Events["MinStakeChanged"].ID:
+		return _Contract.ParseMinStakeChanged(log)
+	case _Contract.abi.Events["MinUnstakeChanged"].ID:
+		return _Contract.ParseMinUnstakeChanged(log)
+	case _Contract.abi.Events["PendingUnstake"].ID:
+		return _Contract.ParsePendingUnstake(log)
+	case _Contract.abi.Events["ProtocolFeeChanged"].ID:
+		return _Contract.ParseProtocolFeeChanged(log)
+	case _Contract.abi.Events["Received"].ID:
+		return _Contract.ParseReceived(log)
+	case _Contract.abi.Events["ReferralStake"].ID:
+		return _Contract.ParseReferralStake(log)
+	case _Contract.abi.Events["RestakerAdded"].ID:
+		return _Contract.ParseRestakerAdded(log)
+	case _Contract.abi.Events["RewardsAdded"].ID:
+		return _Contract.ParseRewardsAdded(log)
+	case _Contract.abi.Events["RewardsTimelineChanged"].ID:
+		return _Contract.ParseRewardsTimelineChanged(log)
+	case _Contract.abi.Events["StakeBonus"].ID:
+		return _Contract.ParseStakeBonus(log)
+	case _Contract.abi.Events["StakeBonusParamsChanged"].ID:
+		return _Contract.ParseStakeBonusParamsChanged(log)
+	case _Contract.abi.Events["Staked"].ID:
+		return _Contract.ParseStaked(log)
+	case _Contract.abi.Events["TargetCapacityChanged"].ID:
+		return _Contract.ParseTargetCapacityChanged(log)
+	case _Contract.abi.Events["UnstakeClaimed"].ID:
+		return _Contract.ParseUnstakeClaimed(log)
+	case _Contract.abi.Events["UnstakeFeeParamsChanged"].ID:
+		return _Contract.ParseUnstakeFeeParamsChanged(log)
+	case _Contract.abi.Events["Unstaked"].ID:
+		return _Contract.ParseUnstaked(log)
+	case _Contract.abi.Events["UnstakesDistributed"].ID:
+		return _Contract.ParseUnstakesDistributed(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractClaimExpected) Topic() common.Hash {
+	return common.HexToHash("0xf4426c1412b22225874f3898e67e34c12ba628bff6d89fd87ba593927a80b421")
+}
+
+func (ContractDeposited) Topic() common.Hash {
+	return common.HexToHash("0x82893d4437db377df0abca554a4a9ef54de226c99efe74077b214d6d3390e7dd")
+}
+
+func (ContractDistributeGasLimitChanged) Topic() common.Hash {
+	return common.HexToHash("0x55c1474264b8038534b3e34b09a3a99d1f3436634d4e34fa13cea4c33c9a832e")
+}
+
+func (ContractFeeClaimed) Topic() common.Hash {
+	return common.HexToHash("0x2ee827557318e24ad8fdb9f616bbfd73b44656ea23439623780d719c369f0c1d")
+}
+
+func (ContractFlashUnstaked) Topic() common.Hash {
+	return common.HexToHash("0x628a6f39215835333501afcce023c08e1e61c026768b46f5076804d344e023e3")
+}
+
+func (ContractInitialized) Topic() common.Hash {
+	return common.HexToHash("0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2")
+}
+
+func (ContractMaxTVLChanged) Topic() common.Hash {
+	return common.HexToHash("0x3e6e3950c3fc7a0bbe5c0ded9285010d1c1ef843817fed86973510375eb07418")
+}
+
+func (ContractMinStakeChanged) Topic() common.Hash {
+	return common.HexToHash("0xca11c8a4c461b60c9f485404c272650c2aaae260b2067d72e9924abb68556593")
+}
+
+func (ContractMinUnstakeChanged) Topic() common.Hash {
+	return common.HexToHash("0x984016d328adef81f3cc09f8ea9e3420f85d390635be94215c432e83837aa0a2")
+}
+
+func (ContractPendingUnstake) Topic() common.Hash {
+	return common.HexToHash("0xe8f73d529f5ced08581a2c18456a6530dbd0dddf94d8c98e0ab8f9883e2f4482")
+}
+
+func (ContractProtocolFeeChanged) Topic() common.Hash {
+	return common.HexToHash("0xb51bef650ff5ad43303dbe2e500a74d4fd1bdc9ae05f046bece330e82ae0ba87")
+}
+
+func (ContractReceived) Topic() common.Hash {
+	return common.HexToHash("0x88a5966d370b9919b20f3e2c13ff65706f196a4e32cc2c12bf57088f88525874")
+}
+
+func (ContractReferralStake) Topic() common.Hash {
+	return common.HexToHash("0x8c355e502e4eade8ac341bcb08dcfca0ae238efe6431e5a63b75be9026d9163b")
+}
+
+func (ContractRestakerAdded) Topic() common.Hash {
+	return common.HexToHash("0x187f9ad308b4b25229d086a632166f351b45905117cc3162fb9ec6873b9a9ce8")
+}
+
+func (ContractRewardsAdded) Topic() common.Hash {
+	return common.HexToHash("0x40df43107e8b4d467127964bd3c966687c0a6a39aaede970755397fd09535e98")
+}
+
+func (ContractRewardsTimelineChanged) Topic() common.Hash {
+	return common.HexToHash("0x1638f4dadb33895a0e192a35d63a2677a5b9dbe482091d94d8877d0919473320")
+}
+
+func (ContractStakeBonus) Topic() common.Hash {
+	return common.HexToHash("0x98b37da5bc0dc3a6af25a940988aef0df1a74411148692d8d74aa06665817305")
+}
+
+func (ContractStakeBonusParamsChanged) Topic() common.Hash {
+	return common.HexToHash("0xbdd363e1b2c509839731ca7a94e48c8835b6de783af60bbfaeb08b9ba3862bea")
+}
+
+func (ContractStaked) Topic() common.Hash {
+	return common.HexToHash("0x1449c6dd7851abc30abf37f57715f492010519147cc2652fbc38202c18a6ee90")
+}
+
+func (ContractTargetCapacityChanged) Topic() common.Hash {
+	return common.HexToHash("0xbf127022ae43ce46c7042f13c6e64c507f75f20314c2aee1b03b0cf208a10f39")
+}
+
+func (ContractUnstakeClaimed) Topic() common.Hash {
+	return common.HexToHash("0xebc6a30f46090cafd1f043241dbc148c4310b7026aae29ff950ea25a5411879b")
+}
+
+func (ContractUnstakeFeeParamsChanged) Topic() common.Hash {
+	return common.HexToHash("0xf3f49a7eaa29cb38bb074c44803e3ef1f47960b9529ee0c0a3aa47c0d4f93a9d")
+}
+
+func (ContractUnstaked) Topic() common.Hash {
+	return common.HexToHash("0x06cc7e90b4f2b554a9614b0caa84f909f3498c820ae47c731f490c28c07f7d3b")
+}
+
+func (ContractUnstakesDistributed) Topic() common.Hash {
+	return common.HexToHash("0xdb6e8b10a6c30551abbedf583209f3ba034b0fe65db6848390a5af4816a2eaa0")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractAmbiguousFeeError struct {
+	Claimed *big.Int
+	Fee     *big.Int
+}
+
+func (e *ContractAmbiguousFeeError) Error() string {
+	return fmt.Sprintf("AmbiguousFee(%v, %v)", e.Claimed, e.Fee)
+}
+
+type ContractInconsistentDataError struct{}
+
+func (e *ContractInconsistentDataError) Error() string {
+	return "InconsistentData()"
+}
+
+type ContractInsufficientCapacityError struct {
+	Capacity *big.Int
+}
+
+func (e *ContractInsufficientCapacityError) Error() string {
+	return fmt.Sprintf("InsufficientCapacity(%v)", e.Capacity)
+}
+
+type ContractInvalidInitializationError struct{}
+
+func (e *ContractInvalidInitializationError) Error() string {
+	return "InvalidInitialization()"
+}
+
+type ContractNotInitializingError struct{}
+
+func (e *ContractNotInitializingError) Error() string {
+	return "NotInitializing()"
+}
+
+type ContractOnlyGovernanceAllowedError struct{}
+
+func (e *ContractOnlyGovernanceAllowedError) Error() string {
+	return "OnlyGovernanceAllowed()"
+}
+
+type ContractOnlyOperatorAllowedError struct{}
+
+func (e *ContractOnlyOperatorAllowedError) Error() string {
+	return "OnlyOperatorAllowed()"
+}
+
+type ContractOnlyRestakingPoolAllowedError struct{}
+
+func (e *ContractOnlyRestakingPoolAllowedError) Error() string {
+	return "OnlyRestakingPoolAllowed()"
+}
+
+type ContractParameterExceedsLimitsError struct {
+	Param *big.Int
+}
+
+func (e *ContractParameterExceedsLimitsError) Error() string {
+	return fmt.Sprintf("ParameterExceedsLimits(%v)", e.Param)
+}
+
+type ContractPoolDistributeGasLimitNotInRangeError struct {
+	Max uint64
+}
+
+func (e *ContractPoolDistributeGasLimitNotInRangeError) Error() string {
+	return fmt.Sprintf("PoolDistributeGasLimitNotInRange(%v)", e.Max)
+}
+
+type ContractPoolFailedInnerCallError struct{}
+
+func (e *ContractPoolFailedInnerCallError) Error() string {
+	return "PoolFailedInnerCall()"
+}
+
+type ContractPoolInsufficientBalanceError struct{}
+
+func (e *ContractPoolInsufficientBalanceError) Error() string {
+	return "PoolInsufficientBalance()"
+}
+
+type ContractPoolRestakerExistsError struct{}
+
+func (e *ContractPoolRestakerExistsError) Error() string {
+	return "PoolRestakerExists()"
+}
+
+type ContractPoolRestakerNotExistsError struct{}
+
+func (e *ContractPoolRestakerNotExistsError) Error() string {
+	return "PoolRestakerNotExists()"
+}
+
+type ContractPoolStakeAmGreaterThanAvailableError struct{}
+
+func (e *ContractPoolStakeAmGreaterThanAvailableError) Error() string {
+	return "PoolStakeAmGreaterThanAvailable()"
+}
+
+type ContractPoolStakeAmLessThanMinError struct{}
+
+func (e *ContractPoolStakeAmLessThanMinError) Error() string {
+	return "PoolStakeAmLessThanMin()"
+}
+
+type ContractPoolUnstakeAmLessThanMinError struct{}
+
+func (e *ContractPoolUnstakeAmLessThanMinError) Error() string {
+	return "PoolUnstakeAmLessThanMin()"
+}
+
+type ContractPoolWrongInputLengthError struct{}
+
+func (e *ContractPoolWrongInputLengthError) Error() string {
+	return "PoolWrongInputLength()"
+}
+
+type ContractPoolZeroAddressError struct{}
+
+func (e *ContractPoolZeroAddressError) Error() string {
+	return "PoolZeroAddress()"
+}
+
+type ContractPoolZeroAmountError struct{}
+
+func (e *ContractPoolZeroAmountError) Error() string {
+	return "PoolZeroAmount()"
+}
+
+type ContractReentrancyGuardReentrantCallError struct{}
+
+func (e *ContractReentrancyGuardReentrantCallError) Error() string {
+	return "ReentrancyGuardReentrantCall()"
+}
+
+type ContractTargetCapacityNotSetError struct{}
+
+func (e *ContractTargetCapacityNotSetError) Error() string {
+	return "TargetCapacityNotSet()"
+}
+
+type ContractTimelineNotOverError struct{}
+
+func (e *ContractTimelineNotOverError) Error() string {
+	return "TimelineNotOver()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "AmbiguousFee":
+		return &ContractAmbiguousFeeError{Claimed: *abi.ConvertType(e.Args[0], new(*big.Int)).(**big.Int), Fee: *abi.ConvertType(e.Args[1], new(*big.Int)).(**big.Int)}, true
+	case "InconsistentData":
+		return &ContractInconsistentDataError{}, true
+	case "InsufficientCapacity":
+		return &ContractInsufficientCapacityError{Capacity: *abi.ConvertType(e.Args[0], new(*big.Int)).(**big.Int)}, true
+	case "InvalidInitialization":
+		return &ContractInvalidInitializationError{}, true
+	case "NotInitializing":
+		return &ContractNotInitializingError{}, true
+	case "OnlyGovernanceAllowed":
+		return &ContractOnlyGovernanceAllowedError{}, true
+	case "OnlyOperatorAllowed":
+		return &ContractOnlyOperatorAllowedError{}, true
+	case "OnlyRestakingPoolAllowed":
+		return &ContractOnlyRestakingPoolAllowedError{}, true
+	case "ParameterExceedsLimits":
+		return &ContractParameterExceedsLimitsError{Param: *abi.ConvertType(e.Args[0], new(*big.Int)).(**big.Int)}, true
+	case "PoolDistributeGasLimitNotInRange":
+		return &ContractPoolDistributeGasLimitNotInRangeError{Max: *abi.ConvertType(e.Args[0], new(uint64)).(*uint64)}, true
+	case "PoolFailedInnerCall":
+		return &ContractPoolFailedInnerCallError{}, true
+	case "PoolInsufficientBalance":
+		return &ContractPoolInsufficientBalanceError{}, true
+	case "PoolRestakerExists":
+		return &ContractPoolRestakerExistsError{}, true
+	case "PoolRestakerNotExists":
+		return &ContractPoolRestakerNotExistsError{}, true
+	case "PoolStakeAmGreaterThanAvailable":
+		return &ContractPoolStakeAmGreaterThanAvailableError{}, true
+	case "PoolStakeAmLessThanMin":
+		return &ContractPoolStakeAmLessThanMinError{}, true
+	case "PoolUnstakeAmLessThanMin":
+		return &ContractPoolUnstakeAmLessThanMinError{}, true
+	case "PoolWrongInputLength":
+		return &ContractPoolWrongInputLengthError{}, true
+	case "PoolZeroAddress":
+		return &ContractPoolZeroAddressError{}, true
+	case "PoolZeroAmount":
+		return &ContractPoolZeroAmountError{}, true
+	case "ReentrancyGuardReentrantCall":
+		return &ContractReentrancyGuardReentrantCallError{}, true
+	case "TargetCapacityNotSet":
+		return &ContractTargetCapacityNotSetError{}, true
+	case "TimelineNotOver":
+		return &ContractTimelineNotOverError{}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) AddRestakerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.AddRestaker(opts, provider)
+	})
+}
+
+func (_Contract *Contract) AddRewardsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.AddRewards(opts)
+	})
+}
+
+func (_Contract *Contract) BatchDepositAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.BatchDeposit(opts, provider, pubkeys, signatures, deposit_data_roots)
+	})
+}
+
+func (_Contract *Contract) ClaimRestakerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, fee *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.ClaimRestaker(opts, provider, fee)
+	})
+}
+
+func (_Contract *Contract) ClaimUnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, claimer common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.ClaimUnstake(opts, claimer)
+	})
+}
+
+func (_Contract *Contract) CompleteWithdrawalsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.CompleteWithdrawals(opts, provider, withdrawals, tokens, middlewareTimesIndexes, receiveAsTokens)
+	})
+}
+
+func (_Contract *Contract) DelegateToAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.DelegateTo(opts, provider, elOperator, approverSignatureAndExpiry, approverSalt)
+	})
+}
+
+func (_Contract *Contract) DistributeUnstakesAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.DistributeUnstakes(opts)
+	})
+}
+
+func (_Contract *Contract) FlashUnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.FlashUnstake(opts, shares, receiver)
+	})
+}
+
+func (_Contract *Contract) InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Initialize(opts, config, distributeGasLimit, newMaxTVL)
+	})
+}
+
+func (_Contract *Contract) QueueWithdrawalsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.QueueWithdrawals(opts, provider, withdrawals)
+	})
+}
+
+func (_Contract *Contract) RecoverTokensAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.RecoverTokens(opts, provider, tokenList, amountsToWithdraw)
+	})
+}
+
+func (_Contract *Contract) SetDistributeGasLimitAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue uint32) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetDistributeGasLimit(opts, newValue)
+	})
+}
+
+func (_Contract *Contract) SetFlashUnstakeFeeParamsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetFlashUnstakeFeeParams(opts, newMaxFlashFeeRate, newOptimalUnstakeRate, newUnstakeUtilizationKink)
+	})
+}
+
+func (_Contract *Contract) SetMaxTVLAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetMaxTVL(opts, newValue)
+	})
+}
+
+func (_Contract *Contract) SetMinStakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetMinStake(opts, newValue)
+	})
+}
+
+func (_Contract *Contract) SetMinUnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetMinUnstake(opts, newValue)
+	})
+}
+
+func (_Contract *Contract) SetProtocolFeeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newProtocolFee uint64) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetProtocolFee(opts, newProtocolFee)
+	})
+}
+
+func (_Contract *Contract) SetRewardsCoordinatorAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, newCoordinator common.Address, claimer common.Address) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetRewardsCoordinator(opts, provider, newCoordinator, claimer)
+	})
+}
+
+func (_Contract *Contract) SetRewardsTimelineAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newTimelineInSeconds *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetRewardsTimeline(opts, newTimelineInSeconds)
+	})
+}
+
+func (_Contract *Contract) SetStakeBonusParamsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetStakeBonusParams(opts, newMaxBonusRate, newOptimalBonusRate, newStakeUtilizationKink)
+	})
+}
+
+func (_Contract *Contract) SetTargetFlashCapacityAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newTargetCapacity uint64) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.SetTargetFlashCapacity(opts, newTargetCapacity)
+	})
+}
+
+func (_Contract *Contract) StakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Stake(opts)
+	})
+}
+
+func (_Contract *Contract) Stake0AndWait(waiter *generated.Waiter, opts *bind.TransactOpts, code [32]byte) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Stake0(opts, code)
+	})
+}
+
+func (_Contract *Contract) StartWithdrawalCheckpointAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, revertIfNoBalance bool) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.StartWithdrawalCheckpoint(opts, provider, revertIfNoBalance)
+	})
+}
+
+func (_Contract *Contract) UndelegateAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Undelegate(opts, provider)
+	})
+}
+
+func (_Contract *Contract) UnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, to common.Address, shares *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Unstake(opts, to, shares)
+	})
+}
+
+func (_Contract *Contract) VerifyWithdrawalCredentialsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.VerifyWithdrawalCredentials(opts, provider, oracleTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+	})
+}
+
+func (_Contract *Contract) ReceiveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Receive(opts)
+	})
+}
+
+func (_Contract *Contract) SimulateAddRestaker(sim *generated.Simulator, opts *generated.SimulateOpts, provider string) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "addRestaker", provider)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateAddRewards(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "addRewards")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateBatchDeposit(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "batchDeposit", provider, pubkeys, signatures, deposit_data_roots)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateClaimRestaker(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, fee *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "claimRestaker", provider, fee)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateClaimUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, claimer common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "claimUnstake", claimer)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateCompleteWithdrawals(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "completeWithdrawals", provider, withdrawals, tokens, middlewareTimesIndexes, receiveAsTokens)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateDelegateTo(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "delegateTo", provider, elOperator, approverSignatureAndExpiry, approverSalt)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateDistributeUnstakes(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "distributeUnstakes")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateFlashUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, shares *big.Int, receiver common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "flashUnstake", shares, receiver)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "initialize", config, distributeGasLimit, newMaxTVL)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateQueueWithdrawals(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "queueWithdrawals", provider, withdrawals)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateRecoverTokens(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "recoverTokens", provider, tokenList, amountsToWithdraw)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetDistributeGasLimit(sim *generated.Simulator, opts *generated.SimulateOpts, newValue uint32) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setDistributeGasLimit", newValue)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetFlashUnstakeFeeParams(sim *generated.Simulator, opts *generated.SimulateOpts, newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setFlashUnstakeFeeParams", newMaxFlashFeeRate, newOptimalUnstakeRate, newUnstakeUtilizationKink)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetMaxTVL(sim *generated.Simulator, opts *generated.SimulateOpts, newValue *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setMaxTVL", newValue)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetMinStake(sim *generated.Simulator, opts *generated.SimulateOpts, newValue *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setMinStake", newValue)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetMinUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, newValue *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setMinUnstake", newValue)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetProtocolFee(sim *generated.Simulator, opts *generated.SimulateOpts, newProtocolFee uint64) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setProtocolFee", newProtocolFee)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetRewardsCoordinator(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, newCoordinator common.Address, claimer common.Address) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setRewardsCoordinator", provider, newCoordinator, claimer)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetRewardsTimeline(sim *generated.Simulator, opts *generated.SimulateOpts, newTimelineInSeconds *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setRewardsTimeline", newTimelineInSeconds)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetStakeBonusParams(sim *generated.Simulator, opts *generated.SimulateOpts, newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setStakeBonusParams", newMaxBonusRate, newOptimalBonusRate, newStakeUtilizationKink)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateSetTargetFlashCapacity(sim *generated.Simulator, opts *generated.SimulateOpts, newTargetCapacity uint64) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "setTargetFlashCapacity", newTargetCapacity)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateStake(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "stake")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateStake0(sim *generated.Simulator, opts *generated.SimulateOpts, code [32]byte) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "stake0", code)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateStartWithdrawalCheckpoint(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, revertIfNoBalance bool) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "startWithdrawalCheckpoint", provider, revertIfNoBalance)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateUndelegate(sim *generated.Simulator, opts *generated.SimulateOpts, provider string) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "undelegate", provider)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, to common.Address, shares *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "unstake", to, shares)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateVerifyWithdrawalCredentials(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "verifyWithdrawalCredentials", provider, oracleTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+	return gas, err
+}
+
+type ContractInterface interface {
+	CALLGASLIMIT(opts *bind.CallOpts) (*big.Int, error)
+
+	MAXPERCENT(opts *bind.CallOpts) (uint64, error)
+
+	AvailableToStake(opts *bind.CallOpts) (*big.Int, error)
+
+	CalculateFlashUnstakeFee(opts *bind.CallOpts, amount *big.Int) (*big.Int, error)
+
+	CalculateStakeBonus(opts *bind.CallOpts, amount *big.Int) (*big.Int, error)
+
+	ClaimableOf(opts *bind.CallOpts, claimer common.Address) (*big.Int, error)
+
+	Config(opts *bind.CallOpts) (common.Address, error)
+
+	CurrentRewards(opts *bind.CallOpts) (*big.Int, error)
+
+	GetFlashCapacity(opts *bind.CallOpts) (*big.Int, error)
+
+	GetFreeBalance(opts *bind.CallOpts) (*big.Int, error)
+
+	GetMinStake(opts *bind.CallOpts) (*big.Int, error)
+
+	GetMinUnstake(opts *bind.CallOpts) (*big.Int, error)
+
+	GetPending(opts *bind.CallOpts) (*big.Int, error)
+
+	GetRestaker(opts *bind.CallOpts, provider string) (common.Address, error)
+
+	GetTotalClaimable(opts *bind.CallOpts) (*big.Int, error)
+
+	GetTotalPendingUnstakes(opts *bind.CallOpts) (*big.Int, error)
+
+	GetTotalUnstakesOf(opts *bind.CallOpts, recipient common.Address) (*big.Int, error)
+
+	GetUnstakes(opts *bind.CallOpts) ([]IRestakingPoolUnstake, error)
+
+	GetUnstakesOf(opts *bind.CallOpts, recipient common.Address) ([]IRestakingPoolUnstake, error)
+
+	HasClaimable(opts *bind.CallOpts, claimer common.Address) (bool, error)
+
+	MaxBonusRate(opts *bind.CallOpts) (uint64, error)
+
+	MaxFlashFeeRate(opts *bind.CallOpts) (uint64, error)
+
+	MaxTVL(opts *bind.CallOpts) (*big.Int, error)
+
+	OptimalBonusRate(opts *bind.CallOpts) (uint64, error)
+
+	OptimalUnstakeRate(opts *bind.CallOpts) (uint64, error)
+
+	ProtocolFee(opts *bind.CallOpts) (uint64, error)
+
+	RewardsTimeline(opts *bind.CallOpts) (*big.Int, error)
+
+	StakeBonusAmount(opts *bind.CallOpts) (*big.Int, error)
+
+	StakeUtilizationKink(opts *bind.CallOpts) (uint64, error)
+
+	StartTimeline(opts *bind.CallOpts) (*big.Int, error)
+
+	TargetCapacity(opts *bind.CallOpts) (uint64, error)
+
+	TotalAssets(opts *bind.CallOpts) (*big.Int, error)
+
+	UnstakeUtilizationKink(opts *bind.CallOpts) (uint64, error)
+
+	AddRestaker(opts *bind.TransactOpts, provider string) (*types.Transaction, error)
+
+	AddRewards(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	BatchDeposit(opts *bind.TransactOpts, provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (*types.Transaction, error)
+
+	ClaimRestaker(opts *bind.TransactOpts, provider string, fee *big.Int) (*types.Transaction, error)
+
+	ClaimUnstake(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error)
+
+	CompleteWithdrawals(opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error)
+
+	DelegateTo(opts *bind.TransactOpts, provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+
+	DistributeUnstakes(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	FlashUnstake(opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*types.Transaction, error)
+
+	Initialize(opts *bind.TransactOpts, config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (*types.Transaction, error)
+
+	QueueWithdrawals(opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error)
+
+	RecoverTokens(opts *bind.TransactOpts, provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (*types.Transaction, error)
+
+	SetDistributeGasLimit(opts *bind.TransactOpts, newValue uint32) (*types.Transaction, error)
+
+	SetFlashUnstakeFeeParams(opts *bind.TransactOpts, newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (*types.Transaction, error)
+
+	SetMaxTVL(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error)
+
+	SetMinStake(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error)
+
+	SetMinUnstake(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error)
+
+	SetProtocolFee(opts *bind.TransactOpts, newProtocolFee uint64) (*types.Transaction, error)
+
+	SetRewardsCoordinator(opts *bind.TransactOpts, provider string, newCoordinator common.Address, claimer common.Address) (*types.Transaction, error)
+
+	SetRewardsTimeline(opts *bind.TransactOpts, newTimelineInSeconds *big.Int) (*types.Transaction, error)
+
+	SetStakeBonusParams(opts *bind.TransactOpts, newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (*types.Transaction, error)
+
+	SetTargetFlashCapacity(opts *bind.TransactOpts, newTargetCapacity uint64) (*types.Transaction, error)
+
+	Stake(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	Stake0(opts *bind.TransactOpts, code [32]byte) (*types.Transaction, error)
+
+	StartWithdrawalCheckpoint(opts *bind.TransactOpts, provider string, revertIfNoBalance bool) (*types.Transaction, error)
+
+	Undelegate(opts *bind.TransactOpts, provider string) (*types.Transaction, error)
+
+	Unstake(opts *bind.TransactOpts, to common.Address, shares *big.Int) (*types.Transaction, error)
+
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+
+	Receive(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	FilterClaimExpected(opts *bind.FilterOpts, claimer []common.Address) (*ContractClaimExpectedIterator, error)
+
+	WatchClaimExpected(opts *bind.WatchOpts, sink chan<- *ContractClaimExpected, claimer []common.Address) (event.Subscription, error)
+
+	ParseClaimExpected(log types.Log) (*ContractClaimExpected, error)
+
+	FilterDeposited(opts *bind.FilterOpts, provider []string) (*ContractDepositedIterator, error)
+
+	WatchDeposited(opts *bind.WatchOpts, sink chan<- *ContractDeposited, provider []string) (event.Subscription, error)
+
+	ParseDeposited(log types.Log) (*ContractDeposited, error)
+
+	FilterDistributeGasLimitChanged(opts *bind.FilterOpts) (*ContractDistributeGasLimitChangedIterator, error)
+
+	WatchDistributeGasLimitChanged(opts *bind.WatchOpts, sink chan<- *ContractDistributeGasLimitChanged) (event.Subscription, error)
+
+	ParseDistributeGasLimitChanged(log types.Log) (*ContractDistributeGasLimitChanged, error)
+
+	FilterFeeClaimed(opts *bind.FilterOpts, restaker []common.Address, treasury []common.Address) (*ContractFeeClaimedIterator, error)
+
+	WatchFeeClaimed(opts *bind.WatchOpts, sink chan<- *ContractFeeClaimed, restaker []common.Address, treasury []common.Address) (event.Subscription, error)
+
+	ParseFeeClaimed(log types.Log) (*ContractFeeClaimed, error)
+
+	FilterFlashUnstaked(opts *bind.FilterOpts, sender []common.Address, receiver []common.Address, owner []common.Address) (*ContractFlashUnstakedIterator, error)
+
+	WatchFlashUnstaked(opts *bind.WatchOpts, sink chan<- *ContractFlashUnstaked, sender []common.Address, receiver []common.Address, owner []common.Address) (event.Subscription, error)
+
+	ParseFlashUnstaked(log types.Log) (*ContractFlashUnstaked, error)
+
+	FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error)
+
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error)
+
+	ParseInitialized(log types.Log) (*ContractInitialized, error)
+
+	FilterMaxTVLChanged(opts *bind.FilterOpts) (*ContractMaxTVLChangedIterator, error)
+
+	WatchMaxTVLChanged(opts *bind.WatchOpts, sink chan<- *ContractMaxTVLChanged) (event.Subscription, error)
+
+	ParseMaxTVLChanged(log types.Log) (*ContractMaxTVLChanged, error)
+
+	FilterMinStakeChanged(opts *bind.FilterOpts) (*ContractMinStakeChangedIterator, error)
+
+	WatchMinStakeChanged(opts *bind.WatchOpts, sink chan<- *ContractMinStakeChanged) (event.Subscription, error)
+
+	ParseMinStakeChanged(log types.Log) (*ContractMinStakeChanged, error)
+
+	FilterMinUnstakeChanged(opts *bind.FilterOpts) (*ContractMinUnstakeChangedIterator, error)
+
+	WatchMinUnstakeChanged(opts *bind.WatchOpts, sink chan<- *ContractMinUnstakeChanged) (event.Subscription, error)
+
+	ParseMinUnstakeChanged(log types.Log) (*ContractMinUnstakeChanged, error)
+
+	FilterPendingUnstake(opts *bind.FilterOpts, ownerAddress []common.Address, receiverAddress []common.Address) (*ContractPendingUnstakeIterator, error)
+
+	WatchPendingUnstake(opts *bind.WatchOpts, sink chan<- *ContractPendingUnstake, ownerAddress []common.Address, receiverAddress []common.Address) (event.Subscription, error)
+
+	ParsePendingUnstake(log types.Log) (*ContractPendingUnstake, error)
+
+	FilterProtocolFeeChanged(opts *bind.FilterOpts) (*ContractProtocolFeeChangedIterator, error)
+
+	WatchProtocolFeeChanged(opts *bind.WatchOpts, sink chan<- *ContractProtocolFeeChanged) (event.Subscription, error)
+
+	ParseProtocolFeeChanged(log types.Log) (*ContractProtocolFeeChanged, error)
+
+	FilterReceived(opts *bind.FilterOpts, sender []common.Address) (*ContractReceivedIterator, error)
+
+	WatchReceived(opts *bind.WatchOpts, sink chan<- *ContractReceived, sender []common.Address) (event.Subscription, error)
+
+	ParseReceived(log types.Log) (*ContractReceived, error)
+
+	FilterReferralStake(opts *bind.FilterOpts, code [][32]byte) (*ContractReferralStakeIterator, error)
+
+	WatchReferralStake(opts *bind.WatchOpts, sink chan<- *ContractReferralStake, code [][32]byte) (event.Subscription, error)
+
+	ParseReferralStake(log types.Log) (*ContractReferralStake, error)
+
+	FilterRestakerAdded(opts *bind.FilterOpts, provider []string) (*ContractRestakerAddedIterator, error)
+
+	WatchRestakerAdded(opts *bind.WatchOpts, sink chan<- *ContractRestakerAdded, provider []string) (event.Subscription, error)
+
+	ParseRestakerAdded(log types.Log) (*ContractRestakerAdded, error)
+
+	FilterRewardsAdded(opts *bind.FilterOpts) (*ContractRewardsAddedIterator, error)
+
+	WatchRewardsAdded(opts *bind.WatchOpts, sink chan<- *ContractRewardsAdded) (event.Subscription, error)
+
+	ParseRewardsAdded(log types.Log) (*ContractRewardsAdded, error)
+
+	FilterRewardsTimelineChanged(opts *bind.FilterOpts) (*ContractRewardsTimelineChangedIterator, error)
+
+	WatchRewardsTimelineChanged(opts *bind.WatchOpts, sink chan<- *ContractRewardsTimelineChanged) (event.Subscription, error)
+
+	ParseRewardsTimelineChanged(log types.Log) (*ContractRewardsTimelineChanged, error)
+
+	FilterStakeBonus(opts *bind.FilterOpts) (*ContractStakeBonusIterator, error)
+
+	WatchStakeBonus(opts *bind.WatchOpts, sink chan<- *ContractStakeBonus) (event.Subscription, error)
+
+	ParseStakeBonus(log types.Log) (*ContractStakeBonus, error)
+
+	FilterStakeBonusParamsChanged(opts *bind.FilterOpts) (*ContractStakeBonusParamsChangedIterator, error)
+
+	WatchStakeBonusParamsChanged(opts *bind.WatchOpts, sink chan<- *ContractStakeBonusParamsChanged) (event.Subscription, error)
+
+	ParseStakeBonusParamsChanged(log types.Log) (*ContractStakeBonusParamsChanged, error)
+
+	FilterStaked(opts *bind.FilterOpts, staker []common.Address) (*ContractStakedIterator, error)
+
+	WatchStaked(opts *bind.WatchOpts, sink chan<- *ContractStaked, staker []common.Address) (event.Subscription, error)
+
+	ParseStaked(log types.Log) (*ContractStaked, error)
+
+	FilterTargetCapacityChanged(opts *bind.FilterOpts) (*ContractTargetCapacityChangedIterator, error)
+
+	WatchTargetCapacityChanged(opts *bind.WatchOpts, sink chan<- *ContractTargetCapacityChanged) (event.Subscription, error)
+
+	ParseTargetCapacityChanged(log types.Log) (*ContractTargetCapacityChanged, error)
+
+	FilterUnstakeClaimed(opts *bind.FilterOpts, claimer []common.Address, caller []common.Address) (*ContractUnstakeClaimedIterator, error)
+
+	WatchUnstakeClaimed(opts *bind.WatchOpts, sink chan<- *ContractUnstakeClaimed, claimer []common.Address, caller []common.Address) (event.Subscription, error)
+
+	ParseUnstakeClaimed(log types.Log) (*ContractUnstakeClaimed, error)
+
+	FilterUnstakeFeeParamsChanged(opts *bind.FilterOpts) (*ContractUnstakeFeeParamsChangedIterator, error)
+
+	WatchUnstakeFeeParamsChanged(opts *bind.WatchOpts, sink chan<- *ContractUnstakeFeeParamsChanged) (event.Subscription, error)
+
+	ParseUnstakeFeeParamsChanged(log types.Log) (*ContractUnstakeFeeParamsChanged, error)
+
+	FilterUnstaked(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ContractUnstakedIterator, error)
+
+	WatchUnstaked(opts *bind.WatchOpts, sink chan<- *ContractUnstaked, from []common.Address, to []common.Address) (event.Subscription, error)
+
+	ParseUnstaked(log types.Log) (*ContractUnstaked, error)
+
+	FilterUnstakesDistributed(opts *bind.FilterOpts) (*ContractUnstakesDistributedIterator, error)
+
+	WatchUnstakesDistributed(opts *bind.WatchOpts, sink chan<- *ContractUnstakesDistributed) (event.Subscription, error)
+
+	ParseUnstakesDistributed(log types.Log) (*ContractUnstakesDistributed, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	AddRestakerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string) (*generated.Result, error)
+
+	AddRewardsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	BatchDepositAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (*generated.Result, error)
+
+	ClaimRestakerAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, fee *big.Int) (*generated.Result, error)
+
+	ClaimUnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, claimer common.Address) (*generated.Result, error)
+
+	CompleteWithdrawalsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*generated.Result, error)
+
+	DelegateToAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*generated.Result, error)
+
+	DistributeUnstakesAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	FlashUnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, shares *big.Int, receiver common.Address) (*generated.Result, error)
+
+	InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (*generated.Result, error)
+
+	QueueWithdrawalsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (*generated.Result, error)
+
+	RecoverTokensAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (*generated.Result, error)
+
+	SetDistributeGasLimitAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue uint32) (*generated.Result, error)
+
+	SetFlashUnstakeFeeParamsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (*generated.Result, error)
+
+	SetMaxTVLAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue *big.Int) (*generated.Result, error)
+
+	SetMinStakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue *big.Int) (*generated.Result, error)
+
+	SetMinUnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newValue *big.Int) (*generated.Result, error)
+
+	SetProtocolFeeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newProtocolFee uint64) (*generated.Result, error)
+
+	SetRewardsCoordinatorAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, newCoordinator common.Address, claimer common.Address) (*generated.Result, error)
+
+	SetRewardsTimelineAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newTimelineInSeconds *big.Int) (*generated.Result, error)
+
+	SetStakeBonusParamsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (*generated.Result, error)
+
+	SetTargetFlashCapacityAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newTargetCapacity uint64) (*generated.Result, error)
+
+	StakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	Stake0AndWait(waiter *generated.Waiter, opts *bind.TransactOpts, code [32]byte) (*generated.Result, error)
+
+	StartWithdrawalCheckpointAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, revertIfNoBalance bool) (*generated.Result, error)
+
+	UndelegateAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string) (*generated.Result, error)
+
+	UnstakeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, to common.Address, shares *big.Int) (*generated.Result, error)
+
+	VerifyWithdrawalCredentialsAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*generated.Result, error)
+
+	ReceiveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SimulateAddRestaker(sim *generated.Simulator, opts *generated.SimulateOpts, provider string) (uint64, error)
+
+	SimulateAddRewards(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateBatchDeposit(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, pubkeys [][]byte, signatures [][]byte, deposit_data_roots [][32]byte) (uint64, error)
+
+	SimulateClaimRestaker(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, fee *big.Int) (uint64, error)
+
+	SimulateClaimUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, claimer common.Address) (uint64, error)
+
+	SimulateCompleteWithdrawals(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (uint64, error)
+
+	SimulateDelegateTo(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, elOperator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (uint64, error)
+
+	SimulateDistributeUnstakes(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateFlashUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, shares *big.Int, receiver common.Address) (uint64, error)
+
+	SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, config common.Address, distributeGasLimit uint32, newMaxTVL *big.Int) (uint64, error)
+
+	SimulateQueueWithdrawals(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, withdrawals []IDelegationManagerQueuedWithdrawalParams) (uint64, error)
+
+	SimulateRecoverTokens(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, tokenList []common.Address, amountsToWithdraw []*big.Int) (uint64, error)
+
+	SimulateSetDistributeGasLimit(sim *generated.Simulator, opts *generated.SimulateOpts, newValue uint32) (uint64, error)
+
+	SimulateSetFlashUnstakeFeeParams(sim *generated.Simulator, opts *generated.SimulateOpts, newMaxFlashFeeRate uint64, newOptimalUnstakeRate uint64, newUnstakeUtilizationKink uint64) (uint64, error)
+
+	SimulateSetMaxTVL(sim *generated.Simulator, opts *generated.SimulateOpts, newValue *big.Int) (uint64, error)
+
+	SimulateSetMinStake(sim *generated.Simulator, opts *generated.SimulateOpts, newValue *big.Int) (uint64, error)
+
+	SimulateSetMinUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, newValue *big.Int) (uint64, error)
+
+	SimulateSetProtocolFee(sim *generated.Simulator, opts *generated.SimulateOpts, newProtocolFee uint64) (uint64, error)
+
+	SimulateSetRewardsCoordinator(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, newCoordinator common.Address, claimer common.Address) (uint64, error)
+
+	SimulateSetRewardsTimeline(sim *generated.Simulator, opts *generated.SimulateOpts, newTimelineInSeconds *big.Int) (uint64, error)
+
+	SimulateSetStakeBonusParams(sim *generated.Simulator, opts *generated.SimulateOpts, newMaxBonusRate uint64, newOptimalBonusRate uint64, newStakeUtilizationKink uint64) (uint64, error)
+
+	SimulateSetTargetFlashCapacity(sim *generated.Simulator, opts *generated.SimulateOpts, newTargetCapacity uint64) (uint64, error)
+
+	SimulateStake(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateStake0(sim *generated.Simulator, opts *generated.SimulateOpts, code [32]byte) (uint64, error)
+
+	SimulateStartWithdrawalCheckpoint(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, revertIfNoBalance bool) (uint64, error)
+
+	SimulateUndelegate(sim *generated.Simulator, opts *generated.SimulateOpts, provider string) (uint64, error)
+
+	SimulateUnstake(sim *generated.Simulator, opts *generated.SimulateOpts, to common.Address, shares *big.Int) (uint64, error)
+
+	SimulateVerifyWithdrawalCredentials(sim *generated.Simulator, opts *generated.SimulateOpts, provider string, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (uint64, error)
+}
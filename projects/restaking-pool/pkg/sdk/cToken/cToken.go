@@ -0,0 +1,1864 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package cToken
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+var ContractMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EnforcedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ExpectedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"MathOverflowedMulDiv\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyGovernanceAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyOperatorAllowed\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OnlyRestakingPoolAllowed\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"newName\",\"type\":\"string\"}],\"name\":\"NameChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"newSymbol\",\"type\":\"string\"}],\"name\":\"SymbolChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"newName\",\"type\":\"string\"}],\"name\":\"changeName\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"newSymbol\",\"type\":\"string\"}],\"name\":\"changeSymbol\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"convertToAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"convertToShares\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIProtocolConfig\",\"name\":\"config\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ratio\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalAssets\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"totalManagedEth\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x608060405234801561001057600080fd5b5061001961001e565b6100d0565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff161561006e5760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146100cd5780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b611769806100df6000396000f3fe608060405234801561001057600080fd5b50600436106101425760003560e01c806371ca337d116100b85780639dc29fac1161007c5780639dc29fac146102a7578063a3895fff146102ba578063a9059cbb146102cd578063c6e6f592146102e0578063dd62ed3e146102f3578063ff1bdd431461030657600080fd5b806371ca337d1461026157806379502c55146102695780638456cb5914610284578063906571471461028c57806395d89b411461029f57600080fd5b8063313ce5671161010a578063313ce567146101d45780633f4ba83a146101e357806340c10f19146101ed5780635353a2d8146102005780635c975abb1461021357806370a082311461022b57600080fd5b806301e1d1141461014757806306fdde0314610162578063095ea7b31461017757806318160ddd1461019a57806323b872dd146101c1575b600080fd5b61014f610319565b6040519081526020015b60405180910390f35b61016a61034b565b6040516101599190611263565b61018a6101853660046112c6565b6103dd565b6040519015158152602001610159565b7f52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace025461014f565b61018a6101cf3660046112f2565b6103f7565b60405160128152602001610159565b6101eb61041d565b005b6101eb6101fb3660046112c6565b6104cd565b6101eb61020e3660046113d6565b610589565b6000805160206117148339815191525460ff1661018a565b61014f610239366004611413565b6001600160a01b031660009081526000805160206116f4833981519152602052604090205490565b61014f61063b565b6000546040516001600160a01b039091168152602001610159565b6101eb610713565b6101eb61029a366004611430565b6107c1565b61016a6108f7565b6101eb6102b53660046112c6565b610906565b6101eb6102c83660046113d6565b6109be565b61018a6102db3660046112c6565b610a6d565b61014f6102ee3660046114a6565b610a7b565b61014f6103013660046114bf565b610a9a565b61014f6103143660046114a6565b610ae4565b60006103466103147f52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace025490565b905090565b60606032805461035a906114f8565b80601f0160208091040260200160405190810160405280929190818152602001828054610386906114f8565b80156103d35780601f106103a8576101008083540402835291602001916103d3565b820191906000526020600020905b8154815290600101906020018083116103b657829003601f168201915b5050505050905090565b6000336103eb818585610b04565b60019150505b92915050565b600033610405858285610b16565b610410858585610b81565b60019150505b9392505050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561046e573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104929190611532565b6001600160a01b0316336001600160a01b0316146104c35760405163e2d4f15f60e01b815260040160405180910390fd5b6104cb610be0565b565b6104d5610c41565b60008054906101000a90046001600160a01b03166001600160a01b0316637745165b6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610526573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061054a9190611532565b6001600160a01b0316336001600160a01b03161461057b576040516301beb2d760e51b815260040160405180910390fd5b6105858282610c72565b5050565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156105da573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105fe9190611532565b6001600160a01b0316336001600160a01b03161461062f5760405163e2d4f15f60e01b815260040160405180910390fd5b61063881610ca8565b50565b600080546001600160a01b03166001600160a01b031663c5db8a7a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610685573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106a99190611532565b60405163754b270760e01b81523060048201526001600160a01b03919091169063754b270790602401602060405180830381865afa1580156106ef573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610346919061154f565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610764573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107889190611532565b6001600160a01b0316336001600160a01b0316146107b95760405163e2d4f15f60e01b815260040160405180910390fd5b6104cb610ce4565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a008054600160401b810460ff16159067ffffffffffffffff166000811580156108075750825b905060008267ffffffffffffffff1660011480156108245750303b155b905081158015610832575080155b156108505760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff19166001178555831561087a57845460ff60401b1916600160401b1785555b61088388610d2d565b61088d8787610d57565b610895610d69565b61089d610d69565b6108a78787610d79565b83156108ed57845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b5050505050505050565b60606033805461035a906114f8565b61090e610c41565b60008054906101000a90046001600160a01b03166001600160a01b0316637745165b6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561095f573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906109839190611532565b6001600160a01b0316336001600160a01b0316146109b4576040516301beb2d760e51b815260040160405180910390fd5b6105858282610d8b565b60008054906101000a90046001600160a01b03166001600160a01b031663289b3c0d6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610a0f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610a339190611532565b6001600160a01b0316336001600160a01b031614610a645760405163e2d4f15f60e01b815260040160405180910390fd5b61063881610dc1565b6000336103eb818585610b81565b60006103f1610a8861063b565b8390670de0b6b3a76400006000610dfd565b6001600160a01b0391821660009081527f52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace016020908152604080832093909416825291909152205490565b60006103f1670de0b6b3a7640000610afa61063b565b8491906001610dfd565b610b118383836001610e4e565b505050565b6000610b228484610a9a565b90506000198114610b7b5781811015610b6c57604051637dc7a0d960e11b81526001600160a01b038416600482015260248101829052604481018390526064015b60405180910390fd5b610b7b84848484036000610e4e565b50505050565b6001600160a01b038316610bab57604051634b637e8f60e11b815260006004820152602401610b63565b6001600160a01b038216610bd55760405163ec442f0560e01b815260006004820152602401610b63565b610b11838383610f36565b610be8610f49565b600080516020611714833981519152805460ff191681557f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa335b6040516001600160a01b0390911681526020015b60405180910390a150565b6000805160206117148339815191525460ff16156104cb5760405163d93c066560e01b815260040160405180910390fd5b6001600160a01b038216610c9c5760405163ec442f0560e01b815260006004820152602401610b63565b61058560008383610f36565b6032610cb482826115b6565b507f4737457377f528cc8afd815f73ecb8b05df80d047dbffc41c17750a4033592bc81604051610c369190611263565b610cec610c41565b600080516020611714833981519152805460ff191660011781557f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a25833610c22565b610d35610f79565b600080546001600160a01b0319166001600160a01b0392909216919091179055565b610d5f610f79565b6105858282610fc2565b610d71610f79565b6104cb611013565b610d8282610ca8565b61058581610dc1565b6001600160a01b038216610db557604051634b637e8f60e11b815260006004820152602401610b63565b61058582600083610f36565b6033610dcd82826115b6565b507f57c940aa14b51ea5f96b7a2bea757ce355d996e2c5d7a3c68aff1c75a326269b81604051610c369190611263565b600080610e0b868686611034565b9050610e16836110f8565b8015610e32575060008480610e2d57610e2d611676565b868809115b15610e4557610e4260018261168c565b90505b95945050505050565b6000805160206116f48339815191526001600160a01b038516610e875760405163e602df0560e01b815260006004820152602401610b63565b6001600160a01b038416610eb157604051634a1406b160e11b815260006004820152602401610b63565b6001600160a01b03808616600090815260018301602090815260408083209388168352929052208390558115610f2f57836001600160a01b0316856001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92585604051610f2691815260200190565b60405180910390a35b5050505050565b610f3e610c41565b610b11838383611125565b6000805160206117148339815191525460ff166104cb57604051638dfc202b60e01b815260040160405180910390fd5b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a0054600160401b900460ff166104cb57604051631afcd79f60e31b815260040160405180910390fd5b610fca610f79565b6000805160206116f48339815191527f52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace0361100484826115b6565b5060048101610b7b83826115b6565b61101b610f79565b600080516020611714833981519152805460ff19169055565b600083830281600019858709828110838203039150508060000361106b5783828161106157611061611676565b0492505050610416565b80841161108b5760405163227bc15360e01b815260040160405180910390fd5b6000848688096000868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010186841190950394909402919094039290920491909117919091029150509392505050565b6000600282600381111561110e5761110e6116ad565b61111891906116c3565b60ff166001149050919050565b6000805160206116f48339815191526001600160a01b0384166111615781816002016000828254611156919061168c565b909155506111d39050565b6001600160a01b038416600090815260208290526040902054828110156111b45760405163391434e360e21b81526001600160a01b03861660048201526024810182905260448101849052606401610b63565b6001600160a01b03851660009081526020839052604090209083900390555b6001600160a01b0383166111f1576002810180548390039055611210565b6001600160a01b03831660009081526020829052604090208054830190555b826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8460405161125591815260200190565b60405180910390a350505050565b600060208083528351808285015260005b8181101561129057858101830151858201604001528201611274565b506000604082860101526040601f19601f8301168501019250505092915050565b6001600160a01b038116811461063857600080fd5b600080604083850312156112d957600080fd5b82356112e4816112b1565b946020939093013593505050565b60008060006060848603121561130757600080fd5b8335611312816112b1565b92506020840135611322816112b1565b929592945050506040919091013590565b634e487b7160e01b600052604160045260246000fd5b600082601f83011261135a57600080fd5b813567ffffffffffffffff8082111561137557611375611333565b604051601f8301601f19908116603f0116810190828211818310171561139d5761139d611333565b816040528381528660208588010111156113b657600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000602082840312156113e857600080fd5b813567ffffffffffffffff8111156113ff57600080fd5b61140b84828501611349565b949350505050565b60006020828403121561142557600080fd5b8135610416816112b1565b60008060006060848603121561144557600080fd5b8335611450816112b1565b9250602084013567ffffffffffffffff8082111561146d57600080fd5b61147987838801611349565b9350604086013591508082111561148f57600080fd5b5061149c86828701611349565b9150509250925092565b6000602082840312156114b857600080fd5b5035919050565b600080604083850312156114d257600080fd5b82356114dd816112b1565b915060208301356114ed816112b1565b809150509250929050565b600181811c9082168061150c57607f821691505b60208210810361152c57634e487b7160e01b600052602260045260246000fd5b50919050565b60006020828403121561154457600080fd5b8151610416816112b1565b60006020828403121561156157600080fd5b5051919050565b601f821115610b1157600081815260208120601f850160051c8101602086101561158f5750805b601f850160051c820191505b818110156115ae5782815560010161159b565b505050505050565b815167ffffffffffffffff8111156115d0576115d0611333565b6115e4816115de84546114f8565b84611568565b602080601f83116001811461161957600084156116015750858301515b600019600386901b1c1916600185901b1785556115ae565b600085815260208120601f198616915b8281101561164857888601518255948401946001909101908401611629565b50858210156116665787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b634e487b7160e01b600052601260045260246000fd5b808201808211156103f157634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052602160045260246000fd5b600060ff8316806116e457634e487b7160e01b600052601260045260246000fd5b8060ff8416069150509291505056fe52c63247e1f47db19d5ce0460030c497f067ca4cebf71ba98eeadabe20bace00cd5ed15c6e187e77e9aee88184c21f4f2182ab5827cb3b7e07fbedcd63f03300a26469706673582212204b66bdfeae6a6e6b6dd36b528048b1caeadd8157b96eac26062f25ab1dd25eb364736f6c63430008150033",
+}
+
+var ContractABI = ContractMetaData.ABI
+
+var ContractBin = ContractMetaData.Bin
+
+func DeployContract(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Contract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ContractBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Contract{ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	ContractCaller
+	ContractTransactor
+	ContractFilterer
+}
+
+type ContractCaller struct {
+	contract *bind.BoundContract
+}
+
+type ContractTransactor struct {
+	contract *bind.BoundContract
+}
+
+type ContractFilterer struct {
+	contract *bind.BoundContract
+}
+
+type ContractSession struct {
+	Contract     *Contract
+	CallOpts     bind.CallOpts
+	TransactOpts bind.TransactOpts
+}
+
+type ContractCallerSession struct {
+	Contract *ContractCaller
+	CallOpts bind.CallOpts
+}
+
+type ContractTransactorSession struct {
+	Contract     *ContractTransactor
+	TransactOpts bind.TransactOpts
+}
+
+type ContractRaw struct {
+	Contract *Contract
+}
+
+type ContractCallerRaw struct {
+	Contract *ContractCaller
+}
+
+type ContractTransactorRaw struct {
+	Contract *ContractTransactor
+}
+
+func NewContract(address common.Address, backend bind.ContractBackend) (*Contract, error) {
+	abi, err := abi.JSON(strings.NewReader(ContractABI))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := bindContract(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Contract{address: address, abi: abi, ContractCaller: ContractCaller{contract: contract}, ContractTransactor: ContractTransactor{contract: contract}, ContractFilterer: ContractFilterer{contract: contract}}, nil
+}
+
+func NewContractCaller(address common.Address, caller bind.ContractCaller) (*ContractCaller, error) {
+	contract, err := bindContract(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractCaller{contract: contract}, nil
+}
+
+func NewContractTransactor(address common.Address, transactor bind.ContractTransactor) (*ContractTransactor, error) {
+	contract, err := bindContract(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransactor{contract: contract}, nil
+}
+
+func NewContractFilterer(address common.Address, filterer bind.ContractFilterer) (*ContractFilterer, error) {
+	contract, err := bindContract(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractFilterer{contract: contract}, nil
+}
+
+func bindContract(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+func (_Contract *ContractRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.ContractCaller.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transfer(opts)
+}
+
+func (_Contract *ContractRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.ContractTransactor.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Contract.Contract.contract.Call(opts, result, method, params...)
+}
+
+func (_Contract *ContractTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transfer(opts)
+}
+
+func (_Contract *ContractTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Contract.Contract.contract.Transact(opts, method, params...)
+}
+
+func (_Contract *ContractCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _Contract.Contract.Allowance(&_Contract.CallOpts, owner, spender)
+}
+
+func (_Contract *ContractCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _Contract.Contract.Allowance(&_Contract.CallOpts, owner, spender)
+}
+
+func (_Contract *ContractCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _Contract.Contract.BalanceOf(&_Contract.CallOpts, account)
+}
+
+func (_Contract *ContractCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _Contract.Contract.BalanceOf(&_Contract.CallOpts, account)
+}
+
+func (_Contract *ContractCaller) Config(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "config")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Config() (common.Address, error) {
+	return _Contract.Contract.Config(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) ConvertToAmount(opts *bind.CallOpts, shares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "convertToAmount", shares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) ConvertToAmount(shares *big.Int) (*big.Int, error) {
+	return _Contract.Contract.ConvertToAmount(&_Contract.CallOpts, shares)
+}
+
+func (_Contract *ContractCallerSession) ConvertToAmount(shares *big.Int) (*big.Int, error) {
+	return _Contract.Contract.ConvertToAmount(&_Contract.CallOpts, shares)
+}
+
+func (_Contract *ContractCaller) ConvertToShares(opts *bind.CallOpts, amount *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "convertToShares", amount)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) ConvertToShares(amount *big.Int) (*big.Int, error) {
+	return _Contract.Contract.ConvertToShares(&_Contract.CallOpts, amount)
+}
+
+func (_Contract *ContractCallerSession) ConvertToShares(amount *big.Int) (*big.Int, error) {
+	return _Contract.Contract.ConvertToShares(&_Contract.CallOpts, amount)
+}
+
+func (_Contract *ContractCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Decimals() (uint8, error) {
+	return _Contract.Contract.Decimals(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Decimals() (uint8, error) {
+	return _Contract.Contract.Decimals(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Name() (string, error) {
+	return _Contract.Contract.Name(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Name() (string, error) {
+	return _Contract.Contract.Name(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Paused() (bool, error) {
+	return _Contract.Contract.Paused(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Paused() (bool, error) {
+	return _Contract.Contract.Paused(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Ratio(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "ratio")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Ratio() (*big.Int, error) {
+	return _Contract.Contract.Ratio(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Ratio() (*big.Int, error) {
+	return _Contract.Contract.Ratio(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) Symbol() (string, error) {
+	return _Contract.Contract.Symbol(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) Symbol() (string, error) {
+	return _Contract.Contract.Symbol(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) TotalAssets(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "totalAssets")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) TotalAssets() (*big.Int, error) {
+	return _Contract.Contract.TotalAssets(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) TotalAssets() (*big.Int, error) {
+	return _Contract.Contract.TotalAssets(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Contract.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+func (_Contract *ContractSession) TotalSupply() (*big.Int, error) {
+	return _Contract.Contract.TotalSupply(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractCallerSession) TotalSupply() (*big.Int, error) {
+	return _Contract.Contract.TotalSupply(&_Contract.CallOpts)
+}
+
+func (_Contract *ContractTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "approve", spender, value)
+}
+
+func (_Contract *ContractSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Approve(&_Contract.TransactOpts, spender, value)
+}
+
+func (_Contract *ContractTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Approve(&_Contract.TransactOpts, spender, value)
+}
+
+func (_Contract *ContractTransactor) Burn(opts *bind.TransactOpts, account common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "burn", account, shares)
+}
+
+func (_Contract *ContractSession) Burn(account common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Burn(&_Contract.TransactOpts, account, shares)
+}
+
+func (_Contract *ContractTransactorSession) Burn(account common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Burn(&_Contract.TransactOpts, account, shares)
+}
+
+func (_Contract *ContractTransactor) ChangeName(opts *bind.TransactOpts, newName string) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "changeName", newName)
+}
+
+func (_Contract *ContractSession) ChangeName(newName string) (*types.Transaction, error) {
+	return _Contract.Contract.ChangeName(&_Contract.TransactOpts, newName)
+}
+
+func (_Contract *ContractTransactorSession) ChangeName(newName string) (*types.Transaction, error) {
+	return _Contract.Contract.ChangeName(&_Contract.TransactOpts, newName)
+}
+
+func (_Contract *ContractTransactor) ChangeSymbol(opts *bind.TransactOpts, newSymbol string) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "changeSymbol", newSymbol)
+}
+
+func (_Contract *ContractSession) ChangeSymbol(newSymbol string) (*types.Transaction, error) {
+	return _Contract.Contract.ChangeSymbol(&_Contract.TransactOpts, newSymbol)
+}
+
+func (_Contract *ContractTransactorSession) ChangeSymbol(newSymbol string) (*types.Transaction, error) {
+	return _Contract.Contract.ChangeSymbol(&_Contract.TransactOpts, newSymbol)
+}
+
+func (_Contract *ContractTransactor) Initialize(opts *bind.TransactOpts, config common.Address, name string, symbol string) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "initialize", config, name, symbol)
+}
+
+func (_Contract *ContractSession) Initialize(config common.Address, name string, symbol string) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, config, name, symbol)
+}
+
+func (_Contract *ContractTransactorSession) Initialize(config common.Address, name string, symbol string) (*types.Transaction, error) {
+	return _Contract.Contract.Initialize(&_Contract.TransactOpts, config, name, symbol)
+}
+
+func (_Contract *ContractTransactor) Mint(opts *bind.TransactOpts, account common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "mint", account, shares)
+}
+
+func (_Contract *ContractSession) Mint(account common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Mint(&_Contract.TransactOpts, account, shares)
+}
+
+func (_Contract *ContractTransactorSession) Mint(account common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Mint(&_Contract.TransactOpts, account, shares)
+}
+
+func (_Contract *ContractTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "pause")
+}
+
+func (_Contract *ContractSession) Pause() (*types.Transaction, error) {
+	return _Contract.Contract.Pause(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Pause() (*types.Transaction, error) {
+	return _Contract.Contract.Pause(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "transfer", to, value)
+}
+
+func (_Contract *ContractSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Transfer(&_Contract.TransactOpts, to, value)
+}
+
+func (_Contract *ContractTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.Transfer(&_Contract.TransactOpts, to, value)
+}
+
+func (_Contract *ContractTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+func (_Contract *ContractSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.TransferFrom(&_Contract.TransactOpts, from, to, value)
+}
+
+func (_Contract *ContractTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _Contract.Contract.TransferFrom(&_Contract.TransactOpts, from, to, value)
+}
+
+func (_Contract *ContractTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Contract.contract.Transact(opts, "unpause")
+}
+
+func (_Contract *ContractSession) Unpause() (*types.Transaction, error) {
+	return _Contract.Contract.Unpause(&_Contract.TransactOpts)
+}
+
+func (_Contract *ContractTransactorSession) Unpause() (*types.Transaction, error) {
+	return _Contract.Contract.Unpause(&_Contract.TransactOpts)
+}
+
+type ContractApprovalIterator struct {
+	Event *ContractApproval
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractApprovalIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractApprovalIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ContractApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractApprovalIterator{contract: _Contract.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ContractApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractApproval)
+				if err := _Contract.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseApproval(log types.Log) (*ContractApproval, error) {
+	event := new(ContractApproval)
+	if err := _Contract.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractInitializedIterator struct {
+	Event *ContractInitialized
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractInitializedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractInitialized struct {
+	Version uint64
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractInitializedIterator{contract: _Contract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractInitialized)
+				if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseInitialized(log types.Log) (*ContractInitialized, error) {
+	event := new(ContractInitialized)
+	if err := _Contract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractNameChangedIterator struct {
+	Event *ContractNameChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractNameChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractNameChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractNameChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractNameChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractNameChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractNameChanged struct {
+	NewName string
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterNameChanged(opts *bind.FilterOpts) (*ContractNameChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "NameChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractNameChangedIterator{contract: _Contract.contract, event: "NameChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchNameChanged(opts *bind.WatchOpts, sink chan<- *ContractNameChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "NameChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractNameChanged)
+				if err := _Contract.contract.UnpackLog(event, "NameChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseNameChanged(log types.Log) (*ContractNameChanged, error) {
+	event := new(ContractNameChanged)
+	if err := _Contract.contract.UnpackLog(event, "NameChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractPausedIterator struct {
+	Event *ContractPaused
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractPausedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractPausedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractPaused struct {
+	Account common.Address
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterPaused(opts *bind.FilterOpts) (*ContractPausedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractPausedIterator{contract: _Contract.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *ContractPaused) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractPaused)
+				if err := _Contract.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParsePaused(log types.Log) (*ContractPaused, error) {
+	event := new(ContractPaused)
+	if err := _Contract.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractSymbolChangedIterator struct {
+	Event *ContractSymbolChanged
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractSymbolChangedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractSymbolChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractSymbolChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractSymbolChangedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractSymbolChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractSymbolChanged struct {
+	NewSymbol string
+	Raw       types.Log
+}
+
+func (_Contract *ContractFilterer) FilterSymbolChanged(opts *bind.FilterOpts) (*ContractSymbolChangedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "SymbolChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractSymbolChangedIterator{contract: _Contract.contract, event: "SymbolChanged", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchSymbolChanged(opts *bind.WatchOpts, sink chan<- *ContractSymbolChanged) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "SymbolChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractSymbolChanged)
+				if err := _Contract.contract.UnpackLog(event, "SymbolChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseSymbolChanged(log types.Log) (*ContractSymbolChanged, error) {
+	event := new(ContractSymbolChanged)
+	if err := _Contract.contract.UnpackLog(event, "SymbolChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractTransferIterator struct {
+	Event *ContractTransfer
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractTransferIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractTransferIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log
+}
+
+func (_Contract *ContractFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ContractTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ContractTransferIterator{contract: _Contract.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ContractTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractTransfer)
+				if err := _Contract.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseTransfer(log types.Log) (*ContractTransfer, error) {
+	event := new(ContractTransfer)
+	if err := _Contract.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+type ContractUnpausedIterator struct {
+	Event *ContractUnpaused
+
+	contract *bind.BoundContract
+	event    string
+
+	logs chan types.Log
+	sub  ethereum.Subscription
+	done bool
+	fail error
+}
+
+func (it *ContractUnpausedIterator) Next() bool {
+
+	if it.fail != nil {
+		return false
+	}
+
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ContractUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+
+	select {
+	case log := <-it.logs:
+		it.Event = new(ContractUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+func (it *ContractUnpausedIterator) Error() error {
+	return it.fail
+}
+
+func (it *ContractUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+type ContractUnpaused struct {
+	Account common.Address
+	Raw     types.Log
+}
+
+func (_Contract *ContractFilterer) FilterUnpaused(opts *bind.FilterOpts) (*ContractUnpausedIterator, error) {
+
+	logs, sub, err := _Contract.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &ContractUnpausedIterator{contract: _Contract.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+func (_Contract *ContractFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *ContractUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _Contract.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+
+				event := new(ContractUnpaused)
+				if err := _Contract.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (_Contract *ContractFilterer) ParseUnpaused(log types.Log) (*ContractUnpaused, error) {
+	event := new(ContractUnpaused)
+	if err := _Contract.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+func (_Contract *Contract) ParseLog(log types.Log) (generated.AbigenLog, error) {
+	switch log.Topics[0] {
+	case _Contract.abi.Events["Approval"].ID:
+		return _Contract.ParseApproval(log)
+	case _Contract.abi.Events["Initialized"].ID:
+		return _Contract.ParseInitialized(log)
+	case _Contract.abi.Events["NameChanged"].ID:
+		return _Contract.ParseNameChanged(log)
+	case _Contract.abi.Events["Paused"].ID:
+		return _Contract.ParsePaused(log)
+	case _Contract.abi.Events["SymbolChanged"].ID:
+		return _Contract.ParseSymbolChanged(log)
+	case _Contract.abi.Events["Transfer"].ID:
+		return _Contract.ParseTransfer(log)
+	case _Contract.abi.Events["Unpaused"].ID:
+		return _Contract.ParseUnpaused(log)
+
+	default:
+		return nil, fmt.Errorf("abigen wrapper received unknown log topic: %v", log.Topics[0])
+	}
+}
+
+func (ContractApproval) Topic() common.Hash {
+	return common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+}
+
+func (ContractInitialized) Topic() common.Hash {
+	return common.HexToHash("0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2")
+}
+
+func (ContractNameChanged) Topic() common.Hash {
+	return common.HexToHash("0x4737457377f528cc8afd815f73ecb8b05df80d047dbffc41c17750a4033592bc")
+}
+
+func (ContractPaused) Topic() common.Hash {
+	return common.HexToHash("0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258")
+}
+
+func (ContractSymbolChanged) Topic() common.Hash {
+	return common.HexToHash("0x57c940aa14b51ea5f96b7a2bea757ce355d996e2c5d7a3c68aff1c75a326269b")
+}
+
+func (ContractTransfer) Topic() common.Hash {
+	return common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+}
+
+func (ContractUnpaused) Topic() common.Hash {
+	return common.HexToHash("0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa")
+}
+
+func (_Contract *Contract) Address() common.Address {
+	return _Contract.address
+}
+
+type ContractERC20InsufficientAllowanceError struct {
+	Spender   common.Address
+	Allowance *big.Int
+	Needed    *big.Int
+}
+
+func (e *ContractERC20InsufficientAllowanceError) Error() string {
+	return fmt.Sprintf("ERC20InsufficientAllowance(%v, %v, %v)", e.Spender, e.Allowance, e.Needed)
+}
+
+type ContractERC20InsufficientBalanceError struct {
+	Sender  common.Address
+	Balance *big.Int
+	Needed  *big.Int
+}
+
+func (e *ContractERC20InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("ERC20InsufficientBalance(%v, %v, %v)", e.Sender, e.Balance, e.Needed)
+}
+
+type ContractERC20InvalidApproverError struct {
+	Approver common.Address
+}
+
+func (e *ContractERC20InvalidApproverError) Error() string {
+	return fmt.Sprintf("ERC20InvalidApprover(%v)", e.Approver)
+}
+
+type ContractERC20InvalidReceiverError struct {
+	Receiver common.Address
+}
+
+func (e *ContractERC20InvalidReceiverError) Error() string {
+	return fmt.Sprintf("ERC20InvalidReceiver(%v)", e.Receiver)
+}
+
+type ContractERC20InvalidSenderError struct {
+	Sender common.Address
+}
+
+func (e *ContractERC20InvalidSenderError) Error() string {
+	return fmt.Sprintf("ERC20InvalidSender(%v)", e.Sender)
+}
+
+type ContractERC20InvalidSpenderError struct {
+	Spender common.Address
+}
+
+func (e *ContractERC20InvalidSpenderError) Error() string {
+	return fmt.Sprintf("ERC20InvalidSpender(%v)", e.Spender)
+}
+
+type ContractEnforcedPauseError struct{}
+
+func (e *ContractEnforcedPauseError) Error() string {
+	return "EnforcedPause()"
+}
+
+type ContractExpectedPauseError struct{}
+
+func (e *ContractExpectedPauseError) Error() string {
+	return "ExpectedPause()"
+}
+
+type ContractInvalidInitializationError struct{}
+
+func (e *ContractInvalidInitializationError) Error() string {
+	return "InvalidInitialization()"
+}
+
+type ContractMathOverflowedMulDivError struct{}
+
+func (e *ContractMathOverflowedMulDivError) Error() string {
+	return "MathOverflowedMulDiv()"
+}
+
+type ContractNotInitializingError struct{}
+
+func (e *ContractNotInitializingError) Error() string {
+	return "NotInitializing()"
+}
+
+type ContractOnlyGovernanceAllowedError struct{}
+
+func (e *ContractOnlyGovernanceAllowedError) Error() string {
+	return "OnlyGovernanceAllowed()"
+}
+
+type ContractOnlyOperatorAllowedError struct{}
+
+func (e *ContractOnlyOperatorAllowedError) Error() string {
+	return "OnlyOperatorAllowed()"
+}
+
+type ContractOnlyRestakingPoolAllowedError struct{}
+
+func (e *ContractOnlyRestakingPoolAllowedError) Error() string {
+	return "OnlyRestakingPoolAllowed()"
+}
+
+func (_Contract *Contract) UnpackError(data []byte) (error, bool) {
+	e, ok := generated.UnpackError(&_Contract.abi, data)
+	if !ok {
+		return nil, false
+	}
+	switch e.Name {
+	case "ERC20InsufficientAllowance":
+		return &ContractERC20InsufficientAllowanceError{Spender: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address), Allowance: *abi.ConvertType(e.Args[1], new(*big.Int)).(**big.Int), Needed: *abi.ConvertType(e.Args[2], new(*big.Int)).(**big.Int)}, true
+	case "ERC20InsufficientBalance":
+		return &ContractERC20InsufficientBalanceError{Sender: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address), Balance: *abi.ConvertType(e.Args[1], new(*big.Int)).(**big.Int), Needed: *abi.ConvertType(e.Args[2], new(*big.Int)).(**big.Int)}, true
+	case "ERC20InvalidApprover":
+		return &ContractERC20InvalidApproverError{Approver: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "ERC20InvalidReceiver":
+		return &ContractERC20InvalidReceiverError{Receiver: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "ERC20InvalidSender":
+		return &ContractERC20InvalidSenderError{Sender: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "ERC20InvalidSpender":
+		return &ContractERC20InvalidSpenderError{Spender: *abi.ConvertType(e.Args[0], new(common.Address)).(*common.Address)}, true
+	case "EnforcedPause":
+		return &ContractEnforcedPauseError{}, true
+	case "ExpectedPause":
+		return &ContractExpectedPauseError{}, true
+	case "InvalidInitialization":
+		return &ContractInvalidInitializationError{}, true
+	case "MathOverflowedMulDiv":
+		return &ContractMathOverflowedMulDivError{}, true
+	case "NotInitializing":
+		return &ContractNotInitializingError{}, true
+	case "OnlyGovernanceAllowed":
+		return &ContractOnlyGovernanceAllowedError{}, true
+	case "OnlyOperatorAllowed":
+		return &ContractOnlyOperatorAllowedError{}, true
+	case "OnlyRestakingPoolAllowed":
+		return &ContractOnlyRestakingPoolAllowedError{}, true
+	default:
+		return e, true
+	}
+}
+
+func (_Contract *Contract) ApproveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, spender common.Address, value *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Approve(opts, spender, value)
+	})
+}
+
+func (_Contract *Contract) BurnAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, account common.Address, shares *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Burn(opts, account, shares)
+	})
+}
+
+func (_Contract *Contract) ChangeNameAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newName string) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.ChangeName(opts, newName)
+	})
+}
+
+func (_Contract *Contract) ChangeSymbolAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newSymbol string) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.ChangeSymbol(opts, newSymbol)
+	})
+}
+
+func (_Contract *Contract) InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, config common.Address, name string, symbol string) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Initialize(opts, config, name, symbol)
+	})
+}
+
+func (_Contract *Contract) MintAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, account common.Address, shares *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Mint(opts, account, shares)
+	})
+}
+
+func (_Contract *Contract) PauseAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Pause(opts)
+	})
+}
+
+func (_Contract *Contract) TransferAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, to common.Address, value *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Transfer(opts, to, value)
+	})
+}
+
+func (_Contract *Contract) TransferFromAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.TransferFrom(opts, from, to, value)
+	})
+}
+
+func (_Contract *Contract) UnpauseAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error) {
+	return waiter.Send(opts, _Contract, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return _Contract.Unpause(opts)
+	})
+}
+
+func (_Contract *Contract) SimulateApprove(sim *generated.Simulator, opts *generated.SimulateOpts, spender common.Address, value *big.Int) (bool, uint64, error) {
+	out, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "approve", spender, value)
+	if err != nil {
+		return *new(bool), 0, err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, gas, nil
+}
+
+func (_Contract *Contract) SimulateBurn(sim *generated.Simulator, opts *generated.SimulateOpts, account common.Address, shares *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "burn", account, shares)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateChangeName(sim *generated.Simulator, opts *generated.SimulateOpts, newName string) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "changeName", newName)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateChangeSymbol(sim *generated.Simulator, opts *generated.SimulateOpts, newSymbol string) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "changeSymbol", newSymbol)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, config common.Address, name string, symbol string) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "initialize", config, name, symbol)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateMint(sim *generated.Simulator, opts *generated.SimulateOpts, account common.Address, shares *big.Int) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "mint", account, shares)
+	return gas, err
+}
+
+func (_Contract *Contract) SimulatePause(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "pause")
+	return gas, err
+}
+
+func (_Contract *Contract) SimulateTransfer(sim *generated.Simulator, opts *generated.SimulateOpts, to common.Address, value *big.Int) (bool, uint64, error) {
+	out, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "transfer", to, value)
+	if err != nil {
+		return *new(bool), 0, err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, gas, nil
+}
+
+func (_Contract *Contract) SimulateTransferFrom(sim *generated.Simulator, opts *generated.SimulateOpts, from common.Address, to common.Address, value *big.Int) (bool, uint64, error) {
+	out, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "transferFrom", from, to, value)
+	if err != nil {
+		return *new(bool), 0, err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, gas, nil
+}
+
+func (_Contract *Contract) SimulateUnpause(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error) {
+	_, gas, err := sim.Call(opts, _Contract, &_Contract.abi, "unpause")
+	return gas, err
+}
+
+type ContractInterface interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+
+	Config(opts *bind.CallOpts) (common.Address, error)
+
+	ConvertToAmount(opts *bind.CallOpts, shares *big.Int) (*big.Int, error)
+
+	ConvertToShares(opts *bind.CallOpts, amount *big.Int) (*big.Int, error)
+
+	Decimals(opts *bind.CallOpts) (uint8, error)
+
+	Name(opts *bind.CallOpts) (string, error)
+
+	Paused(opts *bind.CallOpts) (bool, error)
+
+	Ratio(opts *bind.CallOpts) (*big.Int, error)
+
+	Symbol(opts *bind.CallOpts) (string, error)
+
+	TotalAssets(opts *bind.CallOpts) (*big.Int, error)
+
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+
+	Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error)
+
+	Burn(opts *bind.TransactOpts, account common.Address, shares *big.Int) (*types.Transaction, error)
+
+	ChangeName(opts *bind.TransactOpts, newName string) (*types.Transaction, error)
+
+	ChangeSymbol(opts *bind.TransactOpts, newSymbol string) (*types.Transaction, error)
+
+	Initialize(opts *bind.TransactOpts, config common.Address, name string, symbol string) (*types.Transaction, error)
+
+	Mint(opts *bind.TransactOpts, account common.Address, shares *big.Int) (*types.Transaction, error)
+
+	Pause(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error)
+
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error)
+
+	Unpause(opts *bind.TransactOpts) (*types.Transaction, error)
+
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ContractApprovalIterator, error)
+
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *ContractApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+
+	ParseApproval(log types.Log) (*ContractApproval, error)
+
+	FilterInitialized(opts *bind.FilterOpts) (*ContractInitializedIterator, error)
+
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ContractInitialized) (event.Subscription, error)
+
+	ParseInitialized(log types.Log) (*ContractInitialized, error)
+
+	FilterNameChanged(opts *bind.FilterOpts) (*ContractNameChangedIterator, error)
+
+	WatchNameChanged(opts *bind.WatchOpts, sink chan<- *ContractNameChanged) (event.Subscription, error)
+
+	ParseNameChanged(log types.Log) (*ContractNameChanged, error)
+
+	FilterPaused(opts *bind.FilterOpts) (*ContractPausedIterator, error)
+
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *ContractPaused) (event.Subscription, error)
+
+	ParsePaused(log types.Log) (*ContractPaused, error)
+
+	FilterSymbolChanged(opts *bind.FilterOpts) (*ContractSymbolChangedIterator, error)
+
+	WatchSymbolChanged(opts *bind.WatchOpts, sink chan<- *ContractSymbolChanged) (event.Subscription, error)
+
+	ParseSymbolChanged(log types.Log) (*ContractSymbolChanged, error)
+
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ContractTransferIterator, error)
+
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *ContractTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+
+	ParseTransfer(log types.Log) (*ContractTransfer, error)
+
+	FilterUnpaused(opts *bind.FilterOpts) (*ContractUnpausedIterator, error)
+
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *ContractUnpaused) (event.Subscription, error)
+
+	ParseUnpaused(log types.Log) (*ContractUnpaused, error)
+
+	ParseLog(log types.Log) (generated.AbigenLog, error)
+
+	Address() common.Address
+
+	UnpackError(data []byte) (error, bool)
+
+	ApproveAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, spender common.Address, value *big.Int) (*generated.Result, error)
+
+	BurnAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, account common.Address, shares *big.Int) (*generated.Result, error)
+
+	ChangeNameAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newName string) (*generated.Result, error)
+
+	ChangeSymbolAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, newSymbol string) (*generated.Result, error)
+
+	InitializeAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, config common.Address, name string, symbol string) (*generated.Result, error)
+
+	MintAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, account common.Address, shares *big.Int) (*generated.Result, error)
+
+	PauseAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	TransferAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, to common.Address, value *big.Int) (*generated.Result, error)
+
+	TransferFromAndWait(waiter *generated.Waiter, opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*generated.Result, error)
+
+	UnpauseAndWait(waiter *generated.Waiter, opts *bind.TransactOpts) (*generated.Result, error)
+
+	SimulateApprove(sim *generated.Simulator, opts *generated.SimulateOpts, spender common.Address, value *big.Int) (bool, uint64, error)
+
+	SimulateBurn(sim *generated.Simulator, opts *generated.SimulateOpts, account common.Address, shares *big.Int) (uint64, error)
+
+	SimulateChangeName(sim *generated.Simulator, opts *generated.SimulateOpts, newName string) (uint64, error)
+
+	SimulateChangeSymbol(sim *generated.Simulator, opts *generated.SimulateOpts, newSymbol string) (uint64, error)
+
+	SimulateInitialize(sim *generated.Simulator, opts *generated.SimulateOpts, config common.Address, name string, symbol string) (uint64, error)
+
+	SimulateMint(sim *generated.Simulator, opts *generated.SimulateOpts, account common.Address, shares *big.Int) (uint64, error)
+
+	SimulatePause(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+
+	SimulateTransfer(sim *generated.Simulator, opts *generated.SimulateOpts, to common.Address, value *big.Int) (bool, uint64, error)
+
+	SimulateTransferFrom(sim *generated.Simulator, opts *generated.SimulateOpts, from common.Address, to common.Address, value *big.Int) (bool, uint64, error)
+
+	SimulateUnpause(sim *generated.Simulator, opts *generated.SimulateOpts) (uint64, error)
+}
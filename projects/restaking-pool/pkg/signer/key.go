@@ -0,0 +1,49 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// Key signs with a private key held in memory.
+type Key struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKey returns the signer of a hex private key, with or without 0x.
+func NewKey(hex string) (*Key, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hex), "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse key")
+	}
+	return &Key{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}, nil
+}
+
+// NewKeystore decrypts a geth keystore file, the JSON of an encrypted key.
+func NewKeystore(keyJSON []byte, password string) (*Key, error) {
+	k, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	return &Key{key: k.PrivateKey, address: k.Address}, nil
+}
+
+// Address implements Signer.
+func (k *Key) Address() common.Address {
+	return k.address
+}
+
+// SignTx implements Signer.
+func (k *Key) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), k.key)
+	return signed, errors.Wrap(err, "could not sign transaction")
+}
@@ -0,0 +1,94 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+// Remote signs through an external signer implementing the clef
+// account_signTransaction method, which returns the raw signed transaction.
+type Remote struct {
+	client  *rpc.Client
+	address common.Address
+}
+
+// NewRemote connects to the signer at url, signing for address.
+func NewRemote(ctx context.Context, url string, address common.Address) (*Remote, error) {
+	if address == (common.Address{}) {
+		return nil, errors.New("no address for the remote signer")
+	}
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to signer")
+	}
+	return &Remote{client: client, address: address}, nil
+}
+
+// Close closes the connection to the signer.
+func (r *Remote) Close() {
+	r.client.Close()
+}
+
+// Address implements Signer.
+func (r *Remote) Address() common.Address {
+	return r.address
+}
+
+// signResult is the result of account_signTransaction.
+type signResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignTx implements Signer. The signed transaction is checked to be tx
+// signed by the account, whatever the remote signer returns.
+func (r *Remote) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(r.address),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if to := tx.To(); to != nil {
+		mixed := common.NewMixedcaseAddress(*to)
+		args.To = &mixed
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		list := tx.AccessList()
+		args.GasPrice, args.AccessList = (*hexutil.Big)(tx.GasPrice()), &list
+	case types.DynamicFeeTxType:
+		list := tx.AccessList()
+		args.MaxFeePerGas, args.MaxPriorityFeePerGas, args.AccessList = (*hexutil.Big)(tx.GasFeeCap()), (*hexutil.Big)(tx.GasTipCap()), &list
+	default:
+		return nil, errors.Errorf("unsupported transaction type %d", tx.Type())
+	}
+
+	var result signResult
+	if err := r.client.CallContext(ctx, &result, "account_signTransaction", args); err != nil {
+		return nil, errors.Wrap(err, "remote signer")
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(result.Raw); err != nil {
+		return nil, errors.Wrap(err, "could not decode signed transaction")
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.New("remote signer returned another transaction")
+	}
+	if sender, err := types.Sender(signer, signed); err != nil || sender != r.address {
+		return nil, errors.Errorf("remote signer did not sign for %s", r.address.Hex())
+	}
+	return signed, nil
+}
@@ -0,0 +1,131 @@
+// Package signer produces the TransactOpts of the generated Transactors from
+// interchangeable signing backends: a raw hex key in development, an
+// encrypted geth keystore file in staging and an external signer speaking
+// the clef account_signTransaction JSON-RPC API in production.
+//
+// Every backend implements Signer, and TransactOpts applies the same checks
+// to all of them: the sender must be the signer's account, and the
+// destination must be on the allowlist when there is one.
+package signer
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Signer signs the transactions of one account.
+type Signer interface {
+	// Address is the account of the signer.
+	Address() common.Address
+	// SignTx signs tx for chainID.
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// ErrNotAllowed is returned for a transaction to a destination off the
+// allowlist.
+var ErrNotAllowed = errors.New("destination not allowed")
+
+// Allowlist is the set of addresses a signer may send transactions to. An
+// empty allowlist allows every destination, contract creations included;
+// otherwise contract creations are refused.
+type Allowlist []common.Address
+
+// Check returns ErrNotAllowed unless the destination of tx is allowed.
+func (a Allowlist) Check(tx *types.Transaction) error {
+	if len(a) == 0 {
+		return nil
+	}
+	if tx.To() == nil {
+		return errors.Wrap(ErrNotAllowed, "contract creation")
+	}
+	for _, to := range a {
+		if *tx.To() == to {
+			return nil
+		}
+	}
+	return errors.Wrap(ErrNotAllowed, tx.To().Hex())
+}
+
+// TransactOpts returns the options of the Transactor methods that sign with
+// s for chainID. Signing fails for another sender than the account of s,
+// and for destinations off allow.
+func TransactOpts(ctx context.Context, s Signer, chainID *big.Int, allow Allowlist) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:    s.Address(),
+		Context: ctx,
+		Signer: func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if from != s.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			if err := allow.Check(tx); err != nil {
+				return nil, err
+			}
+			signed, err := s.SignTx(ctx, tx, chainID)
+			if err != nil {
+				return nil, err
+			}
+			if sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed); err != nil || sender != from {
+				return nil, errors.Errorf("transaction not signed by %s", from.Hex())
+			}
+			return signed, nil
+		},
+	}
+}
+
+// Config selects a signer, typically from command-line flags. Exactly one
+// of Key, Keystore and Remote is set.
+type Config struct {
+	// Key is a file holding a hex private key.
+	Key string
+	// Keystore is an encrypted geth keystore file, unlocked with the
+	// password in PasswordFile.
+	Keystore     string
+	PasswordFile string
+	// Remote is the JSON-RPC URL of a clef-compatible signer, signing for
+	// Address.
+	Remote  string
+	Address common.Address
+}
+
+// Open returns the signer of cfg.
+func Open(ctx context.Context, cfg Config) (Signer, error) {
+	set := 0
+	for _, s := range []string{cfg.Key, cfg.Keystore, cfg.Remote} {
+		if s != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, errors.New("exactly one of a key, a keystore and a remote signer is required")
+	}
+	switch {
+	case cfg.Key != "":
+		data, err := os.ReadFile(cfg.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read key")
+		}
+		return NewKey(string(data))
+	case cfg.Keystore != "":
+		var password []byte
+		if cfg.PasswordFile != "" {
+			data, err := os.ReadFile(cfg.PasswordFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read password")
+			}
+			password = []byte(strings.TrimRight(string(data), "\r\n"))
+		}
+		data, err := os.ReadFile(cfg.Keystore)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keystore")
+		}
+		return NewKeystore(data, string(password))
+	}
+	return NewRemote(ctx, cfg.Remote, cfg.Address)
+}
@@ -0,0 +1,139 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// clef is a stand-in for the clef account API that signs every request
+// with key. With tamper set it signs another nonce.
+type clef struct {
+	key    *ecdsa.PrivateKey
+	tamper bool
+	calls  int
+}
+
+func (c *clef) SignTransaction(_ context.Context, args apitypes.SendTxArgs, _ *string) (*signResult, error) {
+	c.calls++
+	if c.tamper {
+		args.Nonce++
+	}
+	tx, err := types.SignTx(args.ToTransaction(), types.LatestSignerForChainID(args.ChainID.ToInt()), c.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	return &signResult{Raw: hexutil.Bytes(raw)}, err
+}
+
+func serve(t *testing.T, c *clef) string {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", c); err != nil {
+		t.Fatal(err)
+	}
+	h := httptest.NewServer(server)
+	t.Cleanup(func() {
+		h.Close()
+		server.Stop()
+	})
+	return h.URL
+}
+
+func TestSigners(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	ctx := context.Background()
+	gov := f.Governance.Key
+	dir := t.TempDir()
+
+	keyFile := filepath.Join(dir, "gov.key")
+	if err := os.WriteFile(keyFile, []byte("0x"+hex.EncodeToString(crypto.FromECDSA(gov))+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(filepath.Join(dir, "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(gov, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	passwordFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(passwordFile, []byte("secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	stand := &clef{key: gov}
+	remote, err := NewRemote(ctx, serve(t, stand), f.Governance.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remote.Close()
+
+	pool := f.RestakingPool.Address()
+	signers := map[string]Signer{"remote": remote}
+	for name, cfg := range map[string]Config{
+		"key":      {Key: keyFile},
+		"keystore": {Keystore: account.URL.Path, PasswordFile: passwordFile},
+	} {
+		if signers[name], err = Open(ctx, cfg); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+	for name, s := range signers {
+		if s.Address() != f.Governance.Address {
+			t.Fatalf("%s signs for %s", name, s.Address().Hex())
+		}
+		tvl := new(big.Int).Add(fixture.Ether, big.NewInt(int64(len(name))))
+		opts := TransactOpts(ctx, s, fixture.ChainID, Allowlist{pool})
+		if _, err := f.Send(f.RestakingPool.SetMaxTVL(opts, tvl)); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got, err := f.RestakingPool.MaxTVL(nil); err != nil || got.Cmp(tvl) != 0 {
+			t.Fatalf("%s: maxTVL %s: %v", name, got, err)
+		}
+
+		// the checks are the same for every signer
+		opts = TransactOpts(ctx, s, fixture.ChainID, Allowlist{f.RatioFeed.Address()})
+		if _, err := f.RestakingPool.SetMaxTVL(opts, tvl); !errors.Is(err, ErrNotAllowed) {
+			t.Errorf("%s: destination off the allowlist: %v", name, err)
+		}
+		opts = TransactOpts(ctx, s, fixture.ChainID, nil)
+		opts.From, opts.GasLimit = f.Operator.Address, 100_000
+		if _, err := f.RestakingPool.SetMaxTVL(opts, tvl); !errors.Is(err, bind.ErrNotAuthorized) {
+			t.Errorf("%s: another sender: %v", name, err)
+		}
+	}
+	if stand.calls != 1 {
+		t.Errorf("%d remote signatures", stand.calls)
+	}
+
+	// a remote signer returning another transaction is caught
+	stand.tamper = true
+	if _, err := f.RestakingPool.SetMaxTVL(TransactOpts(ctx, remote, fixture.ChainID, nil), fixture.Ether); err == nil {
+		t.Error("tampered transaction accepted")
+	}
+
+	for _, cfg := range []Config{{}, {Key: keyFile, Remote: "http://localhost"}, {Keystore: account.URL.Path}} {
+		if _, err := Open(ctx, cfg); err == nil {
+			t.Errorf("opened %+v", cfg)
+		}
+	}
+}
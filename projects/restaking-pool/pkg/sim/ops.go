@@ -0,0 +1,258 @@
+package sim
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Errors named after the custom errors the pool reverts with.
+var (
+	ErrTargetCapacityNotSet    = errors.New("TargetCapacityNotSet")
+	ErrStakeLessThanMin        = errors.New("PoolStakeAmLessThanMin")
+	ErrStakeAboveAvailable     = errors.New("PoolStakeAmGreaterThanAvailable")
+	ErrUnstakeLessThanMin      = errors.New("PoolUnstakeAmLessThanMin")
+	ErrZeroAmount              = errors.New("PoolZeroAmount")
+	ErrInsufficientBalance     = errors.New("PoolInsufficientBalance")
+	ErrTimelineNotOver         = errors.New("TimelineNotOver")
+	ErrInsufficientShares      = errors.New("ERC20InsufficientBalance")
+	ErrInsufficientRestaked    = errors.New("withdrawal exceeds the restaked amount")
+	ErrDepositNotValidatorSize = errors.New("deposit is not a multiple of 32 ether")
+)
+
+// ValidatorDeposit is the ETH batchDeposit sends per validator.
+var ValidatorDeposit = new(big.Int).Mul(big.NewInt(32), e18)
+
+// StakeResult is the outcome of Stake.
+type StakeResult struct {
+	Bonus  *big.Int
+	Shares *big.Int
+}
+
+// Stake is stake() with msg.value amount.
+func (s *State) Stake(amount *big.Int) (*StakeResult, error) {
+	if s.TargetCapacity == 0 {
+		return nil, ErrTargetCapacityNotSet
+	}
+	if amount.Cmp(s.minStake()) < 0 {
+		return nil, ErrStakeLessThanMin
+	}
+	if amount.Cmp(s.AvailableToStake()) > 0 {
+		return nil, ErrStakeAboveAvailable
+	}
+	q, err := s.quote().Stake(amount)
+	if err != nil {
+		return nil, err
+	}
+	s.StakeBonusAmount.Sub(s.StakeBonusAmount, q.Bonus)
+	s.Supply.Add(s.Supply, q.Shares)
+	s.Balance.Add(s.Balance, amount)
+	return &StakeResult{Bonus: q.Bonus, Shares: q.Shares}, nil
+}
+
+// minStake is getMinStake: 1 share is the smallest stake.
+func (s *State) minStake() *big.Int {
+	minimum := s.quote().ConvertToAmount(big.NewInt(1))
+	if s.MinStake.Cmp(minimum) > 0 {
+		return s.MinStake
+	}
+	return minimum
+}
+
+// UnstakeResult is the outcome of Unstake.
+type UnstakeResult struct {
+	Shares *big.Int
+	// Amount is the ETH queued for the recipient.
+	Amount *big.Int
+}
+
+// Unstake is unstake(to, shares) with the shares worth amount.
+func (s *State) Unstake(to common.Address, amount *big.Int) (*UnstakeResult, error) {
+	q := s.quote()
+	shares := q.ConvertToShares(amount)
+	amount = q.ConvertToAmount(shares)
+	if amount.Cmp(s.MinUnstake) < 0 {
+		return nil, ErrUnstakeLessThanMin
+	}
+	if shares.Cmp(s.Supply) > 0 {
+		return nil, ErrInsufficientShares
+	}
+	if amount.Sign() == 0 {
+		return nil, ErrZeroAmount
+	}
+	s.Supply.Sub(s.Supply, shares)
+	s.Queue = append(s.Queue, Unstake{Recipient: to, Amount: amount})
+	s.TotalPendingUnstakes.Add(s.TotalPendingUnstakes, amount)
+	return &UnstakeResult{Shares: shares, Amount: new(big.Int).Set(amount)}, nil
+}
+
+// FlashUnstakeResult is the outcome of FlashUnstake.
+type FlashUnstakeResult struct {
+	Shares      *big.Int
+	Fee         *big.Int
+	ProtocolFee *big.Int
+	Received    *big.Int
+}
+
+// FlashUnstake is flashUnstake(shares, receiver) with the shares worth
+// amount.
+func (s *State) FlashUnstake(amount *big.Int) (*FlashUnstakeResult, error) {
+	if s.TargetCapacity == 0 {
+		return nil, ErrTargetCapacityNotSet
+	}
+	q := s.quote()
+	shares := q.ConvertToShares(amount)
+	if q.ConvertToAmount(shares).Cmp(s.MinUnstake) < 0 {
+		return nil, ErrUnstakeLessThanMin
+	}
+	res, err := q.FlashUnstake(shares)
+	if err != nil {
+		return nil, err
+	}
+	if res.Fee.Sign() == 0 {
+		return nil, ErrZeroAmount
+	}
+	if shares.Cmp(s.Supply) > 0 {
+		return nil, ErrInsufficientShares
+	}
+	s.Supply.Sub(s.Supply, shares)
+	s.StakeBonusAmount.Add(s.StakeBonusAmount, new(big.Int).Sub(res.Fee, res.ProtocolFee))
+	s.Balance.Sub(s.Balance, res.ProtocolFee)
+	s.Balance.Sub(s.Balance, res.Received)
+	s.TreasuryFees.Add(s.TreasuryFees, res.ProtocolFee)
+	return &FlashUnstakeResult{Shares: shares, Fee: res.Fee, ProtocolFee: res.ProtocolFee, Received: res.Received}, nil
+}
+
+// DistributeUnstakes is distributeUnstakes. It moves queued unstakes to
+// the claimables in order while the free balance covers them, and stops
+// after limit unstakes when limit is positive, standing in for the gas
+// limit. It returns the number of unstakes paid.
+func (s *State) DistributeUnstakes(limit int) int {
+	free := s.FreeBalance()
+	paid := 0
+	for len(s.Queue) > 0 && free.Sign() > 0 && (limit <= 0 || paid < limit) {
+		u := s.Queue[0]
+		if free.Cmp(u.Amount) < 0 {
+			break
+		}
+		s.TotalPendingUnstakes.Sub(s.TotalPendingUnstakes, u.Amount)
+		free.Sub(free, u.Amount)
+		s.Queue = s.Queue[1:]
+		s.addClaimable(u.Recipient, u.Amount)
+		paid++
+	}
+	return paid
+}
+
+func (s *State) addClaimable(account common.Address, amount *big.Int) {
+	s.TotalClaimable.Add(s.TotalClaimable, amount)
+	if c, ok := s.Claimable[account]; ok {
+		c.Add(c, amount)
+	} else {
+		s.Claimable[account] = new(big.Int).Set(amount)
+	}
+}
+
+// ClaimUnstake is claimUnstake(claimer).
+func (s *State) ClaimUnstake(claimer common.Address) (*big.Int, error) {
+	amount, ok := s.Claimable[claimer]
+	if !ok || amount.Sign() == 0 {
+		return nil, ErrZeroAmount
+	}
+	if err := s.claim(amount); err != nil {
+		return nil, err
+	}
+	delete(s.Claimable, claimer)
+	return amount, nil
+}
+
+// ClaimAll claims every claimable, including the part Seed could not
+// attribute to an account.
+func (s *State) ClaimAll() (*big.Int, error) {
+	amount := new(big.Int).Set(s.TotalClaimable)
+	if amount.Sign() == 0 {
+		return nil, ErrZeroAmount
+	}
+	if err := s.claim(amount); err != nil {
+		return nil, err
+	}
+	s.Claimable = make(map[common.Address]*big.Int)
+	return amount, nil
+}
+
+func (s *State) claim(amount *big.Int) error {
+	if s.TotalAssets().Cmp(s.TotalClaimable) < 0 || s.TotalAssets().Cmp(amount) < 0 {
+		return ErrInsufficientBalance
+	}
+	s.TotalClaimable.Sub(s.TotalClaimable, amount)
+	s.Balance.Sub(s.Balance, amount)
+	return nil
+}
+
+// AddRewards is addRewards with msg.value amount.
+func (s *State) AddRewards(amount *big.Int) error {
+	if s.CurrentRewards.Sign() > 0 && s.Time >= s.StartTimeline &&
+		(s.Time-s.StartTimeline)/day < s.RewardsTimeline/day {
+		return ErrTimelineNotOver
+	}
+	s.CurrentRewards = new(big.Int).Set(amount)
+	s.StartTimeline = s.Time
+	s.Balance.Add(s.Balance, amount)
+	return nil
+}
+
+// FairRatio is the ratio that values the supply at the assets backing it:
+// the flash capacity and the restaked ETH less the queued unstakes. It is
+// rounded up so the supply is never valued above its backing, and is nil
+// when there is nothing to value.
+func (s *State) FairRatio() *big.Int {
+	backing := new(big.Int).Add(s.FlashCapacity(), s.Restaked)
+	backing.Sub(backing, s.TotalPendingUnstakes)
+	if s.Supply.Sign() == 0 || backing.Sign() <= 0 {
+		return nil
+	}
+	ratio := new(big.Int).Mul(s.Supply, e18)
+	ratio.Add(ratio, backing).Sub(ratio, big.NewInt(1))
+	return ratio.Quo(ratio, backing)
+}
+
+// UpdateRatio sets the cToken ratio. The RatioFeed rules are not checked.
+func (s *State) UpdateRatio(ratio *big.Int) error {
+	if ratio.Sign() == 0 {
+		return ErrZeroAmount
+	}
+	s.Ratio = new(big.Int).Set(ratio)
+	return nil
+}
+
+// Deposit is batchDeposit of amount, a multiple of 32 ether, to
+// EigenLayer.
+func (s *State) Deposit(amount *big.Int) error {
+	if new(big.Int).Rem(amount, ValidatorDeposit).Sign() != 0 {
+		return ErrDepositNotValidatorSize
+	}
+	if s.FreeBalance().Cmp(amount) < 0 {
+		return ErrInsufficientBalance
+	}
+	s.Balance.Sub(s.Balance, amount)
+	s.Restaked.Add(s.Restaked, amount)
+	return nil
+}
+
+// MaxDeposit is the largest amount Deposit accepts.
+func (s *State) MaxDeposit() *big.Int {
+	validators := new(big.Int).Quo(s.FreeBalance(), ValidatorDeposit)
+	return validators.Mul(validators, ValidatorDeposit)
+}
+
+// Withdraw returns amount of restaked ETH to the pool, as validator exits
+// and restaker claims do.
+func (s *State) Withdraw(amount *big.Int) error {
+	if amount.Cmp(s.Restaked) > 0 {
+		return ErrInsufficientRestaked
+	}
+	s.Restaked.Sub(s.Restaked, amount)
+	s.Balance.Add(s.Balance, amount)
+	return nil
+}
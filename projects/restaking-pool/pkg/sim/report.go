@@ -0,0 +1,173 @@
+package sim
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Row is the state after an action, or at a sample time when Action is
+// empty.
+type Row struct {
+	Time   time.Time `json:"time"`
+	Action string    `json:"action,omitempty"`
+	// Error is the revert of a rejected action. The state is unchanged.
+	Error string `json:"error,omitempty"`
+
+	Balance          *big.Int `json:"balance"`
+	TotalAssets      *big.Int `json:"totalAssets"`
+	LockedRewards    *big.Int `json:"lockedRewards"`
+	FlashCapacity    *big.Int `json:"flashCapacity"`
+	TargetCapacity   *big.Int `json:"targetCapacity"`
+	FreeBalance      *big.Int `json:"freeBalance"`
+	AvailableToStake *big.Int `json:"availableToStake"`
+	PendingUnstakes  *big.Int `json:"pendingUnstakes"`
+	QueueLength      int      `json:"queueLength"`
+	TotalClaimable   *big.Int `json:"totalClaimable"`
+	StakeBonusAmount *big.Int `json:"stakeBonusAmount"`
+	TokenTotalAssets *big.Int `json:"tokenTotalAssets"`
+	Ratio            *big.Int `json:"ratio"`
+	Restaked         *big.Int `json:"restaked"`
+	TreasuryFees     *big.Int `json:"treasuryFees"`
+}
+
+func newRow(s *State) Row {
+	return Row{
+		Time:             time.Unix(int64(s.Time), 0).UTC(),
+		Balance:          new(big.Int).Set(s.Balance),
+		TotalAssets:      s.TotalAssets(),
+		LockedRewards:    s.LockedRewards(),
+		FlashCapacity:    s.FlashCapacity(),
+		TargetCapacity:   s.TargetCapacityAmount(),
+		FreeBalance:      s.FreeBalance(),
+		AvailableToStake: s.AvailableToStake(),
+		PendingUnstakes:  new(big.Int).Set(s.TotalPendingUnstakes),
+		QueueLength:      len(s.Queue),
+		TotalClaimable:   new(big.Int).Set(s.TotalClaimable),
+		StakeBonusAmount: new(big.Int).Set(s.StakeBonusAmount),
+		TokenTotalAssets: s.TokenTotalAssets(),
+		Ratio:            new(big.Int).Set(s.Ratio),
+		Restaked:         new(big.Int).Set(s.Restaked),
+		TreasuryFees:     new(big.Int).Set(s.TreasuryFees),
+	}
+}
+
+func (r Row) amounts() []*big.Int {
+	return []*big.Int{
+		r.Balance, r.TotalAssets, r.LockedRewards, r.FlashCapacity, r.TargetCapacity,
+		r.FreeBalance, r.AvailableToStake, r.PendingUnstakes, r.TotalClaimable,
+		r.StakeBonusAmount, r.TokenTotalAssets, r.Ratio, r.Restaked, r.TreasuryFees,
+	}
+}
+
+var amountColumns = []string{
+	"balance", "totalAssets", "lockedRewards", "flashCapacity", "targetCapacity",
+	"freeBalance", "availableToStake", "pendingUnstakes", "totalClaimable",
+	"stakeBonusAmount", "tokenTotalAssets", "ratio", "restaked", "treasuryFees",
+}
+
+// Report is the time series produced by Run.
+type Report struct {
+	Rows []Row `json:"rows"`
+	// Final is the state at the end of the timeline.
+	Final *State `json:"-"`
+}
+
+// Rejected returns the rows of the actions the pool would have rejected.
+func (r *Report) Rejected() []Row {
+	var out []Row
+	for _, row := range r.Rows {
+		if row.Error != "" {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// Run applies the timeline to a copy of s. Rejected actions are reported
+// and leave the state unchanged, like a reverted transaction.
+func Run(s *State, t *Timeline) (*Report, error) {
+	events, err := t.events()
+	if err != nil {
+		return nil, err
+	}
+	s = s.Clone()
+	start := s.Time
+	report := &Report{Rows: []Row{newRow(s)}}
+	report.Rows[0].Action = "seed"
+	for _, e := range events {
+		s.Time = start + uint64(e.at/time.Second)
+		if e.action == nil {
+			report.Rows = append(report.Rows, newRow(s))
+			continue
+		}
+		next := s.Clone()
+		err := next.Apply(e.action)
+		if err == nil {
+			s = next
+		}
+		row := newRow(s)
+		row.Action = e.action.String()
+		if err != nil {
+			row.Error = err.Error()
+		}
+		report.Rows = append(report.Rows, row)
+	}
+	report.Final = s
+	return report, nil
+}
+
+// WriteCSV writes the report with amounts in wei.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := append([]string{"time", "action", "error", "queueLength"}, amountColumns...)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		record := []string{row.Time.Format(time.RFC3339), row.Action, row.Error, strconv.Itoa(row.QueueLength)}
+		for _, v := range row.amounts() {
+			record = append(record, v.String())
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the rows as a JSON array with amounts in wei.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r.Rows)
+}
+
+// WriteTable prints the main columns in ETH as an aligned table.
+func (r *Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "time\taction\tflashCapacity\tfreeBalance\tavailableToStake\tpending\tqueue\tclaimable\tstakeBonus\ttvl\tratio\t")
+	for _, row := range r.Rows {
+		action := row.Action
+		if row.Error != "" {
+			action += " !" + row.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\t\n",
+			row.Time.Format("2006-01-02 15:04"), action,
+			FormatEther(row.FlashCapacity), FormatEther(row.FreeBalance), FormatEther(row.AvailableToStake),
+			FormatEther(row.PendingUnstakes), row.QueueLength, FormatEther(row.TotalClaimable),
+			FormatEther(row.StakeBonusAmount), FormatEther(row.TokenTotalAssets), FormatEther(row.Ratio))
+	}
+	return tw.Flush()
+}
+
+// FormatEther formats wei as ETH with four decimals.
+func FormatEther(wei *big.Int) string {
+	return new(big.Rat).SetFrac(wei, e18).FloatString(4)
+}
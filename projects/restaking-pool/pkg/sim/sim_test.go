@@ -0,0 +1,253 @@
+package sim
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+func eth(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), fixture.Ether)
+}
+
+func newFixture(t *testing.T) *fixture.Fixture {
+	t.Helper()
+	opts := fixture.DefaultOptions()
+	opts.Users, opts.MaxTVL = 3, eth(100)
+	f, err := fixture.New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func seed(t *testing.T, f *fixture.Fixture) *State {
+	t.Helper()
+	ctx := context.Background()
+	c, err := f.Client(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var users []common.Address
+	for _, u := range f.Users {
+		users = append(users, u.Address)
+	}
+	s, err := Seed(ctx, c, nil, users...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// compare checks the simulated views against the chain at the latest block.
+func compare(t *testing.T, f *fixture.Fixture, s *State, step string) {
+	t.Helper()
+	pool, token := f.RestakingPool, f.CToken
+	opts := &bind.CallOpts{}
+	balance, err := f.Balance(pool.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	views := []struct {
+		name string
+		sim  *big.Int
+		get  func(*bind.CallOpts) (*big.Int, error)
+	}{
+		{"balance", s.Balance, func(*bind.CallOpts) (*big.Int, error) { return balance, nil }},
+		{"totalAssets", s.TotalAssets(), pool.TotalAssets},
+		{"getFlashCapacity", s.FlashCapacity(), pool.GetFlashCapacity},
+		{"getFreeBalance", s.FreeBalance(), pool.GetFreeBalance},
+		{"availableToStake", s.AvailableToStake(), pool.AvailableToStake},
+		{"getTotalPendingUnstakes", s.TotalPendingUnstakes, pool.GetTotalPendingUnstakes},
+		{"getTotalClaimable", s.TotalClaimable, pool.GetTotalClaimable},
+		{"stakeBonusAmount", s.StakeBonusAmount, pool.StakeBonusAmount},
+		{"cToken.totalSupply", s.Supply, token.TotalSupply},
+		{"cToken.totalAssets", s.TokenTotalAssets(), token.TotalAssets},
+	}
+	for _, v := range views {
+		got, err := v.get(opts)
+		if err != nil {
+			t.Fatalf("%s: %s: %v", step, v.name, err)
+		}
+		if got.Cmp(v.sim) != 0 {
+			t.Errorf("%s: %s is %s on chain, simulated %s", step, v.name, got, v.sim)
+		}
+	}
+	unstakes, err := pool.GetUnstakes(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending := 0
+	for _, u := range unstakes {
+		if u.Amount.Sign() > 0 {
+			pending++
+		}
+	}
+	if pending != len(s.Queue) {
+		t.Errorf("%s: %d queued unstakes on chain, simulated %d", step, pending, len(s.Queue))
+	}
+}
+
+// TestDifferential drives the fixture and the simulator through the same
+// actions and compares the pool views after each of them.
+func TestDifferential(t *testing.T) {
+	f := newFixture(t)
+	pool := f.RestakingPool
+	for i, u := range f.Users {
+		if _, err := f.Send(pool.Stake(u.WithValue(eth(int64(10 * (i + 1)))))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := seed(t, f)
+	compare(t, f, s, "seed")
+
+	send := func(tx *types.Transaction, err error) error {
+		_, err = f.Send(tx, err)
+		return err
+	}
+	gas := func(opts *bind.TransactOpts) *bind.TransactOpts {
+		opts.GasLimit = 5_000_000
+		return opts
+	}
+	u0, u1, u2 := f.Users[0], f.Users[1], f.Users[2]
+	steps := []struct {
+		name  string
+		chain func() error
+		sim   func() error
+	}{
+		{"unstake",
+			func() error { return send(pool.Unstake(u0.TransactOpts(), u0.Address, eth(3))) },
+			func() error { _, err := s.Unstake(u0.Address, eth(3)); return err }},
+		{"unstake to other",
+			func() error { return send(pool.Unstake(u1.TransactOpts(), u2.Address, eth(2))) },
+			func() error { _, err := s.Unstake(u2.Address, eth(2)); return err }},
+		{"flashUnstake",
+			func() error { return send(pool.FlashUnstake(u2.TransactOpts(), eth(4), u2.Address)) },
+			func() error { _, err := s.FlashUnstake(eth(4)); return err }},
+		{"stake with bonus",
+			func() error { return send(pool.Stake(u1.WithValue(eth(2)))) },
+			func() error { _, err := s.Stake(eth(2)); return err }},
+		{"stake above max TVL",
+			func() error { return send(pool.Stake(u1.WithValue(eth(90)))) },
+			func() error { _, err := s.Stake(eth(90)); return err }},
+		{"claimUnstake with nothing to claim",
+			func() error { return send(pool.ClaimUnstake(u0.TransactOpts(), u0.Address)) },
+			func() error { _, err := s.ClaimUnstake(u0.Address); return err }},
+		{"addRewards",
+			func() error { return send(pool.AddRewards(f.Operator.WithValue(eth(7)))) },
+			func() error { return s.AddRewards(eth(7)) }},
+		{"addRewards before the timeline is over",
+			func() error { return send(pool.AddRewards(f.Operator.WithValue(eth(1)))) },
+			func() error { return s.AddRewards(eth(1)) }},
+		{"rewards vest for 3 days",
+			func() error { return f.AdvanceRewardsDays(3) },
+			func() error { return nil }},
+		{"distributeUnstakes",
+			func() error { return send(pool.DistributeUnstakes(gas(f.Operator.TransactOpts()))) },
+			func() error { s.DistributeUnstakes(0); return nil }},
+		{"claimUnstake",
+			func() error { return send(pool.ClaimUnstake(u2.TransactOpts(), u2.Address)) },
+			func() error { _, err := s.ClaimUnstake(u2.Address); return err }},
+		{"ratio update",
+			func() error { return f.UpdateRatio(new(big.Int).Sub(fixture.Ether, big.NewInt(3e15))) },
+			func() error { return s.UpdateRatio(new(big.Int).Sub(fixture.Ether, big.NewInt(3e15))) }},
+		{"stake at the new ratio",
+			func() error { return send(pool.Stake(u0.WithValue(eth(1)))) },
+			func() error { _, err := s.Stake(eth(1)); return err }},
+		{"unstake at the new ratio",
+			func() error {
+				shares, err := f.CToken.ConvertToShares(nil, eth(1))
+				if err != nil {
+					return err
+				}
+				return send(pool.Unstake(u0.TransactOpts(), u0.Address, shares))
+			},
+			func() error { _, err := s.Unstake(u0.Address, eth(1)); return err }},
+		{"target capacity change",
+			func() error { return send(pool.SetTargetFlashCapacity(f.Governance.TransactOpts(), 20e8)) },
+			func() error { return s.SetParam("targetCapacity", "20e8") }},
+		{"rewards timeline over",
+			func() error { return f.AdvanceRewardsTimeline() },
+			func() error { return nil }},
+		{"second distributeUnstakes",
+			func() error { return send(pool.DistributeUnstakes(gas(f.Operator.TransactOpts()))) },
+			func() error { s.DistributeUnstakes(0); return nil }},
+	}
+	for _, step := range steps {
+		chainErr := step.chain()
+		header, err := f.Backend.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Time = header.Time
+		// the step closures act on s, keep the state to restore on revert
+		current := s
+		s = s.Clone()
+		simErr := step.sim()
+		if (chainErr == nil) != (simErr == nil) {
+			t.Fatalf("%s: chain error %v, simulated error %v", step.name, chainErr, simErr)
+		}
+		if simErr != nil {
+			s = current
+		}
+		compare(t, f, s, step.name)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	f := newFixture(t)
+	for _, u := range f.Users {
+		if _, err := f.Send(f.RestakingPool.Stake(u.WithValue(eth(20)))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := seed(t, f)
+	timeline, err := LoadTimeline(filepath.Join("testdata", "unstake_wave.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := Run(s, timeline)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Final.Supply.Cmp(s.Supply) >= 0 {
+		t.Fatal("the seeded state was modified or no unstake was applied")
+	}
+	if got := report.Rows[len(report.Rows)-1].Time.Sub(time.Unix(int64(s.Time), 0)); got != 14*24*time.Hour {
+		t.Fatalf("last row is %s after the seed, want 14d", got)
+	}
+	rejected := report.Rejected()
+	if len(rejected) == 0 || rejected[0].Error != ErrStakeAboveAvailable.Error() {
+		t.Fatalf("want the oversized stake rejected, got %+v", rejected)
+	}
+	final := report.Final
+	if len(final.Queue) != 0 || final.TotalClaimable.Sign() != 0 {
+		t.Fatalf("%d unstakes still queued and %s claimable", len(final.Queue), final.TotalClaimable)
+	}
+	if final.Ratio.Cmp(s.Ratio) >= 0 {
+		t.Fatalf("vested rewards did not lower the ratio: %s", final.Ratio)
+	}
+}
+
+func TestParseTimelineErrors(t *testing.T) {
+	for _, data := range []string{
+		`actions: [{op: stake}]`,
+		`actions: [{op: teleport}]`,
+		`actions: [{op: distributeUnstakes, every: 1d}]`,
+		`actions: [{op: set, set: {unknown: 1}}]`,
+		`actions: [{op: unstake, amount: 1 ether, to: alice}]`,
+	} {
+		if _, err := ParseTimeline([]byte(data)); err == nil {
+			t.Errorf("%s: no error", data)
+		}
+	}
+}
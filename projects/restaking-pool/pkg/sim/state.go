@@ -0,0 +1,248 @@
+// Package sim models RestakingPool accounting off-chain for capacity
+// planning. A State is seeded from on-chain views and then driven by a
+// timeline of stakes, unstakes, flash unstakes, distributions, claims,
+// rewards, ratio updates and parameter changes, producing a time series of
+// the pool views.
+//
+// Every operation follows the contract code path, including its checks, so
+// an action the pool would reject fails in the simulation with the same
+// custom error name. Gas limits and per-account share balances are not
+// modelled.
+package sim
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/client"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/quote"
+)
+
+const day = 24 * 60 * 60
+
+var e18 = big.NewInt(1e18)
+
+// Unstake is a queued unstake, as returned by getUnstakes.
+type Unstake struct {
+	Recipient common.Address
+	Amount    *big.Int
+}
+
+// State is the part of the pool, cToken and RatioFeed state the accounting
+// depends on.
+type State struct {
+	// Time is the block timestamp the next action executes at.
+	Time uint64
+
+	quote.Params
+	MaxTVL     *big.Int
+	MinStake   *big.Int
+	MinUnstake *big.Int
+
+	// Balance is the ETH balance of the pool contract.
+	Balance         *big.Int
+	CurrentRewards  *big.Int
+	StartTimeline   uint64
+	RewardsTimeline uint64
+
+	StakeBonusAmount *big.Int
+
+	// Queue holds the unstakes that are not distributed yet, in order.
+	Queue                []Unstake
+	TotalPendingUnstakes *big.Int
+
+	TotalClaimable *big.Int
+	// Claimable is the known part of TotalClaimable per account. Claimables
+	// created before seeding are only known for the accounts passed to Seed
+	// and the recipients still in the queue.
+	Claimable map[common.Address]*big.Int
+
+	// Supply is the cToken total supply and Ratio its RatioFeed ratio.
+	Supply *big.Int
+	Ratio  *big.Int
+
+	// Restaked is the ETH deposited to EigenLayer. The pool has no view for
+	// it, Seed estimates it from the cToken assets.
+	Restaked *big.Int
+	// TreasuryFees is the protocol part of the flash unstake fees paid
+	// during the simulation.
+	TreasuryFees *big.Int
+}
+
+// Seed reads the state at block, or at the latest block when block is nil.
+// Claimables of accounts are read in addition to those of the queued
+// recipients.
+func Seed(ctx context.Context, c *client.Client, block *big.Int, accounts ...common.Address) (*State, error) {
+	header, err := c.Backend().HeaderByNumber(ctx, block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+	pool, token := c.RestakingPool(), c.CToken()
+
+	snapshot, err := quote.ReadSnapshot(opts, pool, token)
+	if err != nil {
+		return nil, err
+	}
+	s := &State{
+		Time:             header.Time,
+		Params:           snapshot.Params,
+		StakeBonusAmount: snapshot.StakeBonusAmount,
+		Ratio:            snapshot.Ratio,
+		Claimable:        make(map[common.Address]*big.Int),
+		TreasuryFees:     new(big.Int),
+	}
+	var totalAssets, rewardsStart, rewardsTimeline *big.Int
+	read := func(v **big.Int, name string, get func(*bind.CallOpts) (*big.Int, error)) {
+		if err == nil {
+			*v, err = get(opts)
+			err = errors.Wrap(err, name)
+		}
+	}
+	read(&s.MaxTVL, "maxTVL", pool.MaxTVL)
+	read(&s.MinStake, "getMinStake", pool.GetMinStake)
+	read(&s.MinUnstake, "getMinUnstake", pool.GetMinUnstake)
+	read(&totalAssets, "totalAssets", pool.TotalAssets)
+	read(&s.CurrentRewards, "currentRewards", pool.CurrentRewards)
+	read(&rewardsStart, "startTimeline", pool.StartTimeline)
+	read(&rewardsTimeline, "rewardsTimeline", pool.RewardsTimeline)
+	read(&s.TotalPendingUnstakes, "getTotalPendingUnstakes", pool.GetTotalPendingUnstakes)
+	read(&s.TotalClaimable, "getTotalClaimable", pool.GetTotalClaimable)
+	read(&s.Supply, "cToken.totalSupply", token.TotalSupply)
+	if err != nil {
+		return nil, err
+	}
+	s.StartTimeline, s.RewardsTimeline = rewardsStart.Uint64(), rewardsTimeline.Uint64()
+	// totalAssets is the balance minus the rewards that are still vesting
+	s.Balance = new(big.Int).Add(totalAssets, s.LockedRewards())
+
+	unstakes, err := pool.GetUnstakes(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getUnstakes")
+	}
+	for _, u := range unstakes {
+		if u.Amount.Sign() == 0 {
+			continue
+		}
+		s.Queue = append(s.Queue, Unstake{Recipient: u.Recipient, Amount: u.Amount})
+		accounts = append(accounts, u.Recipient)
+	}
+	for _, account := range accounts {
+		if _, ok := s.Claimable[account]; ok {
+			continue
+		}
+		amount, err := pool.ClaimableOf(opts, account)
+		if err != nil {
+			return nil, errors.Wrap(err, "claimableOf")
+		}
+		if amount.Sign() > 0 {
+			s.Claimable[account] = amount
+		}
+	}
+
+	// the cToken assets are backed by the pool's own balance and what it
+	// restaked, less the unstakes it owes
+	s.Restaked = new(big.Int).Sub(s.TokenTotalAssets(), s.FlashCapacity())
+	s.Restaked.Add(s.Restaked, s.TotalPendingUnstakes)
+	if s.Restaked.Sign() < 0 {
+		s.Restaked.SetInt64(0)
+	}
+	return s, nil
+}
+
+// Clone returns a deep copy of s.
+func (s *State) Clone() *State {
+	c := *s
+	for _, v := range []**big.Int{
+		&c.MaxTVL, &c.MinStake, &c.MinUnstake, &c.Balance, &c.CurrentRewards,
+		&c.StakeBonusAmount, &c.TotalPendingUnstakes, &c.TotalClaimable,
+		&c.Supply, &c.Ratio, &c.Restaked, &c.TreasuryFees,
+	} {
+		*v = new(big.Int).Set(*v)
+	}
+	c.Queue = make([]Unstake, len(s.Queue))
+	for i, u := range s.Queue {
+		c.Queue[i] = Unstake{Recipient: u.Recipient, Amount: new(big.Int).Set(u.Amount)}
+	}
+	c.Claimable = make(map[common.Address]*big.Int, len(s.Claimable))
+	for a, v := range s.Claimable {
+		c.Claimable[a] = new(big.Int).Set(v)
+	}
+	return &c
+}
+
+// LockedRewards is the part of currentRewards that has not vested yet.
+func (s *State) LockedRewards() *big.Int {
+	totalDays := s.RewardsTimeline / day
+	if s.Time < s.StartTimeline || totalDays == 0 {
+		return new(big.Int)
+	}
+	elapsedDays := (s.Time - s.StartTimeline) / day
+	if elapsedDays > totalDays {
+		return new(big.Int)
+	}
+	locked := new(big.Int).Quo(s.CurrentRewards, new(big.Int).SetUint64(totalDays))
+	return locked.Mul(locked, new(big.Int).SetUint64(totalDays-elapsedDays))
+}
+
+// TotalAssets is the pool's totalAssets: its balance without the vesting
+// rewards.
+func (s *State) TotalAssets() *big.Int {
+	return new(big.Int).Sub(s.Balance, s.LockedRewards())
+}
+
+// FlashCapacity is getFlashCapacity, which is also getPending.
+func (s *State) FlashCapacity() *big.Int {
+	c := new(big.Int).Sub(s.TotalAssets(), s.TotalClaimable)
+	c.Sub(c, s.StakeBonusAmount)
+	if c.Sign() < 0 {
+		return c.SetInt64(0)
+	}
+	return c
+}
+
+// TokenTotalAssets is cToken.totalAssets.
+func (s *State) TokenTotalAssets() *big.Int {
+	return s.quote().ConvertToAmount(s.Supply)
+}
+
+// TargetCapacityAmount is the flash capacity the pool keeps before
+// anything is free for deposits and distributions.
+func (s *State) TargetCapacityAmount() *big.Int {
+	return s.quote().TargetCapacityAmount()
+}
+
+// FreeBalance is getFreeBalance.
+func (s *State) FreeBalance() *big.Int {
+	free := new(big.Int).Sub(s.FlashCapacity(), s.TargetCapacityAmount())
+	if free.Sign() < 0 {
+		return free.SetInt64(0)
+	}
+	return free
+}
+
+// AvailableToStake is availableToStake.
+func (s *State) AvailableToStake() *big.Int {
+	available := new(big.Int).Sub(s.MaxTVL, s.TokenTotalAssets())
+	if available.Sign() < 0 {
+		return available.SetInt64(0)
+	}
+	return available
+}
+
+// quote returns the state as a quote snapshot to reuse the bonus and fee
+// math.
+func (s *State) quote() *quote.Snapshot {
+	q := &quote.Snapshot{
+		Params:           s.Params,
+		StakeBonusAmount: s.StakeBonusAmount,
+		Ratio:            s.Ratio,
+	}
+	q.TokenTotalAssets = q.ConvertToAmount(s.Supply)
+	q.FlashCapacity = s.FlashCapacity()
+	return q
+}
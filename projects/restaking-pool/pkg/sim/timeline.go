@@ -0,0 +1,361 @@
+package sim
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/parse"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/quote"
+)
+
+// Operations an Action can perform.
+const (
+	OpStake        = "stake"
+	OpUnstake      = "unstake"
+	OpFlashUnstake = "flashUnstake"
+	OpDistribute   = "distributeUnstakes"
+	OpClaim        = "claimUnstake"
+	OpAddRewards   = "addRewards"
+	OpUpdateRatio  = "updateRatio"
+	OpDeposit      = "batchDeposit"
+	OpWithdraw     = "withdraw"
+	OpSet          = "set"
+)
+
+// Timeline is a what-if plan, written in YAML or JSON:
+//
+//	duration: 14d
+//	sample: 1d
+//	actions:
+//	  # 5,000 ETH of unstakes spread over the first week
+//	  - {op: unstake, amount: 714 ether, every: 1d, times: 7}
+//	  - {op: distributeUnstakes, at: 12h, every: 1d}
+//	  - {op: claimUnstake, at: 18h, every: 1d}
+//	  - {op: set, at: 3d, set: {targetCapacity: 5e8}}
+//
+// Times are offsets from the seeded state. Amounts accept the formats of
+// parse.Amount.
+type Timeline struct {
+	// Duration is how long to simulate, by default up to the last action.
+	Duration string `yaml:"duration"`
+	// Sample adds a report row at this interval besides the rows of the
+	// actions.
+	Sample  string   `yaml:"sample"`
+	Actions []Action `yaml:"actions"`
+}
+
+// Action is an operation at a point in time, optionally repeated.
+type Action struct {
+	Op string `yaml:"op"`
+	// At is the offset of the first execution, zero by default.
+	At string `yaml:"at"`
+	// Every repeats the action at this interval, Times times or until the
+	// end of the timeline when Times is zero.
+	Every string `yaml:"every"`
+	Times int    `yaml:"times"`
+
+	// Amount is the ETH of stake, unstake, flashUnstake, addRewards,
+	// batchDeposit and withdraw. batchDeposit deposits all it can when
+	// it is empty.
+	Amount interface{} `yaml:"amount"`
+	// To is the unstake recipient or the claimUnstake claimer. claimUnstake
+	// without To claims every claimable.
+	To string `yaml:"to"`
+	// Ratio is the updateRatio value, or "fair" for the ratio that values
+	// the supply at its backing (see State.FairRatio).
+	Ratio interface{} `yaml:"ratio"`
+	// Limit caps the unstakes paid by one distributeUnstakes.
+	Limit int `yaml:"limit"`
+	// Set maps parameter names to new values for set, see SetParam.
+	Set map[string]interface{} `yaml:"set"`
+}
+
+func (a *Action) String() string {
+	switch {
+	case a.Amount != nil:
+		return fmt.Sprintf("%s %v", a.Op, a.Amount)
+	case a.Ratio != nil:
+		return fmt.Sprintf("%s %v", a.Op, a.Ratio)
+	case len(a.Set) > 0:
+		keys := make([]string, 0, len(a.Set))
+		for k := range a.Set {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		s := a.Op
+		for _, k := range keys {
+			s += fmt.Sprintf(" %s=%v", k, a.Set[k])
+		}
+		return s
+	}
+	return a.Op
+}
+
+// ParseTimeline decodes and validates a timeline.
+func ParseTimeline(data []byte) (*Timeline, error) {
+	var t Timeline
+	if err := yaml.Unmarshal(data, &t); err != nil {
+		return nil, errors.Wrap(err, "could not parse timeline")
+	}
+	if _, err := t.events(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// LoadTimeline reads and parses a timeline file.
+func LoadTimeline(path string) (*Timeline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := ParseTimeline(data)
+	return t, errors.Wrap(err, path)
+}
+
+func parseOffset(s string) (time.Duration, error) {
+	if s == "" || s == "0" {
+		return 0, nil
+	}
+	return parse.Duration(s)
+}
+
+// event is one execution of an action, or a sample when action is nil.
+type event struct {
+	at     time.Duration
+	action *Action
+}
+
+// events expands the repeated actions and the samples in execution order.
+// Actions at the same time run in file order before the sample.
+func (t *Timeline) events() ([]event, error) {
+	duration, err := parseOffset(t.Duration)
+	if err != nil {
+		return nil, errors.Wrap(err, "duration")
+	}
+	sample, err := parseOffset(t.Sample)
+	if err != nil {
+		return nil, errors.Wrap(err, "sample")
+	}
+
+	var events []event
+	end := duration
+	for i := range t.Actions {
+		a := &t.Actions[i]
+		if err := a.validate(); err != nil {
+			return nil, errors.Wrapf(err, "action %d", i)
+		}
+		at, err := parseOffset(a.At)
+		if err != nil {
+			return nil, errors.Wrapf(err, "action %d", i)
+		}
+		every, err := parseOffset(a.Every)
+		if err != nil {
+			return nil, errors.Wrapf(err, "action %d", i)
+		}
+		if every == 0 {
+			events = append(events, event{at: at, action: a})
+			if duration == 0 && at > end {
+				end = at
+			}
+			continue
+		}
+		if a.Times == 0 && duration == 0 {
+			return nil, errors.Errorf("action %d repeats forever, set times or the timeline duration", i)
+		}
+		for n := 0; a.Times == 0 || n < a.Times; n++ {
+			next := at + time.Duration(n)*every
+			if duration > 0 && next > duration {
+				break
+			}
+			events = append(events, event{at: next, action: a})
+			if duration == 0 && next > end {
+				end = next
+			}
+		}
+	}
+	if sample > 0 {
+		for at := time.Duration(0); at <= end; at += sample {
+			events = append(events, event{at: at})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].at != events[j].at {
+			return events[i].at < events[j].at
+		}
+		return events[i].action != nil && events[j].action == nil
+	})
+	return events, nil
+}
+
+func (a *Action) validate() error {
+	switch a.Op {
+	case OpStake, OpUnstake, OpFlashUnstake, OpAddRewards, OpWithdraw:
+		if a.Amount == nil {
+			return errors.Errorf("%s needs an amount", a.Op)
+		}
+	case OpUpdateRatio:
+		if a.Ratio == nil {
+			return errors.New("updateRatio needs a ratio")
+		}
+	case OpSet:
+		if len(a.Set) == 0 {
+			return errors.New("set needs parameters")
+		}
+		for name := range a.Set {
+			if _, ok := params[name]; !ok {
+				return errors.Errorf("unknown parameter %q", name)
+			}
+		}
+	case OpDistribute, OpClaim, OpDeposit:
+	default:
+		return errors.Errorf("unknown op %q", a.Op)
+	}
+	if a.Amount != nil {
+		if _, err := parse.Amount(a.Amount); err != nil {
+			return err
+		}
+	}
+	if a.To != "" && !common.IsHexAddress(a.To) {
+		return errors.Errorf("invalid address %q", a.To)
+	}
+	return nil
+}
+
+// Apply executes the action on s at s.Time.
+func (s *State) Apply(a *Action) error {
+	var amount *big.Int
+	if a.Amount != nil {
+		var err error
+		if amount, err = parse.Amount(a.Amount); err != nil {
+			return err
+		}
+	}
+	to := common.HexToAddress(a.To)
+
+	switch a.Op {
+	case OpStake:
+		_, err := s.Stake(amount)
+		return err
+	case OpUnstake:
+		_, err := s.Unstake(to, amount)
+		return err
+	case OpFlashUnstake:
+		_, err := s.FlashUnstake(amount)
+		return err
+	case OpDistribute:
+		s.DistributeUnstakes(a.Limit)
+		return nil
+	case OpClaim:
+		var err error
+		if a.To == "" {
+			_, err = s.ClaimAll()
+		} else {
+			_, err = s.ClaimUnstake(to)
+		}
+		return err
+	case OpAddRewards:
+		return s.AddRewards(amount)
+	case OpUpdateRatio:
+		if a.Ratio == "fair" {
+			ratio := s.FairRatio()
+			if ratio == nil {
+				return errors.New("no backing to derive a fair ratio from")
+			}
+			return s.UpdateRatio(ratio)
+		}
+		ratio, err := parse.Amount(a.Ratio)
+		if err != nil {
+			return err
+		}
+		return s.UpdateRatio(ratio)
+	case OpDeposit:
+		if amount == nil {
+			amount = s.MaxDeposit()
+		}
+		return s.Deposit(amount)
+	case OpWithdraw:
+		return s.Withdraw(amount)
+	case OpSet:
+		names := make([]string, 0, len(a.Set))
+		for name := range a.Set {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := s.SetParam(name, a.Set[name]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.Errorf("unknown op %q", a.Op)
+}
+
+// params are the names SetParam accepts, after the pool setters.
+var params = map[string]func(s *State, v *big.Int) error{
+	// targetCapacity is the only rate the pool does not cap at 100%
+	"targetCapacity":         rate(func(s *State) *uint64 { return &s.TargetCapacity }, false),
+	"maxBonusRate":           rate(func(s *State) *uint64 { return &s.MaxBonusRate }, true),
+	"optimalBonusRate":       rate(func(s *State) *uint64 { return &s.OptimalBonusRate }, true),
+	"stakeUtilizationKink":   rate(func(s *State) *uint64 { return &s.StakeUtilizationKink }, true),
+	"maxFlashFeeRate":        rate(func(s *State) *uint64 { return &s.MaxFlashFeeRate }, true),
+	"optimalUnstakeRate":     rate(func(s *State) *uint64 { return &s.OptimalUnstakeRate }, true),
+	"unstakeUtilizationKink": rate(func(s *State) *uint64 { return &s.UnstakeUtilizationKink }, true),
+	"protocolFee":            rate(func(s *State) *uint64 { return &s.ProtocolFee }, true),
+	"maxTVL": func(s *State, v *big.Int) error {
+		if v.Sign() == 0 {
+			return ErrZeroAmount
+		}
+		s.MaxTVL = v
+		return nil
+	},
+	"minStake":   func(s *State, v *big.Int) error { s.MinStake = v; return nil },
+	"minUnstake": func(s *State, v *big.Int) error { s.MinUnstake = v; return nil },
+	"rewardsTimeline": func(s *State, v *big.Int) error {
+		if !v.IsUint64() || v.Uint64() < day || v.Uint64()%day != 0 {
+			return errors.New("InconsistentData")
+		}
+		s.RewardsTimeline = v.Uint64()
+		return nil
+	},
+}
+
+func rate(field func(*State) *uint64, capped bool) func(*State, *big.Int) error {
+	return func(s *State, v *big.Int) error {
+		if !v.IsUint64() || capped && v.Uint64() > quote.MaxPercent {
+			return errors.Errorf("ParameterExceedsLimits(%s)", v)
+		}
+		*field(s) = v.Uint64()
+		return nil
+	}
+}
+
+// SetParam changes a pool parameter. Names follow the pool setters:
+// targetCapacity, maxBonusRate, optimalBonusRate, stakeUtilizationKink,
+// maxFlashFeeRate, optimalUnstakeRate, unstakeUtilizationKink, protocolFee,
+// maxTVL, minStake, minUnstake and rewardsTimeline, the latter in seconds
+// or as a duration such as "7d".
+func (s *State) SetParam(name string, value interface{}) error {
+	set, ok := params[name]
+	if !ok {
+		return errors.Errorf("unknown parameter %q", name)
+	}
+	if d, ok := value.(string); ok && name == "rewardsTimeline" {
+		if duration, err := parse.Duration(d); err == nil {
+			value = int64(duration / time.Second)
+		}
+	}
+	v, err := parse.Amount(value)
+	if err != nil {
+		return errors.Wrap(err, name)
+	}
+	return set(s, v)
+}
@@ -0,0 +1,106 @@
+package txmgr
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Pending is a sent transaction waiting for its confirmations, with the
+// replacements sent for its nonce. Any of them may be the one mined.
+type Pending struct {
+	From  common.Address `json:"from"`
+	Nonce uint64         `json:"nonce"`
+	// Txs are the signed transactions of the nonce, the last one being the
+	// latest replacement.
+	Txs []hexutil.Bytes `json:"txs"`
+	// SentAt is the time the latest transaction was sent.
+	SentAt time.Time `json:"sentAt"`
+}
+
+// Transactions decodes the transactions of p.
+func (p *Pending) Transactions() ([]*types.Transaction, error) {
+	txs := make([]*types.Transaction, len(p.Txs))
+	for i, raw := range p.Txs {
+		txs[i] = new(types.Transaction)
+		if err := txs[i].UnmarshalBinary(raw); err != nil {
+			return nil, errors.Wrapf(err, "could not decode transaction %d of nonce %d", i, p.Nonce)
+		}
+	}
+	return txs, nil
+}
+
+// Latest returns the latest transaction of p.
+func (p *Pending) Latest() (*types.Transaction, error) {
+	if len(p.Txs) == 0 {
+		return nil, errors.Errorf("no transaction for nonce %d", p.Nonce)
+	}
+	tx := new(types.Transaction)
+	return tx, errors.Wrap(tx.UnmarshalBinary(p.Txs[len(p.Txs)-1]), "could not decode transaction")
+}
+
+func (p *Pending) add(tx *types.Transaction, at time.Time) error {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "could not encode transaction")
+	}
+	p.Txs = append(p.Txs, raw)
+	p.SentAt = at.UTC()
+	return nil
+}
+
+// State is what the manager persists across restarts, so that a restart
+// follows the transactions in flight instead of reusing their nonces.
+type State struct {
+	Pending []*Pending `json:"pending"`
+}
+
+// loadState reads the state at path. A missing file is an empty state.
+func loadState(path string) (*State, error) {
+	s := new(State)
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state")
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Wrapf(err, "could not parse state %s", path)
+	}
+	return s, nil
+}
+
+// save writes the state to path through a temporary file, so a crash never
+// leaves a truncated state behind.
+func (s *State) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode state")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write state")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), path), "could not write state")
+}
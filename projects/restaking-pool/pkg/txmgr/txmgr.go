@@ -0,0 +1,304 @@
+// Package txmgr sends the transactions of the generated Transactors.
+//
+// A Manager hands the Transactor methods TransactOpts with the next nonce of
+// the sender, EIP-1559 fees and a gas limit estimated with a margin, then
+// persists the signed transaction before sending it, so that a restart
+// follows the transactions in flight instead of reusing their nonces. Wait
+// follows a transaction until it has its confirmations, replacing it with
+// bumped fees while it is not mined. Nonces are serialized per sender:
+// concurrent sends of one sender get consecutive nonces.
+//
+//	m, err := txmgr.New(ctx, backend, txmgr.Config{ChainID: big.NewInt(1), StatePath: "txs.json"}, governance)
+//	p, err := m.Send(ctx, governance.From, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return pool.SetMaxTVL(opts, maxTVL)
+//	})
+//	receipt, err := m.Wait(ctx, p)
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// Backend is what a manager needs from a node; ethclient.Client implements
+// it.
+type Backend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+var (
+	// ErrChainID is returned when the backend is not on the configured
+	// network.
+	ErrChainID = errors.New("wrong chain id")
+	// ErrNonceUsed is returned when the nonce of a pending transaction was
+	// used by a transaction the manager did not send.
+	ErrNonceUsed = errors.New("nonce used by another transaction")
+)
+
+// Config configures a Manager.
+type Config struct {
+	// ChainID is the network transactions are sent to. Nothing is sent to a
+	// backend on another chain.
+	ChainID *big.Int
+	// StatePath is the file the pending transactions persist to. They are
+	// only kept in memory when empty.
+	StatePath string
+	// Confirmations is the number of blocks, including the one it is mined
+	// in, a transaction waits for. 1 by default.
+	Confirmations uint64
+	// GasMargin is the percentage added to the estimated gas limit, 20 by
+	// default.
+	GasMargin uint64
+	// FeeBump is the percentage a replacement raises the fees by, 12 by
+	// default. Nodes reject replacements below 10.
+	FeeBump uint64
+	// MaxFeePerGas caps the fees of every transaction, uncapped when nil.
+	MaxFeePerGas *big.Int
+	// ResendAfter is the time a transaction waits to be mined before it is
+	// replaced, 3 minutes by default.
+	ResendAfter time.Duration
+	// PollInterval is the delay between two polls of Wait, 2 seconds by
+	// default.
+	PollInterval time.Duration
+}
+
+func (c Config) withDefaults() Config {
+	if c.Confirmations == 0 {
+		c.Confirmations = 1
+	}
+	if c.GasMargin == 0 {
+		c.GasMargin = 20
+	}
+	if c.FeeBump == 0 {
+		c.FeeBump = 12
+	}
+	if c.ResendAfter == 0 {
+		c.ResendAfter = 3 * time.Minute
+	}
+	if c.PollInterval == 0 {
+		c.PollInterval = 2 * time.Second
+	}
+	return c
+}
+
+// Manager sends and follows the transactions of a set of senders.
+type Manager struct {
+	backend Backend
+	cfg     Config
+	now     func() time.Time
+
+	mu      sync.Mutex
+	state   *State
+	senders map[common.Address]*sender
+}
+
+// sender is an account the manager signs for.
+type sender struct {
+	// mu serializes the transactions of the sender.
+	mu   sync.Mutex
+	sign bind.SignerFn
+	// nonce is the next nonce, nil until read from the node.
+	nonce *uint64
+}
+
+// New returns a manager sending for the accounts of signers, whose From and
+// Signer are used. The pending transactions are loaded from cfg.StatePath.
+func New(ctx context.Context, backend Backend, cfg Config, signers ...*bind.TransactOpts) (*Manager, error) {
+	if cfg.ChainID == nil {
+		return nil, errors.New("no chain id")
+	}
+	state, err := loadState(cfg.StatePath)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{backend: backend, cfg: cfg.withDefaults(), now: time.Now, state: state, senders: make(map[common.Address]*sender)}
+	if err := m.checkChain(ctx); err != nil {
+		return nil, err
+	}
+	for _, s := range signers {
+		m.senders[s.From] = &sender{sign: s.Signer}
+	}
+	return m, nil
+}
+
+func (m *Manager) checkChain(ctx context.Context) error {
+	id, err := m.backend.ChainID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get chain id")
+	}
+	if id.Cmp(m.cfg.ChainID) != 0 {
+		return errors.Wrapf(ErrChainID, "backend on chain %s, want %s", id, m.cfg.ChainID)
+	}
+	return nil
+}
+
+// Pending returns the transactions in flight, in the order they were sent.
+func (m *Manager) Pending() []*Pending {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*Pending(nil), m.state.Pending...)
+}
+
+func (m *Manager) sender(from common.Address) (*sender, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.senders[from]
+	if !ok {
+		return nil, errors.Errorf("no signer for %s", from.Hex())
+	}
+	return s, nil
+}
+
+// Send sends the transaction call builds, typically a Transactor method
+// called with the options it is given. The options must not be changed,
+// except for the gas limit and the value; a gas limit set by call gets no
+// margin.
+func (m *Manager) Send(ctx context.Context, from common.Address, call func(*bind.TransactOpts) (*types.Transaction, error)) (*Pending, error) {
+	s, err := m.sender(from)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := m.checkChain(ctx); err != nil {
+		return nil, err
+	}
+	nonce, err := m.nonce(ctx, from, s)
+	if err != nil {
+		return nil, err
+	}
+	tip, feeCap, err := m.fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.TransactOpts{From: from, Nonce: new(big.Int).SetUint64(nonce), Context: ctx, NoSend: true}
+	if tip == nil {
+		opts.GasPrice = feeCap
+	} else {
+		opts.GasTipCap, opts.GasFeeCap = tip, feeCap
+	}
+	opts.Signer = func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if opts.GasLimit == 0 {
+			tx = replace(tx, tx.Gas()*(100+m.cfg.GasMargin)/100, tx.GasTipCap(), tx.GasFeeCap())
+		}
+		return s.sign(addr, tx)
+	}
+	tx, err := call(opts)
+	if err != nil {
+		return nil, err
+	}
+	if tx.ChainId().Cmp(m.cfg.ChainID) != 0 {
+		return nil, errors.Wrapf(ErrChainID, "transaction signed for chain %s, want %s", tx.ChainId(), m.cfg.ChainID)
+	}
+	if tx.Nonce() != nonce {
+		return nil, errors.Errorf("transaction with nonce %d, want %d", tx.Nonce(), nonce)
+	}
+
+	p := &Pending{From: from, Nonce: nonce}
+	if err := p.add(tx, m.now()); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	m.state.Pending = append(m.state.Pending, p)
+	err = m.state.save(m.cfg.StatePath)
+	m.mu.Unlock()
+	if err == nil {
+		err = errors.Wrap(m.backend.SendTransaction(ctx, tx), "could not send transaction")
+	}
+	if err != nil {
+		// the node may know better
+		s.nonce = nil
+		return nil, multierr.Append(err, m.done(p))
+	}
+	nonce++
+	s.nonce = &nonce
+	return p, nil
+}
+
+// nonce returns the next nonce of from, past the nonces in flight.
+func (m *Manager) nonce(ctx context.Context, from common.Address, s *sender) (uint64, error) {
+	if s.nonce != nil {
+		return *s.nonce, nil
+	}
+	nonce, err := m.backend.PendingNonceAt(ctx, from)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not get nonce of %s", from.Hex())
+	}
+	for _, p := range m.Pending() {
+		if p.From == from && p.Nonce >= nonce {
+			nonce = p.Nonce + 1
+		}
+	}
+	return nonce, nil
+}
+
+// fees returns the tip and fee cap of a new transaction, or a nil tip and
+// the gas price before London.
+func (m *Manager) fees(ctx context.Context) (tip, feeCap *big.Int, err error) {
+	head, err := m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get head")
+	}
+	if head.BaseFee == nil {
+		price, err := m.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not suggest gas price")
+		}
+		return nil, m.capped(price), nil
+	}
+	if tip, err = m.backend.SuggestGasTipCap(ctx); err != nil {
+		return nil, nil, errors.Wrap(err, "could not suggest tip")
+	}
+	feeCap = m.capped(new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))))
+	if tip.Cmp(feeCap) > 0 {
+		tip = feeCap
+	}
+	return tip, feeCap, nil
+}
+
+func (m *Manager) capped(fee *big.Int) *big.Int {
+	if m.cfg.MaxFeePerGas != nil && fee.Cmp(m.cfg.MaxFeePerGas) > 0 {
+		return new(big.Int).Set(m.cfg.MaxFeePerGas)
+	}
+	return fee
+}
+
+// replace returns tx with another gas limit and fees; feeCap is the gas
+// price of a legacy transaction.
+func replace(tx *types.Transaction, gas uint64, tip, feeCap *big.Int) *types.Transaction {
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID: tx.ChainId(), Nonce: tx.Nonce(), GasTipCap: tip, GasFeeCap: feeCap, Gas: gas,
+			To: tx.To(), Value: tx.Value(), Data: tx.Data(), AccessList: tx.AccessList(),
+		})
+	case types.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{Nonce: tx.Nonce(), GasPrice: feeCap, Gas: gas, To: tx.To(), Value: tx.Value(), Data: tx.Data()})
+	}
+	return tx
+}
+
+// done forgets p.
+func (m *Manager) done(p *Pending) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, q := range m.state.Pending {
+		if q == p {
+			m.state.Pending = append(m.state.Pending[:i:i], m.state.Pending[i+1:]...)
+			return m.state.save(m.cfg.StatePath)
+		}
+	}
+	return nil
+}
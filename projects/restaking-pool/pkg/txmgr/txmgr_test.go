@@ -0,0 +1,222 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+// node is the simulated backend with a chain id. While drop is set, sent
+// transactions are lost, like a transaction evicted from the mempool.
+type node struct {
+	*backends.SimulatedBackend
+
+	mu   sync.Mutex
+	drop bool
+}
+
+func (n *node) ChainID(context.Context) (*big.Int, error) {
+	return fixture.ChainID, nil
+}
+
+func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.drop {
+		return nil
+	}
+	return n.SimulatedBackend.SendTransaction(ctx, tx)
+}
+
+func setup(t *testing.T, cfg Config) (*fixture.Fixture, *node, *Manager) {
+	t.Helper()
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	n := &node{SimulatedBackend: f.Backend}
+	cfg.ChainID = fixture.ChainID
+	m, err := New(context.Background(), n, cfg, f.Governance.TransactOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, n, m
+}
+
+func setMaxTVL(f *fixture.Fixture, ether int64) func(*bind.TransactOpts) (*types.Transaction, error) {
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return f.RestakingPool.SetMaxTVL(opts, new(big.Int).Mul(big.NewInt(ether), fixture.Ether))
+	}
+}
+
+func TestSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "txs.json")
+	f, n, m := setup(t, Config{StatePath: path, Confirmations: 2})
+	ctx := context.Background()
+	from := f.Governance.Address
+	start, err := f.Backend.PendingNonceAt(ctx, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// concurrent sends get consecutive nonces
+	const sends = 5
+	pending := make([]*Pending, sends)
+	var wg sync.WaitGroup
+	for i := 0; i < sends; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			p, err := m.Send(ctx, from, setMaxTVL(f, int64(40+i)))
+			if err != nil {
+				t.Error(err)
+			}
+			pending[i] = p
+		}(i)
+	}
+	wg.Wait()
+	if t.Failed() {
+		t.FailNow()
+	}
+	nonces := make(map[uint64]bool)
+	for _, p := range pending {
+		nonces[p.Nonce] = true
+	}
+	for i := uint64(0); i < sends; i++ {
+		if !nonces[start+i] {
+			t.Fatalf("nonce %d not used: %v", start+i, nonces)
+		}
+	}
+
+	// a restart sees the transactions in flight and continues after them
+	state, err := loadState(path)
+	if err != nil || len(state.Pending) != sends {
+		t.Fatalf("persisted %+v: %v", state, err)
+	}
+	restarted, err := New(ctx, n, Config{ChainID: fixture.ChainID, StatePath: path}, f.Governance.TransactOpts())
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.drop = true
+	p, err := restarted.Send(ctx, from, setMaxTVL(f, 50))
+	if err != nil || p.Nonce != start+sends || len(restarted.Pending()) != sends+1 {
+		t.Fatalf("send after restart %+v: %v", p, err)
+	}
+	n.drop = false
+
+	f.Backend.Commit()
+	for _, p := range pending {
+		if receipt, err := m.Poll(ctx, p); receipt != nil || err != nil {
+			t.Fatalf("receipt %+v with 1 confirmation: %v", receipt, err)
+		}
+	}
+	f.Backend.Commit()
+	for _, p := range pending {
+		receipt, err := m.Wait(ctx, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := p.Latest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the estimate gets its margin
+		if receipt.TxHash != tx.Hash() || tx.Gas() < receipt.GasUsed*120/100 || tx.Type() != types.DynamicFeeTxType {
+			t.Errorf("gas limit %d for %d used", tx.Gas(), receipt.GasUsed)
+		}
+	}
+	if state, err := loadState(path); err != nil || len(state.Pending) != 0 {
+		t.Errorf("persisted %+v after the receipts: %v", state, err)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	f, n, m := setup(t, Config{ResendAfter: time.Minute})
+	ctx := context.Background()
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	n.drop = true
+	p, err := m.Send(ctx, f.Governance.Address, setMaxTVL(f, 64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.drop = false
+	f.Backend.Commit()
+	if receipt, err := m.Poll(ctx, p); receipt != nil || err != nil || len(p.Txs) != 1 {
+		t.Fatalf("poll before ResendAfter %+v: %v", receipt, err)
+	}
+
+	now = now.Add(time.Minute)
+	if receipt, err := m.Poll(ctx, p); receipt != nil || err != nil || len(p.Txs) != 2 {
+		t.Fatalf("poll after ResendAfter %+v: %v", receipt, err)
+	}
+	txs, err := p.Transactions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := txs[0], txs[1]
+	if second.Nonce() != first.Nonce() || second.GasFeeCap().Cmp(m.bumped(first.GasFeeCap())) < 0 || second.GasTipCap().Cmp(m.bumped(first.GasTipCap())) < 0 {
+		t.Fatalf("replacement fees %s/%s, was %s/%s", second.GasFeeCap(), second.GasTipCap(), first.GasFeeCap(), first.GasTipCap())
+	}
+	f.Backend.Commit()
+	receipt, err := m.Poll(ctx, p)
+	if err != nil || receipt == nil || receipt.TxHash != second.Hash() {
+		t.Fatalf("receipt %+v: %v", receipt, err)
+	}
+	if tvl, err := f.RestakingPool.MaxTVL(nil); err != nil || tvl.Cmp(new(big.Int).Mul(big.NewInt(64), fixture.Ether)) != 0 {
+		t.Errorf("maxTVL %s: %v", tvl, err)
+	}
+
+	// a nonce taken by another transaction
+	n.drop = true
+	p, err = m.Send(ctx, f.Governance.Address, setMaxTVL(f, 65))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.drop = false
+	if _, err := f.Send(f.RestakingPool.SetMaxTVL(f.Governance.TransactOpts(), big.NewInt(1))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Poll(ctx, p); !errors.Is(err, ErrNonceUsed) || len(m.Pending()) != 0 {
+		t.Fatalf("poll of a used nonce: %v", err)
+	}
+}
+
+func TestChainID(t *testing.T) {
+	f, err := fixture.New(fixture.DefaultOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	_, err = New(context.Background(), &node{SimulatedBackend: f.Backend}, Config{ChainID: big.NewInt(1)}, f.Governance.TransactOpts())
+	if !errors.Is(err, ErrChainID) {
+		t.Fatalf("manager on the wrong chain: %v", err)
+	}
+
+	// a signer of another chain
+	f, _, m := setup(t, Config{})
+	mainnet, err := bind.NewKeyedTransactorWithChainID(f.Users[0].Key, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.senders[mainnet.From] = &sender{sign: mainnet.Signer}
+	_, err = m.Send(context.Background(), mainnet.From, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = 100_000
+		return setMaxTVL(f, 64)(opts)
+	})
+	if !errors.Is(err, ErrChainID) {
+		t.Fatalf("send signed for mainnet: %v", err)
+	}
+}
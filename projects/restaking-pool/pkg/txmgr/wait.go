@@ -0,0 +1,141 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// Wait polls p until one of its transactions has its confirmations and
+// returns its receipt. A reverted transaction is returned with an error.
+func (m *Manager) Wait(ctx context.Context, p *Pending) (*types.Receipt, error) {
+	for {
+		receipt, err := m.Poll(ctx, p)
+		if receipt != nil || err != nil {
+			return receipt, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(m.cfg.PollInterval):
+		}
+	}
+}
+
+// Poll checks p once. It returns the receipt of the transaction of p that
+// has its confirmations, and nil while none has. A transaction not mined
+// ResendAfter after it was sent is replaced with bumped fees. Once mined, p
+// is no longer pending; a reverted transaction is returned with an error.
+func (m *Manager) Poll(ctx context.Context, p *Pending) (*types.Receipt, error) {
+	txs, err := p.Transactions()
+	if err != nil {
+		return nil, err
+	}
+	head, err := m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head")
+	}
+	for i := len(txs) - 1; i >= 0; i-- {
+		receipt, err := m.backend.TransactionReceipt(ctx, txs[i].Hash())
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get receipt of %s", txs[i].Hash().Hex())
+		}
+		mined := receipt.BlockNumber.Uint64()
+		if head.Number.Uint64()+1 < mined+m.cfg.Confirmations {
+			return nil, nil
+		}
+		if err := m.done(p); err != nil {
+			return nil, err
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return receipt, errors.Errorf("transaction %s reverted in block %d", receipt.TxHash.Hex(), mined)
+		}
+		return receipt, nil
+	}
+
+	// none of the transactions is mined at head
+	nonce, err := m.backend.NonceAt(ctx, p.From, head.Number)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get nonce of %s", p.From.Hex())
+	}
+	if nonce > p.Nonce {
+		if err := m.done(p); err != nil {
+			return nil, err
+		}
+		return nil, errors.Wrapf(ErrNonceUsed, "nonce %d of %s", p.Nonce, p.From.Hex())
+	}
+	if m.now().Sub(p.SentAt) < m.cfg.ResendAfter {
+		return nil, nil
+	}
+	return nil, m.bump(ctx, p, txs[len(txs)-1])
+}
+
+// bump replaces latest, the latest transaction of p, with fees raised by
+// FeeBump percent or to the current fees when they are higher. Nothing is
+// sent when MaxFeePerGas leaves no room for the bump.
+func (m *Manager) bump(ctx context.Context, p *Pending, latest *types.Transaction) error {
+	s, err := m.sender(p.From)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := m.checkChain(ctx); err != nil {
+		return err
+	}
+	tip, feeCap, err := m.fees(ctx)
+	if err != nil {
+		return err
+	}
+	minCap := m.bumped(latest.GasFeeCap())
+	if feeCap.Cmp(minCap) < 0 {
+		feeCap = minCap
+	}
+	if tip != nil {
+		if minTip := m.bumped(latest.GasTipCap()); tip.Cmp(minTip) < 0 {
+			tip = minTip
+		}
+	}
+	if feeCap = m.capped(feeCap); feeCap.Cmp(minCap) < 0 {
+		// retry once the fees allow it
+		m.mu.Lock()
+		p.SentAt = m.now().UTC()
+		m.mu.Unlock()
+		return nil
+	}
+	if tip != nil && tip.Cmp(feeCap) > 0 {
+		tip = feeCap
+	}
+	if tip == nil {
+		tip = feeCap
+	}
+
+	tx, err := s.sign(p.From, replace(latest, latest.Gas(), tip, feeCap))
+	if err != nil {
+		return errors.Wrap(err, "could not sign replacement")
+	}
+	m.mu.Lock()
+	err = p.add(tx, m.now())
+	if err == nil {
+		err = m.state.save(m.cfg.StatePath)
+	}
+	m.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return errors.Wrapf(m.backend.SendTransaction(ctx, tx), "could not send replacement of nonce %d", p.Nonce)
+}
+
+// bumped raises fee by FeeBump percent, rounding up.
+func (m *Manager) bumped(fee *big.Int) *big.Int {
+	v := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+m.cfg.FeeBump))
+	v.Add(v, big.NewInt(99))
+	return v.Div(v, big.NewInt(100))
+}
@@ -0,0 +1,162 @@
+package unstakes
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+// kind is the name of a replayed event.
+type kind string
+
+const (
+	kindUnstaked            kind = "Unstaked"
+	kindPendingUnstake      kind = "PendingUnstake"
+	kindUnstakesDistributed kind = "UnstakesDistributed"
+	kindClaimExpected       kind = "ClaimExpected"
+	kindUnstakeClaimed      kind = "UnstakeClaimed"
+)
+
+// event is a decoded queue event. Only the fields of its kind are set.
+type event struct {
+	kind kind
+	log  types.Log
+
+	owner, recipient common.Address
+	amount, shares   *big.Int
+	distributed      []restakingpool.IRestakingPoolUnstake
+}
+
+// fetch reads the queue events in [start, end] through the generated
+// filterers and returns them in chain order.
+func fetch(ctx context.Context, pool *restakingpool.Contract, start, end uint64) ([]event, error) {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+	var events []event
+	add := func(e event) {
+		if !e.log.Removed {
+			events = append(events, e)
+		}
+	}
+
+	unstaked, err := pool.FilterUnstaked(opts, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unstaked")
+	}
+	for unstaked.Next() {
+		ev := unstaked.Event
+		add(event{kind: kindUnstaked, log: ev.Raw, owner: ev.From, recipient: ev.To, amount: ev.Amount, shares: ev.Shares})
+	}
+	if err := closeIterator(unstaked.Error(), unstaked.Close(), kindUnstaked); err != nil {
+		return nil, err
+	}
+
+	pending, err := pool.FilterPendingUnstake(opts, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "PendingUnstake")
+	}
+	for pending.Next() {
+		ev := pending.Event
+		add(event{kind: kindPendingUnstake, log: ev.Raw, owner: ev.OwnerAddress, recipient: ev.ReceiverAddress, amount: ev.Amount, shares: ev.Shares})
+	}
+	if err := closeIterator(pending.Error(), pending.Close(), kindPendingUnstake); err != nil {
+		return nil, err
+	}
+
+	distributed, err := pool.FilterUnstakesDistributed(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "UnstakesDistributed")
+	}
+	for distributed.Next() {
+		ev := distributed.Event
+		add(event{kind: kindUnstakesDistributed, log: ev.Raw, distributed: ev.Unstakes})
+	}
+	if err := closeIterator(distributed.Error(), distributed.Close(), kindUnstakesDistributed); err != nil {
+		return nil, err
+	}
+
+	expected, err := pool.FilterClaimExpected(opts, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "ClaimExpected")
+	}
+	for expected.Next() {
+		ev := expected.Event
+		add(event{kind: kindClaimExpected, log: ev.Raw, recipient: ev.Claimer, amount: ev.Value})
+	}
+	if err := closeIterator(expected.Error(), expected.Close(), kindClaimExpected); err != nil {
+		return nil, err
+	}
+
+	claimed, err := pool.FilterUnstakeClaimed(opts, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "UnstakeClaimed")
+	}
+	for claimed.Next() {
+		ev := claimed.Event
+		add(event{kind: kindUnstakeClaimed, log: ev.Raw, recipient: ev.Claimer, owner: ev.Caller, amount: ev.Value})
+	}
+	if err := closeIterator(claimed.Error(), claimed.Close(), kindUnstakeClaimed); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].log, events[j].log
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		return a.Index < b.Index
+	})
+	return dedupe(events), nil
+}
+
+func closeIterator(iterErr, closeErr error, k kind) error {
+	if iterErr != nil {
+		return errors.Wrapf(iterErr, "%s", k)
+	}
+	return errors.Wrapf(closeErr, "%s", k)
+}
+
+// dedupe drops the events that describe the same change as another event
+// of the transaction. Pool versions that emit PendingUnstake next to
+// Unstaked would otherwise queue the unstake twice, and UnstakesDistributed
+// repeats the ClaimExpected events of its distribution.
+func dedupe(events []event) []event {
+	type key struct {
+		tx        common.Hash
+		recipient common.Address
+		amount    string
+	}
+	unstaked := make(map[key]int)
+	expected := make(map[common.Hash]bool)
+	for _, e := range events {
+		switch e.kind {
+		case kindUnstaked:
+			unstaked[key{e.log.TxHash, e.recipient, e.amount.String()}]++
+		case kindClaimExpected:
+			expected[e.log.TxHash] = true
+		}
+	}
+	out := events[:0]
+	for _, e := range events {
+		switch e.kind {
+		case kindPendingUnstake:
+			k := key{e.log.TxHash, e.recipient, e.amount.String()}
+			if unstaked[k] > 0 {
+				unstaked[k]--
+				continue
+			}
+		case kindUnstakesDistributed:
+			if expected[e.log.TxHash] {
+				continue
+			}
+		}
+		out = append(out, e)
+	}
+	return out
+}
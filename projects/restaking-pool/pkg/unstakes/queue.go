@@ -0,0 +1,282 @@
+// Package unstakes rebuilds the RestakingPool unstake queue and the
+// per-account claim state by replaying the pool events, and reconciles the
+// result with the pool views.
+//
+// The queue grows with Unstaked (or PendingUnstake on pool versions that
+// emit it), distributeUnstakes pays it from the head and emits one
+// ClaimExpected per paid unstake (UnstakesDistributed on older versions),
+// and UnstakeClaimed empties the claimable of an account.
+package unstakes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+// Request is one unstake in the pool queue.
+type Request struct {
+	// Index is the position in the pool's queue array, counting the
+	// requests that were already distributed.
+	Index     int
+	Owner     common.Address
+	Recipient common.Address
+	Amount    *big.Int
+	Shares    *big.Int
+	Tx        common.Hash
+	Block     uint64
+
+	Distributed      bool
+	DistributedTx    common.Hash
+	DistributedBlock uint64
+	// Claim is the claim that paid out the distributed request, nil while
+	// it is claimable.
+	Claim *Claim
+}
+
+// Distribution is one distributeUnstakes transaction that paid requests.
+type Distribution struct {
+	Tx       common.Hash
+	Block    uint64
+	Requests []*Request
+	Amount   *big.Int
+}
+
+// Claim is one claimUnstake.
+type Claim struct {
+	Claimer common.Address
+	Caller  common.Address
+	Amount  *big.Int
+	Tx      common.Hash
+	Block   uint64
+}
+
+// Account is the unstake state of one recipient.
+type Account struct {
+	// Pending is the sum of the requests still queued for the account,
+	// getTotalUnstakesOf.
+	Pending *big.Int
+	// Claimable is claimableOf.
+	Claimable *big.Int
+	Claims    []*Claim
+	// LastTx is the last transaction that changed the account.
+	LastTx common.Hash
+	// unclaimed are the distributed requests the next claim pays out.
+	unclaimed []*Request
+}
+
+// Queue is the replayed state.
+type Queue struct {
+	// Requests holds every request in queue order. Requests[Gap:] are
+	// pending, like getUnstakes.
+	Requests      []*Request
+	Gap           int
+	Accounts      map[common.Address]*Account
+	Distributions []*Distribution
+
+	TotalPending   *big.Int
+	TotalClaimable *big.Int
+	// lastPendingTx and lastClaimableTx are the last transactions that
+	// changed the totals.
+	lastPendingTx   common.Hash
+	lastClaimableTx common.Hash
+
+	// Issues are the inconsistencies found while replaying, such as a
+	// distribution that does not match the head of the queue.
+	Issues []Discrepancy
+	// Next is the first block not replayed yet.
+	Next uint64
+}
+
+// New returns an empty queue that replays from block start, which should
+// be the pool deployment block.
+func New(start uint64) *Queue {
+	return &Queue{
+		Accounts:       make(map[common.Address]*Account),
+		TotalPending:   new(big.Int),
+		TotalClaimable: new(big.Int),
+		Next:           start,
+	}
+}
+
+// Rebuild replays the pool events from start to end.
+func Rebuild(ctx context.Context, pool *restakingpool.Contract, start, end uint64) (*Queue, error) {
+	q := New(start)
+	if err := q.Sync(ctx, pool, end); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// Sync replays the events from q.Next to end.
+func (q *Queue) Sync(ctx context.Context, pool *restakingpool.Contract, end uint64) error {
+	if end < q.Next {
+		return nil
+	}
+	events, err := fetch(ctx, pool, q.Next, end)
+	if err != nil {
+		return err
+	}
+	for _, e := range events {
+		q.apply(e)
+	}
+	q.Next = end + 1
+	return nil
+}
+
+// Pending returns the requests that are not distributed yet.
+func (q *Queue) Pending() []*Request {
+	var out []*Request
+	for _, r := range q.Requests[q.Gap:] {
+		if !r.Distributed {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// Account returns the state of addr, which is empty for unknown accounts.
+func (q *Queue) Account(addr common.Address) *Account {
+	if a, ok := q.Accounts[addr]; ok {
+		return a
+	}
+	return &Account{Pending: new(big.Int), Claimable: new(big.Int)}
+}
+
+func (q *Queue) account(addr common.Address) *Account {
+	a, ok := q.Accounts[addr]
+	if !ok {
+		a = &Account{Pending: new(big.Int), Claimable: new(big.Int)}
+		q.Accounts[addr] = a
+	}
+	return a
+}
+
+func (q *Queue) issue(e event, account common.Address, index int, want, got *big.Int, format string, args ...interface{}) {
+	q.Issues = append(q.Issues, Discrepancy{
+		Check:   string(e.kind),
+		Account: account,
+		Index:   index,
+		Want:    want,
+		Got:     got,
+		Tx:      e.log.TxHash,
+		Block:   e.log.BlockNumber,
+		Detail:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (q *Queue) apply(e event) {
+	switch e.kind {
+	case kindUnstaked, kindPendingUnstake:
+		q.enqueue(e)
+	case kindClaimExpected:
+		q.distribute(e, e.recipient, e.amount)
+	case kindUnstakesDistributed:
+		for _, u := range e.distributed {
+			q.distribute(e, u.Recipient, u.Amount)
+		}
+	case kindUnstakeClaimed:
+		q.claim(e)
+	}
+}
+
+func (q *Queue) enqueue(e event) {
+	r := &Request{
+		Index:     len(q.Requests),
+		Owner:     e.owner,
+		Recipient: e.recipient,
+		Amount:    e.amount,
+		Shares:    e.shares,
+		Tx:        e.log.TxHash,
+		Block:     e.log.BlockNumber,
+	}
+	q.Requests = append(q.Requests, r)
+	a := q.account(r.Recipient)
+	a.Pending.Add(a.Pending, r.Amount)
+	a.LastTx = r.Tx
+	q.TotalPending.Add(q.TotalPending, r.Amount)
+	q.lastPendingTx = r.Tx
+}
+
+// distribute pays the head of the queue, which must be the unstake of
+// recipient and amount since the pool pays in order. A payment that does
+// not match the head is reported and applied to the first pending request
+// it matches, if any.
+func (q *Queue) distribute(e event, recipient common.Address, amount *big.Int) {
+	matches := func(r *Request) bool {
+		return !r.Distributed && r.Recipient == recipient && r.Amount.Cmp(amount) == 0
+	}
+	var r *Request
+	if q.Gap == len(q.Requests) {
+		q.issue(e, recipient, -1, nil, amount, "paid %s to %s with an empty queue", amount, recipient)
+	} else if head := q.Requests[q.Gap]; matches(head) {
+		r = head
+	} else {
+		q.issue(e, recipient, head.Index, head.Amount, amount,
+			"paid %s to %s but the head of the queue is request %d of %s to %s", amount, recipient, head.Index, head.Amount, head.Recipient)
+		for _, pending := range q.Requests[q.Gap+1:] {
+			if matches(pending) {
+				r = pending
+				break
+			}
+		}
+	}
+
+	if r != nil {
+		r.Distributed, r.DistributedTx, r.DistributedBlock = true, e.log.TxHash, e.log.BlockNumber
+		pending := q.account(r.Recipient)
+		pending.Pending.Sub(pending.Pending, r.Amount)
+		q.TotalPending.Sub(q.TotalPending, r.Amount)
+		q.lastPendingTx = e.log.TxHash
+		for q.Gap < len(q.Requests) && q.Requests[q.Gap].Distributed {
+			q.Gap++
+		}
+		pending.unclaimed = append(pending.unclaimed, r)
+	}
+
+	a := q.account(recipient)
+	a.Claimable.Add(a.Claimable, amount)
+	a.LastTx = e.log.TxHash
+	q.TotalClaimable.Add(q.TotalClaimable, amount)
+	q.lastClaimableTx = e.log.TxHash
+
+	var d *Distribution
+	if n := len(q.Distributions); n > 0 && q.Distributions[n-1].Tx == e.log.TxHash {
+		d = q.Distributions[n-1]
+	} else {
+		d = &Distribution{Tx: e.log.TxHash, Block: e.log.BlockNumber, Amount: new(big.Int)}
+		q.Distributions = append(q.Distributions, d)
+	}
+	if r != nil {
+		d.Requests = append(d.Requests, r)
+	}
+	d.Amount.Add(d.Amount, amount)
+}
+
+func (q *Queue) claim(e event) {
+	a := q.account(e.recipient)
+	if a.Claimable.Cmp(e.amount) != 0 {
+		q.issue(e, e.recipient, -1, a.Claimable, e.amount,
+			"claimed %s while %s was claimable", e.amount, a.Claimable)
+	}
+	c := &Claim{
+		Claimer: e.recipient,
+		Caller:  e.owner,
+		Amount:  e.amount,
+		Tx:      e.log.TxHash,
+		Block:   e.log.BlockNumber,
+	}
+	for _, r := range a.unclaimed {
+		r.Claim = c
+	}
+	a.Claimable = new(big.Int)
+	a.Claims = append(a.Claims, c)
+	a.unclaimed = nil
+	a.LastTx = e.log.TxHash
+	q.TotalClaimable.Sub(q.TotalClaimable, e.amount)
+	q.lastClaimableTx = e.log.TxHash
+}
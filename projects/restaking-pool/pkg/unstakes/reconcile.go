@@ -0,0 +1,192 @@
+package unstakes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+// Discrepancy is a difference between the replayed state and the pool, or
+// an inconsistent event found while replaying.
+type Discrepancy struct {
+	// Check is the view that disagrees, or the event that could not be
+	// applied.
+	Check   string
+	Account common.Address
+	// Index is the position in the pool queue array, -1 when the
+	// discrepancy is not about a single request.
+	Index int
+	// Want is the pool value and Got the replayed one. Either is nil when
+	// the value does not exist on that side.
+	Want, Got *big.Int
+	// Tx is the transaction that caused the discrepancy: the event being
+	// replayed, or the last transaction that changed the replayed value.
+	Tx     common.Hash
+	Block  uint64
+	Detail string
+}
+
+func (d Discrepancy) String() string {
+	s := d.Check
+	if d.Account != (common.Address{}) {
+		s += " " + d.Account.Hex()
+	}
+	if d.Index >= 0 {
+		s += fmt.Sprintf(" #%d", d.Index)
+	}
+	if d.Detail != "" {
+		s += ": " + d.Detail
+	} else {
+		s += fmt.Sprintf(": pool %v, replayed %v", d.Want, d.Got)
+	}
+	if d.Tx != (common.Hash{}) {
+		s += fmt.Sprintf(" (tx %s, block %d)", d.Tx.Hex(), d.Block)
+	}
+	return s
+}
+
+// Reconcile compares q with the pool views at the last replayed block and
+// returns the replay issues followed by every view that disagrees.
+// Accounts lists additional accounts to check besides the ones seen in
+// events and in the pool queue.
+func Reconcile(ctx context.Context, pool *restakingpool.Contract, q *Queue, accounts ...common.Address) ([]Discrepancy, error) {
+	if q.Next == 0 {
+		return nil, errors.New("queue has not been synced")
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(q.Next - 1)}
+	out := append([]Discrepancy(nil), q.Issues...)
+	blockOf := q.blockOf()
+
+	total := func(check string, get func(*bind.CallOpts) (*big.Int, error), got *big.Int, tx common.Hash) error {
+		want, err := get(opts)
+		if err != nil {
+			return errors.Wrap(err, check)
+		}
+		if want.Cmp(got) != 0 {
+			out = append(out, Discrepancy{Check: check, Index: -1, Want: want, Got: got, Tx: tx, Block: blockOf[tx]})
+		}
+		return nil
+	}
+	if err := total("getTotalPendingUnstakes", pool.GetTotalPendingUnstakes, q.TotalPending, q.lastPendingTx); err != nil {
+		return nil, err
+	}
+	if err := total("getTotalClaimable", pool.GetTotalClaimable, q.TotalClaimable, q.lastClaimableTx); err != nil {
+		return nil, err
+	}
+
+	unstakes, err := pool.GetUnstakes(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getUnstakes")
+	}
+	pending := q.Pending()
+	out = append(out, compareQueue("getUnstakes", unstakes, pending)...)
+
+	seen := make(map[common.Address]bool)
+	var all []common.Address
+	addAccount := func(a common.Address) {
+		if !seen[a] {
+			seen[a] = true
+			all = append(all, a)
+		}
+	}
+	for a := range q.Accounts {
+		addAccount(a)
+	}
+	for _, u := range unstakes {
+		addAccount(u.Recipient)
+	}
+	for _, a := range accounts {
+		addAccount(a)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Hex() < all[j].Hex() })
+
+	for _, addr := range all {
+		a := q.Account(addr)
+		block := blockOf[a.LastTx]
+		want, err := pool.GetTotalUnstakesOf(opts, addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "getTotalUnstakesOf")
+		}
+		if want.Cmp(a.Pending) != 0 {
+			out = append(out, Discrepancy{Check: "getTotalUnstakesOf", Account: addr, Index: -1, Want: want, Got: a.Pending, Tx: a.LastTx, Block: block})
+		}
+		if want, err = pool.ClaimableOf(opts, addr); err != nil {
+			return nil, errors.Wrap(err, "claimableOf")
+		}
+		if want.Cmp(a.Claimable) != 0 {
+			out = append(out, Discrepancy{Check: "claimableOf", Account: addr, Index: -1, Want: want, Got: a.Claimable, Tx: a.LastTx, Block: block})
+		}
+		has, err := pool.HasClaimable(opts, addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "hasClaimable")
+		}
+		if has != (a.Claimable.Sign() > 0) {
+			out = append(out, Discrepancy{Check: "hasClaimable", Account: addr, Index: -1, Tx: a.LastTx, Block: block,
+				Detail: fmt.Sprintf("pool %t, replayed claimable %s", has, a.Claimable)})
+		}
+		of, err := pool.GetUnstakesOf(opts, addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "getUnstakesOf")
+		}
+		var mine []*Request
+		for _, r := range pending {
+			if r.Recipient == addr {
+				mine = append(mine, r)
+			}
+		}
+		for _, d := range compareQueue("getUnstakesOf", of, mine) {
+			d.Account = addr
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+// compareQueue compares a queue view with the replayed requests it should
+// list, in order.
+func compareQueue(check string, want []restakingpool.IRestakingPoolUnstake, got []*Request) []Discrepancy {
+	var out []Discrepancy
+	for i := 0; i < len(want) || i < len(got); i++ {
+		switch {
+		case i >= len(got):
+			out = append(out, Discrepancy{Check: check, Account: want[i].Recipient, Index: -1, Want: want[i].Amount,
+				Detail: fmt.Sprintf("pool has %s to %s at position %d with no replayed request", want[i].Amount, want[i].Recipient, i)})
+		case i >= len(want):
+			r := got[i]
+			out = append(out, Discrepancy{Check: check, Account: r.Recipient, Index: r.Index, Got: r.Amount, Tx: r.Tx, Block: r.Block,
+				Detail: fmt.Sprintf("replayed request of %s to %s is not in the pool queue", r.Amount, r.Recipient)})
+		case want[i].Recipient != got[i].Recipient || want[i].Amount.Cmp(got[i].Amount) != 0:
+			r := got[i]
+			out = append(out, Discrepancy{Check: check, Account: r.Recipient, Index: r.Index, Want: want[i].Amount, Got: r.Amount, Tx: r.Tx, Block: r.Block,
+				Detail: fmt.Sprintf("pool has %s to %s, replayed %s to %s", want[i].Amount, want[i].Recipient, r.Amount, r.Recipient)})
+		}
+	}
+	return out
+}
+
+// blockOf maps the transactions of q to their blocks.
+func (q *Queue) blockOf() map[common.Hash]uint64 {
+	blocks := make(map[common.Hash]uint64)
+	for _, r := range q.Requests {
+		blocks[r.Tx] = r.Block
+		if r.Distributed {
+			blocks[r.DistributedTx] = r.DistributedBlock
+		}
+	}
+	for _, d := range q.Distributions {
+		blocks[d.Tx] = d.Block
+	}
+	for _, a := range q.Accounts {
+		for _, c := range a.Claims {
+			blocks[c.Tx] = c.Block
+		}
+	}
+	return blocks
+}
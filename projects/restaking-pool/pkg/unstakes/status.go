@@ -0,0 +1,241 @@
+package unstakes
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+// CadenceWindow is the number of recent distributions the throughput is
+// measured over.
+var CadenceWindow = 8
+
+// HeaderReader reads block headers, for the distribution timestamps.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// State is the stage of an unstake request.
+type State string
+
+const (
+	// StatePending is a request still in the pool queue.
+	StatePending State = "pending"
+	// StateClaimable is a distributed request the recipient has not
+	// claimed yet.
+	StateClaimable State = "claimable"
+	// StateClaimed is a distributed request that was claimed.
+	StateClaimed State = "claimed"
+)
+
+// RequestStatus is the status of one unstake request of the user.
+type RequestStatus struct {
+	State  State
+	Amount *big.Int
+	// Request is the replayed request. It is nil for a pending request the
+	// replayed queue does not know, which means q is behind the pool.
+	Request *Request
+
+	// Position is the place of a pending request in the pool queue, 0 for
+	// the head, and Ahead the amount queued before it.
+	Position int
+	Ahead    *big.Int
+	// ETA estimates when a pending request becomes claimable. It is nil
+	// when there is not enough distribution history to tell.
+	ETA *Estimate
+
+	// ClaimTx is the claim of a claimed request.
+	ClaimTx common.Hash
+}
+
+// Estimate is when a pending request is expected to be distributed.
+type Estimate struct {
+	At time.Time
+	// Funded reports the free balance already covers the request and the
+	// ones ahead of it, so the next distribution pays it.
+	Funded bool
+	// Shortfall is the free balance still missing to pay the request.
+	Shortfall *big.Int
+}
+
+// Throughput is the recent distribution history.
+type Throughput struct {
+	// Distributions is the number of distributions measured, from First to
+	// Last.
+	Distributions int
+	First, Last   time.Time
+	// Amount is what the distributions after First paid, over the period
+	// from First to Last.
+	Amount *big.Int
+	// Cadence is the mean time between distributions.
+	Cadence time.Duration
+}
+
+// Status answers where the unstakes of a recipient are.
+type Status struct {
+	User  common.Address
+	Block uint64
+	Time  time.Time
+
+	// Pending is the sum of getUnstakesOf, Claimable is claimableOf and
+	// HasClaimable is hasClaimable.
+	Pending      *big.Int
+	Claimable    *big.Int
+	HasClaimable bool
+	// FreeBalance is getFreeBalance, what the next distribution can pay.
+	FreeBalance *big.Int
+	// Throughput is nil until the pool has distributed twice.
+	Throughput *Throughput
+
+	// Requests lists the claimed and claimable requests in queue order,
+	// followed by the pending ones.
+	Requests []RequestStatus
+}
+
+// UnstakeStatus combines the pool views at the last replayed block of q
+// with the replayed history to tell where the unstakes to user are. q should
+// be synced close to the head for the ETA to be meaningful.
+func UnstakeStatus(ctx context.Context, backend HeaderReader, pool *restakingpool.Contract, q *Queue, user common.Address) (*Status, error) {
+	if q.Next == 0 {
+		return nil, errors.New("queue has not been synced")
+	}
+	block := q.Next - 1
+	number := new(big.Int).SetUint64(block)
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: number}
+	header, err := backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get header")
+	}
+	s := &Status{User: user, Block: block, Time: blockTime(header), Pending: new(big.Int)}
+
+	of, err := pool.GetUnstakesOf(opts, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "getUnstakesOf")
+	}
+	for _, u := range of {
+		s.Pending.Add(s.Pending, u.Amount)
+	}
+	if s.Claimable, err = pool.ClaimableOf(opts, user); err != nil {
+		return nil, errors.Wrap(err, "claimableOf")
+	}
+	if s.HasClaimable, err = pool.HasClaimable(opts, user); err != nil {
+		return nil, errors.Wrap(err, "hasClaimable")
+	}
+	if s.FreeBalance, err = pool.GetFreeBalance(opts); err != nil {
+		return nil, errors.Wrap(err, "getFreeBalance")
+	}
+	queue, err := pool.GetUnstakes(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getUnstakes")
+	}
+	if s.Throughput, err = q.throughput(ctx, backend); err != nil {
+		return nil, err
+	}
+
+	for _, r := range q.Requests {
+		if r.Recipient != user || !r.Distributed {
+			continue
+		}
+		rs := RequestStatus{State: StateClaimable, Amount: r.Amount, Request: r}
+		if r.Claim != nil {
+			rs.State, rs.ClaimTx = StateClaimed, r.Claim.Tx
+		}
+		s.Requests = append(s.Requests, rs)
+	}
+
+	pending := q.Pending()
+	ahead := new(big.Int)
+	position, found := 0, 0
+	for i, u := range queue {
+		// distributeUnstakes skips the empty slots
+		if u.Recipient == (common.Address{}) || u.Amount.Sign() == 0 {
+			continue
+		}
+		if u.Recipient == user {
+			rs := RequestStatus{State: StatePending, Amount: u.Amount, Position: position, Ahead: new(big.Int).Set(ahead)}
+			if i < len(pending) && pending[i].Recipient == u.Recipient && pending[i].Amount.Cmp(u.Amount) == 0 {
+				rs.Request = pending[i]
+			}
+			rs.ETA = s.estimate(new(big.Int).Add(ahead, u.Amount))
+			s.Requests = append(s.Requests, rs)
+			found++
+		}
+		ahead.Add(ahead, u.Amount)
+		position++
+	}
+	if found != len(of) {
+		return nil, errors.Errorf("getUnstakes lists %d unstakes of %s, getUnstakesOf %d", found, user.Hex(), len(of))
+	}
+	return s, nil
+}
+
+// estimate tells when the free balance covers need, the amount queued up to
+// and including a request. A covered request is paid by the next
+// distribution, expected one cadence after the last one. Otherwise the
+// shortfall is assumed to come in at the recent distribution throughput.
+func (s *Status) estimate(need *big.Int) *Estimate {
+	t := s.Throughput
+	if t == nil {
+		return nil
+	}
+	next := t.Last.Add(t.Cadence)
+	if next.Before(s.Time) {
+		next = s.Time
+	}
+	if s.FreeBalance.Cmp(need) >= 0 {
+		return &Estimate{At: next, Funded: true, Shortfall: new(big.Int)}
+	}
+	shortfall := new(big.Int).Sub(need, s.FreeBalance)
+	if t.Amount.Sign() == 0 {
+		return nil
+	}
+	wait := new(big.Int).Mul(shortfall, big.NewInt(int64(t.Last.Sub(t.First))))
+	wait.Div(wait, t.Amount)
+	if !wait.IsInt64() {
+		return nil
+	}
+	at := s.Time.Add(time.Duration(wait.Int64()))
+	if at.Before(next) {
+		at = next
+	}
+	return &Estimate{At: at, Shortfall: shortfall}
+}
+
+// throughput measures the last CadenceWindow distributions.
+func (q *Queue) throughput(ctx context.Context, backend HeaderReader) (*Throughput, error) {
+	recent := q.Distributions
+	if len(recent) > CadenceWindow {
+		recent = recent[len(recent)-CadenceWindow:]
+	}
+	if len(recent) < 2 {
+		return nil, nil
+	}
+	t := &Throughput{Distributions: len(recent), Amount: new(big.Int)}
+	for i, d := range []*Distribution{recent[0], recent[len(recent)-1]} {
+		header, err := backend.HeaderByNumber(ctx, new(big.Int).SetUint64(d.Block))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get header of distribution %s", d.Tx.Hex())
+		}
+		if i == 0 {
+			t.First = blockTime(header)
+		} else {
+			t.Last = blockTime(header)
+		}
+	}
+	for _, d := range recent[1:] {
+		t.Amount.Add(t.Amount, d.Amount)
+	}
+	t.Cadence = t.Last.Sub(t.First) / time.Duration(len(recent)-1)
+	return t, nil
+}
+
+func blockTime(header *types.Header) time.Time {
+	return time.Unix(int64(header.Time), 0).UTC()
+}
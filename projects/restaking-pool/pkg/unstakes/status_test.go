@@ -0,0 +1,104 @@
+package unstakes
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestUnstakeStatus(t *testing.T) {
+	f, first := history(t)
+	ctx := context.Background()
+	pool := f.RestakingPool
+	u0, u1, u2 := f.Users[0], f.Users[1], f.Users[2]
+	q, err := Rebuild(ctx, pool, 0, latest(t, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := UnstakeStatus(ctx, f.Backend, pool, q, u0.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claim := q.Account(u0.Address).Claims[0].Tx
+	if len(s.Requests) != 2 || s.Throughput != nil || s.HasClaimable {
+		t.Fatalf("user0 %+v", s)
+	}
+	if r := s.Requests[0]; r.State != StateClaimed || r.ClaimTx != claim || r.Request.Tx != first {
+		t.Fatalf("first request %+v", r)
+	}
+	if r := s.Requests[1]; r.State != StatePending || r.Position != 1 || r.Ahead.Cmp(eth(5)) != 0 || r.ETA != nil {
+		t.Fatalf("second request %+v", r)
+	}
+
+	s, err = UnstakeStatus(ctx, f.Backend, pool, q, u2.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Requests) != 2 || s.Requests[0].State != StateClaimable || s.Claimable.Cmp(eth(5)) != 0 || !s.HasClaimable {
+		t.Fatalf("user2 %+v", s)
+	}
+	if r := s.Requests[1]; r.State != StatePending || r.Position != 0 || r.Ahead.Sign() != 0 || r.Amount.Cmp(eth(5)) != 0 {
+		t.Fatalf("user2 pending %+v", r)
+	}
+
+	// a second distribution gives a throughput of 7 ETH over its distance to
+	// the first one
+	if _, err := f.Send(pool.SetTargetFlashCapacity(f.Governance.TransactOpts(), 0)); err != nil {
+		t.Fatal(err)
+	}
+	opts := f.Operator.TransactOpts()
+	opts.GasLimit = 5_000_000
+	if _, err := f.Send(pool.DistributeUnstakes(opts)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Send(pool.SetTargetFlashCapacity(f.Governance.TransactOpts(), 200e8)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Send(pool.Unstake(u1.TransactOpts(), u1.Address, eth(3))); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Sync(ctx, pool, latest(t, f)); err != nil {
+		t.Fatal(err)
+	}
+	s, err = UnstakeStatus(ctx, f.Backend, pool, q, u1.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tp := s.Throughput
+	if tp == nil || tp.Distributions != 2 || tp.Amount.Cmp(eth(7)) != 0 || tp.Cadence != tp.Last.Sub(tp.First) {
+		t.Fatalf("throughput %+v", tp)
+	}
+	if len(s.Requests) != 1 || s.FreeBalance.Sign() != 0 {
+		t.Fatalf("user1 %+v", s)
+	}
+	eta := s.Requests[0].ETA
+	if eta == nil || eta.Funded || eta.Shortfall.Cmp(eth(3)) != 0 {
+		t.Fatalf("estimate %+v", eta)
+	}
+	// 3 of the 7 ETH the pool distributes per cadence, but not before the
+	// next distribution
+	want := s.Time.Add(tp.Cadence * 3 / 7)
+	if next := tp.Last.Add(tp.Cadence); want.Before(next) {
+		want = next
+	}
+	if eta.At.Sub(want) > time.Second || want.Sub(eta.At) > time.Second {
+		t.Fatalf("estimated %s, want about %s", eta.At, want)
+	}
+
+	// once the free balance covers it, the request waits for the next
+	// distribution
+	if _, err := f.Send(pool.SetTargetFlashCapacity(f.Governance.TransactOpts(), 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Sync(ctx, pool, latest(t, f)); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = UnstakeStatus(ctx, f.Backend, pool, q, u1.Address); err != nil {
+		t.Fatal(err)
+	}
+	eta = s.Requests[0].ETA
+	if next := s.Throughput.Last.Add(s.Throughput.Cadence); eta == nil || !eta.Funded || eta.At.Before(next) || eta.At.Before(s.Time) {
+		t.Fatalf("funded estimate %+v", eta)
+	}
+}
@@ -0,0 +1,175 @@
+package unstakes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/fixture"
+)
+
+func eth(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), fixture.Ether)
+}
+
+// history stakes, queues three unstakes of which two get distributed, claims
+// one of them and queues another. It returns the transaction of the first
+// unstake.
+func history(t *testing.T) (*fixture.Fixture, common.Hash) {
+	t.Helper()
+	options := fixture.DefaultOptions()
+	options.Users = 3
+	f, err := fixture.New(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	pool := f.RestakingPool
+	u0, u1, u2 := f.Users[0], f.Users[1], f.Users[2]
+	send := func(tx *types.Transaction, err error) common.Hash {
+		t.Helper()
+		receipt, err := f.Send(tx, err)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt.TxHash
+	}
+	for _, u := range f.Users {
+		send(pool.Stake(u.WithValue(eth(10))))
+	}
+	// a target capacity above 100% of the assets leaves room to pay only
+	// two of the three unstakes
+	send(pool.SetTargetFlashCapacity(f.Governance.TransactOpts(), 110e8))
+	first := send(pool.Unstake(u0.TransactOpts(), u0.Address, eth(5)))
+	send(pool.Unstake(u1.TransactOpts(), u2.Address, eth(5)))
+	send(pool.Unstake(u2.TransactOpts(), u2.Address, eth(5)))
+	opts := f.Operator.TransactOpts()
+	opts.GasLimit = 5_000_000
+	send(pool.DistributeUnstakes(opts))
+	send(pool.ClaimUnstake(u1.TransactOpts(), u0.Address))
+	send(pool.Unstake(u0.TransactOpts(), u0.Address, eth(2)))
+	return f, first
+}
+
+func latest(t *testing.T, f *fixture.Fixture) uint64 {
+	t.Helper()
+	header, err := f.Backend.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return header.Number.Uint64()
+}
+
+func TestRebuildReconciles(t *testing.T) {
+	f, _ := history(t)
+	ctx := context.Background()
+	q, err := Rebuild(ctx, f.RestakingPool, 0, latest(t, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diffs, err := Reconcile(ctx, f.RestakingPool, q, f.Users[1].Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range diffs {
+		t.Error(d)
+	}
+
+	u0, u2 := f.Users[0].Address, f.Users[2].Address
+	if len(q.Requests) != 4 || q.Gap != 2 || len(q.Pending()) != 2 {
+		t.Fatalf("%d requests with gap %d, %d pending", len(q.Requests), q.Gap, len(q.Pending()))
+	}
+	if len(q.Distributions) != 1 || len(q.Distributions[0].Requests) != 2 || q.Distributions[0].Amount.Cmp(eth(10)) != 0 {
+		t.Fatalf("distributions %+v", q.Distributions)
+	}
+	if a := q.Account(u0); len(a.Claims) != 1 || a.Claims[0].Caller != f.Users[1].Address || a.Claimable.Sign() != 0 || a.Pending.Cmp(eth(2)) != 0 {
+		t.Fatalf("user0 %+v", a)
+	}
+	if a := q.Account(u2); a.Claimable.Cmp(eth(5)) != 0 || a.Pending.Cmp(eth(5)) != 0 {
+		t.Fatalf("user2 %+v", a)
+	}
+
+	// syncing in two steps gives the same state. The simulated backend
+	// only serves calls on the latest block, so the intermediate state is
+	// not reconciled.
+	split := New(0)
+	if err := split.Sync(ctx, f.RestakingPool, q.Requests[2].Block); err != nil {
+		t.Fatal(err)
+	}
+	if err := split.Sync(ctx, f.RestakingPool, latest(t, f)); err != nil {
+		t.Fatal(err)
+	}
+	if split.TotalPending.Cmp(q.TotalPending) != 0 || split.TotalClaimable.Cmp(q.TotalClaimable) != 0 || split.Gap != q.Gap {
+		t.Fatal("incremental sync differs from a full rebuild")
+	}
+}
+
+func TestReconcileReportsTx(t *testing.T) {
+	f, first := history(t)
+	ctx := context.Background()
+	end := latest(t, f)
+	events, err := fetch(ctx, f.RestakingPool, 0, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// corrupt the amount of the first unstake
+	q := New(0)
+	for _, e := range events {
+		if e.log.TxHash == first && e.kind == kindUnstaked {
+			e.amount = eth(4)
+		}
+		q.apply(e)
+	}
+	q.Next = end + 1
+
+	diffs, err := Reconcile(ctx, f.RestakingPool, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var issue, pending bool
+	for _, d := range diffs {
+		switch {
+		case d.Check == string(kindClaimExpected) && d.Index == 0:
+			// the distribution no longer matches the head of the queue
+			issue = true
+		case d.Check == "getTotalUnstakesOf" && d.Account == f.Users[0].Address:
+			pending = d.Want.Cmp(eth(2)) == 0 && d.Got.Cmp(eth(6)) == 0
+		}
+	}
+	if !issue || !pending {
+		for _, d := range diffs {
+			t.Log(d)
+		}
+		t.Fatalf("corrupted unstake %s not reported", first.Hex())
+	}
+	for _, d := range diffs {
+		if d.Index == 0 && d.Tx != first && d.Check != string(kindClaimExpected) {
+			t.Errorf("discrepancy of request 0 blames %s, want %s: %s", d.Tx.Hex(), first.Hex(), d)
+		}
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	tx := common.HexToHash("0x01")
+	recipient := common.HexToAddress("0x02")
+	events := []event{
+		{kind: kindPendingUnstake, log: types.Log{TxHash: tx, Index: 0}, recipient: recipient, amount: eth(1)},
+		{kind: kindUnstaked, log: types.Log{TxHash: tx, Index: 1}, recipient: recipient, amount: eth(1)},
+		{kind: kindClaimExpected, log: types.Log{TxHash: common.HexToHash("0x03"), Index: 2}, recipient: recipient, amount: eth(1)},
+		{kind: kindUnstakesDistributed, log: types.Log{TxHash: common.HexToHash("0x03"), Index: 3}},
+	}
+	got := dedupe(events)
+	if len(got) != 2 || got[0].kind != kindUnstaked || got[1].kind != kindClaimExpected {
+		t.Fatalf("dedupe kept %+v", got)
+	}
+	q := New(0)
+	for _, e := range got {
+		q.apply(e)
+	}
+	if len(q.Issues) != 0 || q.TotalPending.Sign() != 0 || q.TotalClaimable.Cmp(eth(1)) != 0 {
+		t.Fatalf("issues %v, pending %s, claimable %s", q.Issues, q.TotalPending, q.TotalClaimable)
+	}
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DeadLetter is a delivery that failed.
+type DeadLetter struct {
+	Time     time.Time `json:"time"`
+	Endpoint string    `json:"endpoint"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	Payload  *Payload  `json:"payload"`
+}
+
+// deadLetter appends l to the dead-letter file and syncs it, since the
+// event is considered handled once this returns.
+func (d *Dispatcher) deadLetter(l DeadLetter) error {
+	if d.cfg.DeadLetter == "" {
+		return errors.Errorf("could not deliver %s to %s: %s", l.Payload.ID, l.Endpoint, l.Error)
+	}
+	data, err := json.Marshal(l)
+	if err != nil {
+		return errors.Wrap(err, "could not encode dead letter")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f, err := os.OpenFile(d.cfg.DeadLetter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "could not open dead-letter file")
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return errors.Wrap(err, "could not write dead letter")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "could not write dead letter")
+	}
+	return errors.Wrap(f.Close(), "could not write dead letter")
+}
+
+// ReadDeadLetters reads a dead-letter file. A missing file has no letters.
+func ReadDeadLetters(path string) ([]DeadLetter, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read dead letters")
+	}
+	var letters []DeadLetter
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1<<24)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var l DeadLetter
+		if err := json.Unmarshal(scanner.Bytes(), &l); err != nil {
+			return nil, errors.Wrapf(err, "dead letter on line %d", line)
+		}
+		letters = append(letters, l)
+	}
+	return letters, scanner.Err()
+}
+
+// Redrive sends every dead letter once more and rewrites the file with the
+// ones that still fail, or whose endpoint is no longer configured. It
+// returns the number of letters delivered and left.
+func (d *Dispatcher) Redrive(ctx context.Context) (delivered, left int, err error) {
+	if d.cfg.DeadLetter == "" {
+		return 0, 0, nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	letters, err := ReadDeadLetters(d.cfg.DeadLetter)
+	if err != nil || len(letters) == 0 {
+		return 0, 0, err
+	}
+	endpoints := make(map[string]*Endpoint)
+	for i := range d.cfg.Endpoints {
+		endpoints[d.cfg.Endpoints[i].Name] = &d.cfg.Endpoints[i]
+	}
+
+	var remaining []DeadLetter
+	for _, l := range letters {
+		e, ok := endpoints[l.Endpoint]
+		if !ok || ctx.Err() != nil {
+			remaining = append(remaining, l)
+			continue
+		}
+		body, err := json.Marshal(l.Payload)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "could not encode dead letter")
+		}
+		if _, err := d.send(ctx, e, l.Payload.ID, body); err != nil {
+			l.Time, l.Error = d.now().UTC(), err.Error()
+			l.Attempts++
+			remaining = append(remaining, l)
+			continue
+		}
+		delivered++
+	}
+	return delivered, len(remaining), d.rewrite(remaining)
+}
+
+// rewrite replaces the dead-letter file through a temporary file, so a
+// crash never loses letters.
+func (d *Dispatcher) rewrite(letters []DeadLetter) error {
+	var buf bytes.Buffer
+	for _, l := range letters {
+		data, err := json.Marshal(l)
+		if err != nil {
+			return errors.Wrap(err, "could not encode dead letter")
+		}
+		buf.Write(append(data, '\n'))
+	}
+	path := d.cfg.DeadLetter
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "could not write dead letters")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write dead letters")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write dead letters")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), path), "could not write dead letters")
+}
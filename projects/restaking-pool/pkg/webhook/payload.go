@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+)
+
+// Headers of a delivery.
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	HeaderTimestamp      = "X-Webhook-Timestamp"
+	HeaderSignature      = "X-Webhook-Signature"
+)
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	// ID identifies the log, and whether it was removed, across retries and
+	// restarts; it is also sent as the Idempotency-Key header.
+	ID          string         `json:"id"`
+	Event       string         `json:"event"`
+	Address     common.Address `json:"address"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	LogIndex    uint           `json:"logIndex"`
+	// Removed is set when a reorg dropped a log delivered before.
+	Removed bool                   `json:"removed"`
+	Args    map[string]interface{} `json:"args"`
+}
+
+// NewPayload returns the payload of a decoded event, one of the
+// Contract<Event> structs of the generated wrappers.
+func NewPayload(event generated.AbigenLog) (*Payload, error) {
+	v := reflect.ValueOf(event)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, errors.Errorf("%T is not a generated event", event)
+	}
+	raw, ok := v.FieldByName("Raw").Interface().(types.Log)
+	if !ok {
+		return nil, errors.Errorf("%T has no raw log", event)
+	}
+	p := &Payload{
+		ID:          ID(raw),
+		Event:       EventName(event),
+		Address:     raw.Address,
+		BlockNumber: raw.BlockNumber,
+		BlockHash:   raw.BlockHash,
+		TxHash:      raw.TxHash,
+		LogIndex:    raw.Index,
+		Removed:     raw.Removed,
+		Args:        make(map[string]interface{}),
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Name == "Raw" || !field.IsExported() {
+			continue
+		}
+		p.Args[lowerFirst(field.Name)] = jsonValue(v.Field(i).Interface())
+	}
+	return p, nil
+}
+
+// ID returns the idempotency key of a log: its block hash and index, with a
+// suffix for removed logs.
+func ID(l types.Log) string {
+	id := fmt.Sprintf("%s-%d", l.BlockHash.Hex(), l.Index)
+	if l.Removed {
+		id += "-removed"
+	}
+	return id
+}
+
+// EventName returns the event name of a generated event struct, Staked for
+// *restakingpool.ContractStaked.
+func EventName(event generated.AbigenLog) string {
+	t := reflect.TypeOf(event)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return strings.TrimPrefix(t.Name(), "Contract")
+}
+
+// jsonValue encodes big and 64-bit integers as decimal strings, which
+// JavaScript receivers read without losing precision, and bytes as hex.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	case []byte:
+		return hexutil.Bytes(v)
+	case [32]byte:
+		return common.Hash(v)
+	case uint64, int64:
+		return fmt.Sprint(v)
+	}
+	return v
+}
+
+func lowerFirst(s string) string {
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[n:]
+}
+
+// Sign returns the signature header of body sent at timestamp, the hex
+// HMAC-SHA256 of "<timestamp>.<body>" with the endpoint secret.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature header of a delivery in constant time.
+// Receivers should also reject old timestamps to prevent replays.
+func Verify(secret []byte, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,262 @@
+// Package webhook pushes decoded protocol events to HTTP endpoints.
+//
+// Every event is POSTed as a JSON Payload signed with the endpoint secret
+// (see Sign). Failed deliveries are retried with an exponential backoff;
+// those that still fail, or that the endpoint rejects with a client error,
+// are appended to a dead-letter file that Redrive sends again. Deliver only
+// returns once every event was delivered or dead-lettered, so used as the
+// handler of an indexer, which commits after its handler, events are
+// delivered at least once. Receivers deduplicate them with the
+// Idempotency-Key header.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+)
+
+// DefaultEvents are the events an endpoint without an event filter receives.
+var DefaultEvents = []string{"Staked", "Unstaked", "FlashUnstaked", "UnstakeClaimed", "RatioUpdated", "FeeClaimed"}
+
+// Endpoint is a receiver of events.
+type Endpoint struct {
+	// Name identifies the endpoint in the dead-letter file.
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Secret string `json:"secret"`
+	// Events lists the event names delivered, DefaultEvents when empty.
+	Events []string `json:"events,omitempty"`
+	// Addresses lists the contracts whose events are delivered, all when
+	// empty.
+	Addresses []common.Address `json:"addresses,omitempty"`
+}
+
+// Match reports whether the endpoint receives p.
+func (e *Endpoint) Match(p *Payload) bool {
+	events := e.Events
+	if len(events) == 0 {
+		events = DefaultEvents
+	}
+	if !contains(events, p.Event) {
+		return false
+	}
+	if len(e.Addresses) == 0 {
+		return true
+	}
+	for _, a := range e.Addresses {
+		if a == p.Address {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Config configures a Dispatcher.
+type Config struct {
+	Endpoints []Endpoint
+	// DeadLetter is the file failed deliveries are appended to as JSON
+	// lines. Without it a failed delivery fails Deliver.
+	DeadLetter string
+	// Client sends the requests, a client with a 10 second timeout by
+	// default.
+	Client *http.Client
+	// Retries is the number of retries of a failed delivery, 8 by default.
+	Retries int
+	// Backoff is the delay before the first retry, doubled at every retry
+	// up to MaxBackoff, 1 second and 5 minutes by default.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Dispatcher delivers events to endpoints.
+type Dispatcher struct {
+	cfg Config
+	now func() time.Time
+
+	mu sync.Mutex // serializes the dead-letter file
+}
+
+// New validates the endpoints and returns a dispatcher.
+func New(cfg Config) (*Dispatcher, error) {
+	names := make(map[string]bool)
+	for _, e := range cfg.Endpoints {
+		if u, err := url.Parse(e.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, errors.Errorf("endpoint %s: invalid URL %q", e.Name, e.URL)
+		}
+		if e.Name == "" || names[e.Name] {
+			return nil, errors.Errorf("endpoint %s: names must be unique and not empty", e.URL)
+		}
+		if e.Secret == "" {
+			return nil, errors.Errorf("endpoint %s: no secret", e.Name)
+		}
+		names[e.Name] = true
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cfg.Retries == 0 {
+		cfg.Retries = 8
+	}
+	if cfg.Backoff == 0 {
+		cfg.Backoff = time.Second
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = 5 * time.Minute
+	}
+	return &Dispatcher{cfg: cfg, now: time.Now}, nil
+}
+
+// Handle delivers the decoded events of an indexer step; it is an
+// indexer.Handler.
+func (d *Dispatcher) Handle(ctx context.Context, events []indexer.Event) error {
+	decoded := make([]generated.AbigenLog, 0, len(events))
+	for _, ev := range events {
+		if ev.Decoded != nil {
+			decoded = append(decoded, ev.Decoded)
+		}
+	}
+	return d.Deliver(ctx, decoded)
+}
+
+var _ indexer.Handler = (*Dispatcher)(nil).Handle
+
+// Deliver sends the events to the endpoints that match them, in order per
+// endpoint. It returns once every delivery succeeded or was dead-lettered,
+// and fails when ctx is done or a dead letter cannot be written.
+func (d *Dispatcher) Deliver(ctx context.Context, events []generated.AbigenLog) error {
+	payloads := make([]*Payload, 0, len(events))
+	for _, ev := range events {
+		p, err := NewPayload(ev)
+		if err != nil {
+			return err
+		}
+		payloads = append(payloads, p)
+	}
+
+	errs := make([]error, len(d.cfg.Endpoints))
+	var wg sync.WaitGroup
+	for i := range d.cfg.Endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			e := &d.cfg.Endpoints[i]
+			for _, p := range payloads {
+				if !e.Match(p) {
+					continue
+				}
+				if errs[i] = d.deliver(ctx, e, p); errs[i] != nil {
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver sends p to e until it succeeds, and dead-letters it otherwise.
+func (d *Dispatcher) deliver(ctx context.Context, e *Endpoint, p *Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return errors.Wrapf(err, "could not encode %s", p.ID)
+	}
+	backoff := d.cfg.Backoff
+	var attempt int
+	for attempt = 1; ; attempt++ {
+		retryAfter, err := d.send(ctx, e, p.ID, body)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var permanent permanentError
+		if errors.As(err, &permanent) || attempt > d.cfg.Retries {
+			return d.deadLetter(DeadLetter{Time: d.now().UTC(), Endpoint: e.Name, Attempts: attempt, Error: err.Error(), Payload: p})
+		}
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff)/2+1))
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		if wait > d.cfg.MaxBackoff {
+			wait = d.cfg.MaxBackoff
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if backoff *= 2; backoff > d.cfg.MaxBackoff {
+			backoff = d.cfg.MaxBackoff
+		}
+	}
+}
+
+// permanentError is a response retrying does not change.
+type permanentError struct {
+	status int
+}
+
+func (e permanentError) Error() string {
+	return "endpoint rejected the event: " + http.StatusText(e.status)
+}
+
+// send makes one delivery attempt. It returns the delay the endpoint asked
+// for with Retry-After, if any.
+func (d *Dispatcher) send(ctx context.Context, e *Endpoint, id string, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderIdempotencyKey, id)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign([]byte(e.Secret), timestamp, body))
+	resp, err := d.cfg.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return 0, nil
+	case resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		var retryAfter time.Duration
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		return retryAfter, errors.Errorf("endpoint answered %s", resp.Status)
+	}
+	return 0, permanentError{resp.StatusCode}
+}
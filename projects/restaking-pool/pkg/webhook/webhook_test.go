@@ -0,0 +1,216 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/TagusLabs/genesis-smart-contracts/abigen/generated"
+	"github.com/TagusLabs/genesis-smart-contracts/pkg/indexer"
+	ratiofeed "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RatioFeed"
+	restakingpool "github.com/TagusLabs/genesis-smart-contracts/pkg/sdk/RestakingPool"
+)
+
+var (
+	pool = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	feed = common.HexToAddress("0x2000000000000000000000000000000000000002")
+)
+
+func raw(address common.Address, block uint64, index uint) types.Log {
+	return types.Log{Address: address, BlockNumber: block, BlockHash: common.BigToHash(new(big.Int).SetUint64(block)), Index: index}
+}
+
+func events() []generated.AbigenLog {
+	amount, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	return []generated.AbigenLog{
+		&restakingpool.ContractStaked{Staker: common.Address{7}, Amount: amount, Shares: big.NewInt(2), Raw: raw(pool, 10, 0)},
+		&ratiofeed.ContractRatioUpdated{TokenAddress: common.Address{8}, OldRatio: big.NewInt(3), NewRatio: big.NewInt(2), Raw: raw(feed, 10, 1)},
+		// not a default event
+		&restakingpool.ContractDeposited{Provider: common.Hash{1}, Pubkeys: [][]byte{{1, 2}}, Raw: raw(pool, 11, 0)},
+		&restakingpool.ContractUnstaked{From: common.Address{7}, To: common.Address{7}, Amount: big.NewInt(5), Shares: big.NewInt(4), Raw: raw(pool, 12, 3)},
+	}
+}
+
+// receiver records the deliveries it accepts after failing with status
+// the first fail requests.
+type receiver struct {
+	t      *testing.T
+	secret string
+	status int
+	fail   int
+
+	mu       sync.Mutex
+	attempts int
+	received []Payload
+	keys     []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		r.t.Error(err)
+	}
+	if !Verify([]byte(r.secret), req.Header.Get(HeaderTimestamp), body, req.Header.Get(HeaderSignature)) {
+		r.t.Errorf("bad signature %s", req.Header.Get(HeaderSignature))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts++
+	if r.fail > 0 {
+		r.fail--
+		w.WriteHeader(r.status)
+		return
+	}
+	var p Payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		r.t.Error(err)
+	}
+	r.received = append(r.received, p)
+	r.keys = append(r.keys, req.Header.Get(HeaderIdempotencyKey))
+}
+
+func (r *receiver) serve(t *testing.T, name string, events []string, addresses []common.Address) Endpoint {
+	r.t = t
+	if r.secret == "" {
+		r.secret = name + " secret"
+	}
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+	return Endpoint{Name: name, URL: server.URL, Secret: r.secret, Events: events, Addresses: addresses}
+}
+
+func TestDeliver(t *testing.T) {
+	all := &receiver{status: http.StatusServiceUnavailable, fail: 2}
+	ratios := &receiver{}
+	pools := &receiver{status: http.StatusTooManyRequests, fail: 1}
+	d, err := New(Config{
+		Endpoints: []Endpoint{
+			all.serve(t, "all", nil, nil),
+			ratios.serve(t, "ratios", []string{"RatioUpdated"}, nil),
+			pools.serve(t, "pools", []string{"Staked", "Deposited", "RatioUpdated"}, []common.Address{pool}),
+		},
+		Backoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Deliver(context.Background(), events()); err != nil {
+		t.Fatal(err)
+	}
+
+	names := func(r *receiver) []string {
+		var out []string
+		for _, p := range r.received {
+			out = append(out, p.Event)
+		}
+		return out
+	}
+	for _, c := range []struct {
+		r        *receiver
+		want     []string
+		attempts int
+	}{
+		{all, []string{"Staked", "RatioUpdated", "Unstaked"}, 5},
+		{ratios, []string{"RatioUpdated"}, 1},
+		{pools, []string{"Staked", "Deposited"}, 3},
+	} {
+		if got := names(c.r); len(got) != len(c.want) || c.r.attempts != c.attempts {
+			t.Fatalf("received %v in %d attempts, want %v in %d", got, c.r.attempts, c.want, c.attempts)
+		}
+		for i, name := range c.want {
+			if c.r.received[i].Event != name || c.r.keys[i] != c.r.received[i].ID {
+				t.Errorf("delivery %d: %+v with key %s, want %s", i, c.r.received[i], c.r.keys[i], name)
+			}
+		}
+	}
+
+	staked := all.received[0]
+	if staked.ID != common.BigToHash(big.NewInt(10)).Hex()+"-0" || staked.Address != pool || staked.BlockNumber != 10 {
+		t.Errorf("payload %+v", staked)
+	}
+	if staked.Args["amount"] != "123456789012345678901234567890" || staked.Args["staker"] != (common.Address{7}).Hex() {
+		t.Errorf("args %v", staked.Args)
+	}
+	if deposited := pools.received[1]; deposited.Args["provider"] != (common.Hash{1}).Hex() {
+		t.Errorf("args %v", deposited.Args)
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	rejecting := &receiver{status: http.StatusBadRequest, fail: 3}
+	down := &receiver{status: http.StatusBadGateway, fail: 1000}
+	path := filepath.Join(t.TempDir(), "dead.jsonl")
+	d, err := New(Config{
+		Endpoints: []Endpoint{
+			rejecting.serve(t, "rejecting", []string{"Staked"}, nil),
+			down.serve(t, "down", []string{"Unstaked"}, nil),
+		},
+		DeadLetter: path,
+		Retries:    2,
+		Backoff:    time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the handler of an indexer step, with a removed event
+	removed := events()[3].(*restakingpool.ContractUnstaked)
+	removed.Raw.Removed = true
+	err = d.Handle(context.Background(), []indexer.Event{
+		{Decoded: events()[0]},
+		{Decoded: removed},
+		{Log: types.Log{Address: pool}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rejecting.attempts != 1 || down.attempts != 3 {
+		t.Fatalf("%d and %d attempts", rejecting.attempts, down.attempts)
+	}
+	letters, err := ReadDeadLetters(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the endpoints fail concurrently
+	if len(letters) == 2 && letters[0].Endpoint == "down" {
+		letters[0], letters[1] = letters[1], letters[0]
+	}
+	if len(letters) != 2 || letters[0].Endpoint != "rejecting" || letters[0].Attempts != 1 || letters[1].Endpoint != "down" || letters[1].Attempts != 3 {
+		t.Fatalf("dead letters %+v", letters)
+	}
+	if id := letters[1].Payload.ID; !letters[1].Payload.Removed || id != common.BigToHash(big.NewInt(12)).Hex()+"-3-removed" {
+		t.Errorf("removed payload %+v", letters[1].Payload)
+	}
+
+	// the endpoint is back
+	down.mu.Lock()
+	down.fail = 0
+	down.mu.Unlock()
+	delivered, left, err := d.Redrive(context.Background())
+	if err != nil || delivered != 1 || left != 1 {
+		t.Fatalf("redrive delivered %d, left %d: %v", delivered, left, err)
+	}
+	if len(down.received) != 1 || down.received[0].ID != letters[1].Payload.ID {
+		t.Errorf("received %+v", down.received)
+	}
+	if letters, err = ReadDeadLetters(path); err != nil || len(letters) != 1 || letters[0].Attempts != 2 {
+		t.Errorf("dead letters after redrive %+v: %v", letters, err)
+	}
+
+	// without a dead-letter file the failure is returned
+	d.cfg.DeadLetter = ""
+	if err := d.Deliver(context.Background(), events()[:1]); err == nil {
+		t.Fatal("no error")
+	}
+}